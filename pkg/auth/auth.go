@@ -37,9 +37,19 @@ func ValidateToken(token string, penalty time.Duration,
 		if token == "" {
 			return next
 		}
+		return ValidateTokens(func() []string { return []string{token} }, penalty, unauthorizedBody)(next)
+	}
+}
 
+// ValidateTokens is like ValidateToken but accepts any of the tokens returned
+// by the tokens function, it is called on every request so that the tokens
+// can change at runtime. Empty tokens are ignored, if there are no tokens all
+// requests are accepted.
+func ValidateTokens(tokens func() []string, penalty time.Duration,
+	unauthorizedBody json.RawMessage) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !secureCompare(bearerAuth(r), token) {
+			if !matchAny(bearerAuth(r), tokens()) {
 				if penalty > 0 {
 					time.Sleep(penalty)
 				}
@@ -52,6 +62,25 @@ func ValidateToken(token string, penalty time.Duration,
 	}
 }
 
+// matchAny returns true if there are no non-empty tokens or token is equal to
+// any of them. All tokens are compared to avoid leaking which one matched.
+func matchAny(token string, tokens []string) bool {
+	var (
+		any   bool
+		match bool
+	)
+	for _, t := range tokens {
+		if t == "" {
+			continue
+		}
+		any = true
+		if secureCompare(token, t) {
+			match = true
+		}
+	}
+	return !any || match
+}
+
 // bearerAuth returns the token provided in the request's Authorization header.
 func bearerAuth(r *http.Request) (token string) {
 	auth := r.Header.Get("Authorization")
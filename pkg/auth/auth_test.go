@@ -157,3 +157,69 @@ func TestCrossCheckAddValidateToken(t *testing.T) {
 		t.Error("expected status 200 got", resp.StatusCode)
 	}
 }
+
+func TestValidateTokens(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name   string
+		Tokens []string
+		Token  string
+		Code   int
+	}{
+		{
+			Name:   "no tokens",
+			Tokens: nil,
+			Code:   http.StatusOK,
+		},
+		{
+			Name:   "empty tokens",
+			Tokens: []string{"", ""},
+			Code:   http.StatusOK,
+		},
+		{
+			Name:   "current token",
+			Tokens: []string{"current", "next"},
+			Token:  "current",
+			Code:   http.StatusOK,
+		},
+		{
+			Name:   "next token",
+			Tokens: []string{"current", "next"},
+			Token:  "next",
+			Code:   http.StatusOK,
+		},
+		{
+			Name:   "invalid token",
+			Tokens: []string{"current", "next"},
+			Token:  "foobar",
+			Code:   http.StatusUnauthorized,
+		},
+		{
+			Name:   "no token",
+			Tokens: []string{"", "next"},
+			Code:   http.StatusUnauthorized,
+		},
+	}
+
+	h := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/foobar", nil)
+			if test.Token != "" {
+				r.Header.Set("Authorization", "Bearer "+test.Token)
+			}
+			w := httptest.NewRecorder()
+
+			tokens := func() []string { return test.Tokens }
+			ValidateTokens(tokens, 0, nil)(h).ServeHTTP(w, r)
+			if w.Code != test.Code {
+				t.Errorf("expected status %d got %d", test.Code, w.Code)
+			}
+		})
+	}
+}
@@ -9,15 +9,28 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
-	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
 )
 
-func newAgentHandler(c config.AgentConfig, rclone http.Handler) *chi.Mux {
+func newAgentHandler(live *liveConfig, rclone http.Handler, reload reloadFunc) *chi.Mux {
+	c := live.Load()
 	m := chi.NewMux()
 
 	m.Get("/node_info", newNodeInfoHandler(c).getNodeInfo)
+	m.Get("/node_time", getNodeTime)
 	m.Post("/terminate", selfSigterm())
+	m.Post("/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		newUpgradeHandler(live.Load()).upgrade(w, r)
+	})
+	m.Post("/reload", reloadHandler(reload))
+	m.Get("/config", getConfig(live))
+	m.Post("/restart_scylla", newRestartHandler(c).restartScylla)
+	m.Post("/run_hook", func(w http.ResponseWriter, r *http.Request) {
+		newHookHandler(live.Load()).runHook(w, r)
+	})
 	m.Post("/free_os_memory", func(writer http.ResponseWriter, request *http.Request) {
 		debug.FreeOSMemory()
 	})
@@ -28,6 +41,12 @@ func newAgentHandler(c config.AgentConfig, rclone http.Handler) *chi.Mux {
 	return m
 }
 
+func getNodeTime(w http.ResponseWriter, r *http.Request) {
+	render.Respond(w, r, models.NodeTime{
+		Time: strfmt.DateTime(timeutc.Now()),
+	})
+}
+
 func selfSigterm() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if err := syscall.Kill(syscall.Getpid(), syscall.SIGTERM); err != nil {
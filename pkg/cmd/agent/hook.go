@@ -0,0 +1,162 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+)
+
+// maxHookOutput is the max number of trailing bytes of hook output returned
+// to the caller.
+const maxHookOutput = 64 * 1024
+
+var baseNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+
+// hookRunner runs the executable and returns its combined output and exit code.
+type hookRunner func(ctx context.Context, path string, env []string) (out []byte, exitCode int, err error)
+
+// runHook runs the executable in a new process group, on context
+// cancellation the whole group is killed so that processes spawned by the hook
+// do not outlive it.
+func runHook(ctx context.Context, path string, env []string) ([]byte, int, error) {
+	var out bytes.Buffer
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Dir = filepath.Dir(path)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := cmd.Start(); err != nil {
+		return nil, 0, err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL) // nolint: errcheck
+		case <-done:
+		}
+	}()
+
+	err := cmd.Wait()
+	if ctx.Err() != nil {
+		return out.Bytes(), 0, ctx.Err()
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return out.Bytes(), exitErr.ExitCode(), nil
+	}
+	return out.Bytes(), 0, err
+}
+
+type hookHandler struct {
+	dir     string
+	timeout time.Duration
+	run     hookRunner
+}
+
+func newHookHandler(c config.AgentConfig) *hookHandler {
+	return &hookHandler{
+		dir:     c.Hooks.Dir,
+		timeout: c.Hooks.Timeout,
+		run:     runHook,
+	}
+}
+
+// runHook runs an executable from the hooks directory. Only files placed
+// directly in the directory can be run, the hook gets the stage and
+// snapshot tag in SCYLLA_MANAGER_HOOK_STAGE and SCYLLA_MANAGER_SNAPSHOT_TAG
+// environment variables. Non-zero exit code is not an error, it's reported
+// in the result.
+func (h *hookHandler) runHook(w http.ResponseWriter, r *http.Request) {
+	if h.dir == "" {
+		render.Status(r, http.StatusForbidden)
+		render.Respond(w, r, errors.New("hooks are disabled, set hooks dir in agent configuration"))
+		return
+	}
+
+	var hook models.Hook
+	if err := json.NewDecoder(r.Body).Decode(&hook); err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, errors.Wrap(err, "decode hook"))
+		return
+	}
+
+	path, err := h.hookPath(hook.Name)
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+
+	env := []string{
+		"SCYLLA_MANAGER_HOOK=" + hook.Name,
+		"SCYLLA_MANAGER_HOOK_STAGE=" + hook.Stage,
+		"SCYLLA_MANAGER_SNAPSHOT_TAG=" + hook.SnapshotTag,
+	}
+	out, exitCode, err := h.run(ctx, path, env)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = errors.Errorf("timeout after %s", h.timeout)
+		}
+		render.Status(r, http.StatusInternalServerError)
+		render.Respond(w, r, errors.Wrapf(err, "run hook %s: %s", hook.Name, tail(out, maxHookOutput)))
+		return
+	}
+
+	render.Respond(w, r, models.HookResult{
+		ExitCode: int64(exitCode),
+		Output:   string(tail(out, maxHookOutput)),
+	})
+}
+
+// hookPath returns path of the named executable in the hooks directory.
+func (h *hookHandler) hookPath(name string) (string, error) {
+	if !baseNameRegexp.MatchString(name) {
+		return "", errors.Errorf("invalid hook name %q", name)
+	}
+
+	path := filepath.Join(h.dir, name)
+	fi, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Errorf("hook %s not found in %s", name, h.dir)
+		}
+		return "", errors.Wrapf(err, "stat %s", path)
+	}
+	if !fi.Mode().IsRegular() || fi.Mode().Perm()&0o111 == 0 {
+		return "", errors.Errorf("hook %s is not an executable file", name)
+	}
+
+	return path, nil
+}
+
+func tail(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[len(b)-n:]
+	}
+	return b
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+)
+
+func TestRunHook(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "flush.sh"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "readme"), []byte("hooks\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		path string
+		env  []string
+	)
+	h := hookHandler{
+		dir:     dir,
+		timeout: time.Minute,
+		run: func(ctx context.Context, p string, e []string) ([]byte, int, error) {
+			path, env = p, e
+			return []byte("flushed\n"), 1, nil
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.runHook(w, newHookRequest("flush.sh"))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Response Code=%d expected %d", w.Code, http.StatusOK)
+		}
+		var res models.HookResult
+		if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(res, models.HookResult{ExitCode: 1, Output: "flushed\n"}); diff != "" {
+			t.Error(diff)
+		}
+		if path != filepath.Join(dir, "flush.sh") {
+			t.Errorf("path=%s", path)
+		}
+		golden := []string{
+			"SCYLLA_MANAGER_HOOK=flush.sh",
+			"SCYLLA_MANAGER_HOOK_STAGE=pre_snapshot",
+			"SCYLLA_MANAGER_SNAPSHOT_TAG=sm_20201029154301UTC",
+		}
+		if diff := cmp.Diff(env, golden); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	table := []struct {
+		Name string
+		Hook string
+	}{
+		{Name: "not found", Hook: "pause.sh"},
+		{Name: "not executable", Hook: "readme"},
+		{Name: "parent dir", Hook: "../flush.sh"},
+		{Name: "absolute path", Hook: filepath.Join(dir, "flush.sh")},
+		{Name: "hidden", Hook: ".flush.sh"},
+		{Name: "empty", Hook: ""},
+	}
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.runHook(w, newHookRequest(test.Hook))
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Response Code=%d expected %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestRunHookDisabled(t *testing.T) {
+	h := hookHandler{
+		run: func(ctx context.Context, p string, e []string) ([]byte, int, error) {
+			t.Fatal("unexpected run")
+			return nil, 0, nil
+		},
+	}
+
+	w := httptest.NewRecorder()
+	h.runHook(w, newHookRequest("flush.sh"))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Response Code=%d expected %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestRunHookTimeout(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "sleep.sh"), []byte("#!/bin/sh\nsleep 10\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	h := hookHandler{
+		dir:     dir,
+		timeout: 100 * time.Millisecond,
+		run:     runHook,
+	}
+
+	start := time.Now()
+	w := httptest.NewRecorder()
+	h.runHook(w, newHookRequest("sleep.sh"))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Response Code=%d expected %d", w.Code, http.StatusInternalServerError)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Hook run for %s, expected to be killed after timeout", d)
+	}
+}
+
+func newHookRequest(name string) *http.Request {
+	b, _ := json.Marshal(models.Hook{
+		Name:        name,
+		Stage:       "pre_snapshot",
+		SnapshotTag: "sm_20201029154301UTC",
+	})
+	return httptest.NewRequest(http.MethodPost, "/run_hook", strings.NewReader(string(b)))
+}
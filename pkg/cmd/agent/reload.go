@@ -0,0 +1,164 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/pkg/config/enrich"
+	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/multipart"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v2"
+)
+
+// reloadableOptions lists top level configuration options that are applied
+// on reload, changes of other options require agent restart.
+var reloadableOptions = []string{
+	"auth_token",
+	"auth_tokens",
+	"hooks",
+	"upgrade",
+	"resumable_upload",
+	"rclone",
+	"s3",
+	"gcs",
+	"azure",
+	"sftp",
+	"webdav",
+}
+
+// liveConfig holds the current agent configuration, it's updated when
+// the configuration is reloaded.
+type liveConfig struct {
+	mu sync.RWMutex
+	c  config.AgentConfig
+}
+
+func newLiveConfig(c config.AgentConfig) *liveConfig {
+	return &liveConfig{c: c}
+}
+
+func (l *liveConfig) Load() config.AgentConfig {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.c
+}
+
+func (l *liveConfig) Store(c config.AgentConfig) {
+	l.mu.Lock()
+	l.c = c
+	l.mu.Unlock()
+}
+
+// mergeReloadable returns c with the reloadable options taken from n,
+// and names of other options that differ between c and n.
+func mergeReloadable(c, n config.AgentConfig) (config.AgentConfig, []string) {
+	var (
+		cv      = reflect.ValueOf(&c).Elem()
+		nv      = reflect.ValueOf(n)
+		ignored []string
+	)
+	for i := 0; i < cv.NumField(); i++ {
+		name := strings.Split(cv.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "" {
+			name = cv.Type().Field(i).Name
+		}
+		if isReloadable(name) {
+			cv.Field(i).Set(nv.Field(i))
+		} else if !reflect.DeepEqual(cv.Field(i).Interface(), nv.Field(i).Interface()) {
+			ignored = append(ignored, name)
+		}
+	}
+	return c, ignored
+}
+
+func isReloadable(name string) bool {
+	for _, o := range reloadableOptions {
+		if o == name {
+			return true
+		}
+	}
+	return false
+}
+
+// reload reads the configuration files and applies the reloadable options.
+// Remote file systems are recreated with the new provider configuration,
+// running rclone jobs are not interrupted. Rclone options are applied when
+// the running jobs are done.
+func (s *server) reload(ctx context.Context) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	s.logger.Info(ctx, "Reloading config", "config_files", rootArgs.configFiles)
+
+	n, err := config.ParseAgentConfigFiles(rootArgs.configFiles)
+	if err != nil {
+		return err
+	}
+	if err := n.Validate(); err != nil {
+		return errors.Wrap(err, "invalid config")
+	}
+	addr := net.JoinHostPort(n.Scylla.APIAddress, n.Scylla.APIPort)
+	if err := enrich.AgentConfigFromAPI(ctx, addr, &n); err != nil {
+		return err
+	}
+
+	c, ignored := mergeReloadable(s.live.Load(), n)
+	if len(ignored) > 0 {
+		s.logger.Info(ctx, "WARNING! Config options changed but require agent restart to take effect", "options", ignored)
+	}
+
+	rclone.SetFsConfigOptions(c.Rclone)
+	multipart.SetOptions(c.ResumableUpload.Options())
+	if err := multierr.Combine(
+		rclone.RegisterS3Provider(c.S3),
+		rclone.RegisterGCSProvider(c.GCS),
+		rclone.RegisterAzureProvider(c.Azure),
+		rclone.RegisterSFTPProvider(c.SFTP),
+		rclone.RegisterWebDAVProvider(c.WebDAV),
+	); err != nil {
+		return err
+	}
+	rclone.ClearFsCache()
+	s.live.Store(c)
+
+	s.logger.Info(ctx, "Config reloaded", "config", config.ObfuscatedAgentConfig(c))
+	return nil
+}
+
+type reloadFunc func(ctx context.Context) error
+
+func reloadHandler(reload reloadFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := reload(r.Context()); err != nil {
+			render.Status(r, http.StatusInternalServerError)
+			render.Respond(w, r, errors.Wrap(err, "reload config"))
+			return
+		}
+	}
+}
+
+// getConfig returns the current configuration in YAML with secrets redacted.
+func getConfig(c *liveConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, err := yaml.Marshal(config.ObfuscatedAgentConfig(c.Load()))
+		if err != nil {
+			render.Status(r, http.StatusInternalServerError)
+			render.Respond(w, r, errors.Wrap(err, "marshal config"))
+			return
+		}
+		render.Respond(w, r, models.AgentConfig{
+			Config: string(b),
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+)
+
+func TestMergeReloadable(t *testing.T) {
+	c := config.DefaultAgentConfig()
+	c.AuthToken = "token"
+
+	n := c
+	n.AuthTokens = []string{"new token"}
+	n.HTTPS = ":10002"
+	n.Hooks.Timeout = time.Minute
+	n.S3.Region = "us-east-1"
+	n.Rclone.Transfers = 8
+
+	m, ignored := mergeReloadable(c, n)
+
+	golden := c
+	golden.AuthTokens = n.AuthTokens
+	golden.Hooks = n.Hooks
+	golden.S3 = n.S3
+	golden.Rclone = n.Rclone
+	if !reflect.DeepEqual(m, golden) {
+		t.Fatalf("mergeReloadable() = %+v, expected %+v", m, golden)
+	}
+	if diff := cmp.Diff(ignored, []string{"https"}); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	c := config.DefaultAgentConfig()
+	c.AuthToken = "token"
+	c.AuthTokens = []string{"token"}
+	c.S3.SecretAccessKey = "secret"
+
+	w := httptest.NewRecorder()
+	getConfig(newLiveConfig(c))(w, httptest.NewRequest(http.MethodGet, "/config", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Response Code=%d expected %d", w.Code, http.StatusOK)
+	}
+
+	var res models.AgentConfig
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(res.Config, ": token\n") || strings.Contains(res.Config, ": secret\n") {
+		t.Fatalf("Secrets not redacted\n%s", res.Config)
+	}
+	if !strings.Contains(res.Config, "auth_token: '*****'") {
+		t.Fatalf("Missing auth_token\n%s", res.Config)
+	}
+	if c.AuthTokens[0] != "token" {
+		t.Fatal("Config modified")
+	}
+}
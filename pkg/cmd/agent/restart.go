@@ -0,0 +1,50 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os/exec"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/config"
+)
+
+type commandRunner func(ctx context.Context, name string, arg ...string) ([]byte, error)
+
+func runCommand(ctx context.Context, name string, arg ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, arg...).CombinedOutput()
+}
+
+type restartHandler struct {
+	serviceName string
+	run         commandRunner
+}
+
+func newRestartHandler(c config.AgentConfig) *restartHandler {
+	return &restartHandler{
+		serviceName: c.Scylla.ServiceName,
+		run:         runCommand,
+	}
+}
+
+// restartScylla queues restart of the Scylla systemd service, it does not
+// wait for Scylla to start. Callers shall check the node health to find out
+// when the node is back.
+func (h *restartHandler) restartScylla(w http.ResponseWriter, r *http.Request) {
+	if h.serviceName == "" {
+		render.Status(r, http.StatusInternalServerError)
+		render.Respond(w, r, errors.New("missing scylla service_name"))
+		return
+	}
+
+	out, err := h.run(r.Context(), "systemctl", "restart", "--no-block", h.serviceName)
+	if err != nil {
+		render.Status(r, http.StatusInternalServerError)
+		render.Respond(w, r, errors.Wrapf(err, "restart %s: %s", h.serviceName, bytes.TrimSpace(out)))
+		return
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRestartScylla(t *testing.T) {
+	var args []string
+	h := restartHandler{
+		serviceName: "scylla-server",
+		run: func(ctx context.Context, name string, arg ...string) ([]byte, error) {
+			args = append([]string{name}, arg...)
+			return nil, nil
+		},
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/restart_scylla", nil)
+	w := httptest.NewRecorder()
+	h.restartScylla(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Response Code=%d expected %d", w.Code, http.StatusOK)
+	}
+	golden := []string{"systemctl", "restart", "--no-block", "scylla-server"}
+	if diff := cmp.Diff(args, golden); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRestartScyllaError(t *testing.T) {
+	h := restartHandler{
+		serviceName: "scylla-server",
+		run: func(ctx context.Context, name string, arg ...string) ([]byte, error) {
+			return []byte("Unit scylla-server.service not found.\n"), errors.New("exit status 5")
+		},
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/restart_scylla", nil)
+	w := httptest.NewRecorder()
+	h.restartScylla(w, r)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Response Code=%d expected %d", w.Code, http.StatusInternalServerError)
+	}
+}
@@ -68,22 +68,40 @@ var rootCmd = &cobra.Command{
 		if err := s.makeServers(ctx); err != nil {
 			return errors.Wrapf(err, "make servers")
 		}
+		// After upgrade run the new binary once the servers are shut down
+		defer func() {
+			if runError != nil {
+				return
+			}
+			if binary := upgradedBinary.Load(); binary != "" {
+				logger.Info(ctx, "Starting upgraded agent", "binary", binary)
+				logger.Sync() // nolint
+				runError = errors.Wrap(syscall.Exec(binary, os.Args, os.Environ()), "exec upgraded agent")
+			}
+		}()
 		s.startServers(ctx)
 		defer s.shutdownServers(ctx, 30*time.Second)
 
-		// Wait signal
+		// Wait signal, reload config on SIGHUP
 		signalCh := make(chan os.Signal, 1)
-		signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
-		select {
-		case err := <-s.errCh:
-			if err != nil {
-				return err
+		signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+		for {
+			select {
+			case err := <-s.errCh:
+				if err != nil {
+					return err
+				}
+				return nil
+			case sig := <-signalCh:
+				logger.Info(ctx, "Received signal", "signal", sig)
+				if sig != syscall.SIGHUP {
+					return nil
+				}
+				if err := s.reload(ctx); err != nil {
+					logger.Error(ctx, "Failed to reload config", "error", err)
+				}
 			}
-		case sig := <-signalCh:
-			logger.Info(ctx, "Received signal", "signal", sig)
 		}
-
-		return nil
 	},
 }
 
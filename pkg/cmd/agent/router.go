@@ -19,7 +19,8 @@ import (
 
 var unauthorizedErrorBody = json.RawMessage(`{"message":"unauthorized","code":401}`)
 
-func newRouter(c config.AgentConfig, rclone http.Handler, logger log.Logger) http.Handler {
+func newRouter(live *liveConfig, rclone http.Handler, reload reloadFunc, logger log.Logger) http.Handler {
+	c := live.Load()
 	r := chi.NewRouter()
 
 	// Common middleware
@@ -30,12 +31,16 @@ func newRouter(c config.AgentConfig, rclone http.Handler, logger log.Logger) htt
 	r.Get("/ping", httphandler.Heartbeat())
 	r.Get("/version", httphandler.Version())
 
-	// Restricted access endpoints
+	// Restricted access endpoints, tokens are read from the live config so
+	// that they can be rotated with config reload.
+	tokens := func() []string {
+		return live.Load().ValidAuthTokens()
+	}
 	priv := r.With(
-		auth.ValidateToken(c.AuthToken, time.Second, unauthorizedErrorBody),
+		auth.ValidateTokens(tokens, time.Second, unauthorizedErrorBody),
 	)
 	// Agent specific endpoints
-	priv.Mount("/agent", newAgentHandler(c, rclone))
+	priv.Mount("/agent", newAgentHandler(live, rclone, reload))
 	// Scylla prometheus proxy
 	priv.Mount("/metrics", promProxy(c))
 	// Fallback to Scylla API proxy
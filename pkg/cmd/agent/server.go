@@ -5,10 +5,13 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -20,6 +23,7 @@ import (
 	"github.com/scylladb/scylla-manager/pkg/config"
 	"github.com/scylladb/scylla-manager/pkg/config/enrich"
 	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/multipart"
 	"github.com/scylladb/scylla-manager/pkg/rclone/rcserver"
 	"github.com/scylladb/scylla-manager/pkg/util/certutil"
 	"github.com/scylladb/scylla-manager/pkg/util/cpuset"
@@ -34,6 +38,9 @@ type server struct {
 	config config.AgentConfig
 	logger log.Logger
 
+	live     *liveConfig
+	reloadMu sync.Mutex
+
 	httpsServer      *http.Server
 	prometheusServer *http.Server
 	debugServer      *http.Server
@@ -120,19 +127,26 @@ func (s *server) init(ctx context.Context) error {
 	rclone.RedirectLogPrint(s.logger.Named("rclone"))
 	// Init rclone config options
 	rclone.InitFsConfigWithOptions(s.config.Rclone)
+	// Init resumable uploads
+	multipart.SetOptions(s.config.ResumableUpload.Options())
 	// Add prometheus metrics
 	rclone.MustRegisterPrometheusMetrics("scylla_manager_agent_rclone")
 
 	// Register rclone providers
 	return multierr.Combine(
 		rclone.RegisterLocalDirProvider("data", "Jailed Scylla data", s.config.Scylla.DataDirectory),
+		rclone.RegisterFileProvider(s.config.File.Root),
 		rclone.RegisterS3Provider(s.config.S3),
 		rclone.RegisterGCSProvider(s.config.GCS),
 		rclone.RegisterAzureProvider(s.config.Azure),
+		rclone.RegisterSFTPProvider(s.config.SFTP),
+		rclone.RegisterWebDAVProvider(s.config.WebDAV),
 	)
 }
 
 func (s *server) makeServers(ctx context.Context) error {
+	s.live = newLiveConfig(s.config)
+
 	tlsConfig, err := s.tlsConfig(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "tls")
@@ -140,7 +154,7 @@ func (s *server) makeServers(ctx context.Context) error {
 	s.httpsServer = &http.Server{
 		Addr:      s.config.HTTPS,
 		TLSConfig: tlsConfig,
-		Handler:   newRouter(s.config, rcserver.New(), s.logger.Named("http")),
+		Handler:   newRouter(s.live, rcserver.New(), s.reload, s.logger.Named("http")),
 	}
 	if s.config.Prometheus != "" {
 		s.prometheusServer = &http.Server{
@@ -185,6 +199,20 @@ func (s *server) tlsConfig(ctx context.Context) (*tls.Config, error) {
 	tlsConfig := s.config.TLSVersion.TLSConfig()
 	tlsConfig.Certificates = []tls.Certificate{cert}
 
+	if s.config.TLSClientCAFile != "" {
+		s.logger.Info(ctx, "Loading client CA certificates from disk", "ca_file", s.config.TLSClientCAFile)
+		b, err := ioutil.ReadFile(s.config.TLSClientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "client CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.Errorf("client CA: no PEM encoded certificates found in %s", s.config.TLSClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
 	return tlsConfig, nil
 }
 
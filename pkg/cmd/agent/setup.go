@@ -32,6 +32,9 @@ func setupCommand(configFiles []string, level zapcore.Level) (config.AgentConfig
 	// Init rclone config options
 	rclone.InitFsConfigWithOptions(c.Rclone)
 	// Register rclone providers
+	if err := rclone.RegisterFileProvider(c.File.Root); err != nil {
+		return c, logger, err
+	}
 	if err := rclone.RegisterS3Provider(c.S3); err != nil {
 		return c, logger, err
 	}
@@ -41,6 +44,12 @@ func setupCommand(configFiles []string, level zapcore.Level) (config.AgentConfig
 	if err := rclone.RegisterAzureProvider(c.Azure); err != nil {
 		return c, logger, err
 	}
+	if err := rclone.RegisterSFTPProvider(c.SFTP); err != nil {
+		return c, logger, err
+	}
+	if err := rclone.RegisterWebDAVProvider(c.WebDAV); err != nil {
+		return c, logger, err
+	}
 
 	return c, logger, nil
 }
@@ -0,0 +1,244 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+	"go.uber.org/atomic"
+)
+
+// upgradedBinary is set to path of the agent binary after a successful
+// upgrade, on termination the agent executes the new binary instead of
+// exiting.
+var upgradedBinary atomic.String
+
+// versionCheckTimeout specifies how long to wait for the downloaded binary to
+// print its version.
+const versionCheckTimeout = 10 * time.Second
+
+// remoteOpener opens the named file in the rclone location.
+type remoteOpener func(ctx context.Context, location, name string) (io.ReadCloser, error)
+
+func openRemote(ctx context.Context, location, name string) (io.ReadCloser, error) {
+	f, err := fs.NewFs(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	o, err := f.NewObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return o.Open(ctx)
+}
+
+// binaryVersion runs the binary with --version flag and returns its output.
+func binaryVersion(ctx context.Context, path string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, versionCheckTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, path, "--version").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+type upgradeHandler struct {
+	location      string
+	publicKeyFile string
+	binary        func() (string, error)
+	open          remoteOpener
+	version       func(ctx context.Context, path string) (string, error)
+}
+
+func newUpgradeHandler(c config.AgentConfig) *upgradeHandler {
+	return &upgradeHandler{
+		location:      c.Upgrade.Location,
+		publicKeyFile: c.Upgrade.PublicKeyFile,
+		binary:        executable,
+		open:          openRemote,
+		version:       binaryVersion,
+	}
+}
+
+func executable() (string, error) {
+	p, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(p)
+}
+
+// upgrade downloads the agent binary from the upgrade location, verifies its
+// checksum, signature and that it runs, and replaces the agent binary with it.
+// The new binary is run when the agent is terminated.
+func (h *upgradeHandler) upgrade(w http.ResponseWriter, r *http.Request) {
+	if h.location == "" {
+		render.Status(r, http.StatusForbidden)
+		render.Respond(w, r, errors.New("upgrades are disabled, set upgrade location in agent configuration"))
+		return
+	}
+	if h.publicKeyFile == "" {
+		render.Status(r, http.StatusForbidden)
+		render.Respond(w, r, errors.New("upgrades are disabled, set upgrade public_key_file in agent configuration"))
+		return
+	}
+
+	var u models.Upgrade
+	if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, errors.Wrap(err, "decode upgrade"))
+		return
+	}
+	if !baseNameRegexp.MatchString(u.Name) {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, errors.Errorf("invalid name %q", u.Name))
+		return
+	}
+	sum, err := hex.DecodeString(u.Sha256)
+	if err != nil || len(sum) != sha256.Size {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, errors.Errorf("invalid sha256 %q", u.Sha256))
+		return
+	}
+
+	binary, err := h.binary()
+	if err != nil {
+		render.Status(r, http.StatusInternalServerError)
+		render.Respond(w, r, errors.Wrap(err, "get agent binary path"))
+		return
+	}
+
+	version, err := h.install(r.Context(), binary, u.Name, sum)
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.Respond(w, r, errors.Wrapf(err, "upgrade to %s", u.Name))
+		return
+	}
+	upgradedBinary.Store(binary)
+
+	render.Respond(w, r, models.UpgradeResult{
+		Version: version,
+	})
+}
+
+// install downloads the named file next to the binary, verifies it and
+// atomically replaces the binary with it. The agent user must be allowed to
+// write to the binary directory, packages run the agent from an agent owned
+// copy of the binary in /var/lib/scylla-manager/bin.
+func (h *upgradeHandler) install(ctx context.Context, binary, name string, sum []byte) (version string, err error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(binary), "."+filepath.Base(binary)+".upgrade-*")
+	if err != nil {
+		return "", errors.Wrap(err, "agent binary directory must be writable by the agent user")
+	}
+	defer func() {
+		tmp.Close()
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	rc, err := h.open(ctx, h.location, name)
+	if err != nil {
+		return "", errors.Wrap(err, "open")
+	}
+	defer rc.Close()
+
+	d := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, d), rc); err != nil {
+		return "", errors.Wrap(err, "download")
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	digest := d.Sum(nil)
+	if !bytes.Equal(digest, sum) {
+		return "", errors.Errorf("checksum mismatch, got %x", digest)
+	}
+
+	if err := h.verifySignature(ctx, name, digest); err != nil {
+		return "", errors.Wrap(err, "verify signature")
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return "", err
+	}
+	if version, err = h.version(ctx, tmp.Name()); err != nil {
+		return "", errors.Wrap(err, "check version")
+	}
+	if err := os.Rename(tmp.Name(), binary); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// verifySignature checks that <name>.sig file in the upgrade location
+// contains base64 encoded ed25519 signature of the digest.
+func (h *upgradeHandler) verifySignature(ctx context.Context, name string, digest []byte) error {
+	key, err := readPublicKey(h.publicKeyFile)
+	if err != nil {
+		return err
+	}
+
+	rc, err := h.open(ctx, h.location, name+".sig")
+	if err != nil {
+		return errors.Wrap(err, "open signature")
+	}
+	defer rc.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(rc, 1024))
+	if err != nil {
+		return errors.Wrap(err, "read signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return errors.Wrap(err, "decode signature")
+	}
+
+	if !ed25519.Verify(key, digest, sig) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func readPublicKey(file string) (ed25519.PublicKey, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.Errorf("no PEM data in %s", file)
+	}
+	k, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse %s", file)
+	}
+	key, ok := k.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("%s is not an ed25519 public key", file)
+	}
+	return key, nil
+}
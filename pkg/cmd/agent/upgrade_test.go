@@ -0,0 +1,211 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
+)
+
+func TestUpgrade(t *testing.T) {
+	newBinary := []byte("new agent")
+	sum := sha256.Sum256(newBinary)
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	validSig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, sum[:]))
+	invalidSig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte("other")))
+
+	table := []struct {
+		Name      string
+		Upgrade     models.Upgrade
+		NoPublicKey bool
+		Signature   string
+		Code        int
+	}{
+		{
+			Name:      "ok",
+			Upgrade:   models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: hex.EncodeToString(sum[:])},
+			Signature: validSig,
+			Code:      http.StatusOK,
+		},
+		{
+			Name:      "checksum mismatch",
+			Upgrade:   models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: hex.EncodeToString(make([]byte, sha256.Size))},
+			Signature: validSig,
+			Code:      http.StatusBadRequest,
+		},
+		{
+			Name:      "invalid checksum",
+			Upgrade:   models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: "abc"},
+			Signature: validSig,
+			Code:      http.StatusBadRequest,
+		},
+		{
+			Name:      "invalid name",
+			Upgrade:   models.Upgrade{Name: "../scylla-manager-agent", Sha256: hex.EncodeToString(sum[:])},
+			Signature: validSig,
+			Code:      http.StatusBadRequest,
+		},
+		{
+			Name:      "not found",
+			Upgrade:   models.Upgrade{Name: "scylla-manager-agent-3.1.2", Sha256: hex.EncodeToString(sum[:])},
+			Signature: validSig,
+			Code:      http.StatusBadRequest,
+		},
+		{
+			Name:      "invalid signature",
+			Upgrade:   models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: hex.EncodeToString(sum[:])},
+			Signature: invalidSig,
+			Code:      http.StatusBadRequest,
+		},
+		{
+			Name:    "missing signature",
+			Upgrade: models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: hex.EncodeToString(sum[:])},
+			Code:    http.StatusBadRequest,
+		},
+		{
+			Name:        "no public key",
+			Upgrade:     models.Upgrade{Name: "scylla-manager-agent-3.1.1", Sha256: hex.EncodeToString(sum[:])},
+			NoPublicKey: true,
+			Signature:   validSig,
+			Code:        http.StatusForbidden,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			defer upgradedBinary.Store("")
+
+			dir := t.TempDir()
+			binary := filepath.Join(dir, "scylla-manager-agent")
+			if err := ioutil.WriteFile(binary, []byte("old agent"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+
+			files := map[string][]byte{
+				"scylla-manager-agent-3.1.1": newBinary,
+			}
+			if test.Signature != "" {
+				files["scylla-manager-agent-3.1.1.sig"] = []byte(test.Signature + "\n")
+			}
+
+			h := upgradeHandler{
+				location:      "s3:agent",
+				publicKeyFile: keyFile,
+				binary: func() (string, error) {
+					return binary, nil
+				},
+				open: func(ctx context.Context, location, name string) (io.ReadCloser, error) {
+					b, ok := files[name]
+					if !ok {
+						return nil, errors.New("object not found")
+					}
+					return ioutil.NopCloser(bytes.NewReader(b)), nil
+				},
+				version: func(ctx context.Context, path string) (string, error) {
+					return "3.1.1", nil
+				},
+			}
+
+			if test.NoPublicKey {
+				h.publicKeyFile = ""
+			}
+
+			b, _ := json.Marshal(test.Upgrade)
+			w := httptest.NewRecorder()
+			h.upgrade(w, httptest.NewRequest(http.MethodPost, "/upgrade", bytes.NewReader(b)))
+			if w.Code != test.Code {
+				t.Fatalf("Response Code=%d expected %d", w.Code, test.Code)
+			}
+
+			content, err := ioutil.ReadFile(binary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries, err := ioutil.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t.Errorf("Temporary files left in %s", dir)
+			}
+
+			if test.Code == http.StatusOK {
+				if !bytes.Equal(content, newBinary) {
+					t.Errorf("Binary not replaced")
+				}
+				if upgradedBinary.Load() != binary {
+					t.Errorf("upgradedBinary=%s expected %s", upgradedBinary.Load(), binary)
+				}
+				var res models.UpgradeResult
+				if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+					t.Fatal(err)
+				}
+				if res.Version != "3.1.1" {
+					t.Errorf("Version=%s expected 3.1.1", res.Version)
+				}
+			} else {
+				if string(content) != "old agent" {
+					t.Errorf("Binary replaced")
+				}
+				if upgradedBinary.Load() != "" {
+					t.Errorf("upgradedBinary set")
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeDisabled(t *testing.T) {
+	h := upgradeHandler{}
+
+	w := httptest.NewRecorder()
+	h.upgrade(w, httptest.NewRequest(http.MethodPost, "/upgrade", bytes.NewReader([]byte("{}"))))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Response Code=%d expected %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestBinaryVersion(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "agent")
+	if err := ioutil.WriteFile(binary, []byte("#!/bin/sh\necho 3.1.1\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	v, err := binaryVersion(context.Background(), binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "3.1.1" {
+		t.Errorf("binaryVersion()=%s expected 3.1.1", v)
+	}
+	os.Remove(binary)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
 	"github.com/scylladb/go-set/strset"
 	"github.com/scylladb/scylla-manager/pkg/managerclient"
 	"github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
@@ -36,6 +37,62 @@ var backupCmd = &cobra.Command{
 }
 
 func backupTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
+	if err := backupFlagsUpdate(t, cmd); err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			showTables, err := cmd.Flags().GetBool("show-tables")
+			if err != nil {
+				return err
+			}
+
+			stillWaiting := atomic.NewBool(true)
+			time.AfterFunc(5*time.Second, func() {
+				if stillWaiting.Load() {
+					fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: this may take a while, we are performing disk size calculations on the nodes\n")
+				}
+			})
+
+			res, err := client.GetBackupTarget(ctx, clusterID, t)
+			stillWaiting.Store(false)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, backup is not scheduled\n\n")
+			if showTables {
+				res.ShowTables = -1
+			}
+			return res.Render(cmd.OutOrStdout())
+		}
+
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
+
+		return nil
+	})
+}
+
+// backupFlagsUpdate sets schedule and properties of the backup task based on
+// flags.
+func backupFlagsUpdate(t *managerclient.Task, cmd *cobra.Command) error {
 	if err := commonFlagsUpdate(t, cmd); err != nil {
 		return err
 	}
@@ -58,7 +115,7 @@ func backupTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
 		props["retention"] = retention
 	}
 
-	for _, name := range []string{"rate-limit", "snapshot-parallel", "upload-parallel"} {
+	for _, name := range []string{"rate-limit", "snapshot-parallel", "upload-parallel", "storage-class"} {
 		if f := cmd.Flag(name); f.Changed {
 			v, err := cmd.Flags().GetStringSlice(name)
 			if err != nil {
@@ -68,59 +125,89 @@ func backupTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
 		}
 	}
 
-	if f := cmd.Flag("purge-only"); f.Changed {
-		ok, err := cmd.Flags().GetBool("purge-only")
+	if f := cmd.Flag("rate-limit-schedule"); f.Changed {
+		v, err := cmd.Flags().GetString("rate-limit-schedule")
 		if err != nil {
 			return err
 		}
-		props["purge_only"] = ok
+		props["rate_limit_schedule"] = strings.Fields(v)
 	}
 
-	t.Properties = props
-
-	dryRun, err := cmd.Flags().GetBool("dry-run")
-	if err != nil {
-		return err
-	}
-
-	if dryRun {
-		showTables, err := cmd.Flags().GetBool("show-tables")
+	if f := cmd.Flag("object-tags"); f.Changed {
+		ok, err := cmd.Flags().GetBool("object-tags")
 		if err != nil {
 			return err
 		}
+		props["object_tags"] = ok
+	}
 
-		stillWaiting := atomic.NewBool(true)
-		time.AfterFunc(5*time.Second, func() {
-			if stillWaiting.Load() {
-				fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: this may take a while, we are performing disk size calculations on the nodes\n")
+	for _, name := range []string{"lock-period", "lock-mode"} {
+		if f := cmd.Flag(name); f.Changed {
+			v, err := cmd.Flags().GetString(name)
+			if err != nil {
+				return err
 			}
-		})
-
-		res, err := client.GetBackupTarget(ctx, cfgCluster, t)
-		stillWaiting.Store(false)
-		if err != nil {
-			return err
+			props[strings.Replace(name, "-", "_", 1)] = v
 		}
+	}
 
-		fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, backup is not scheduled\n\n")
-		if showTables {
-			res.ShowTables = -1
+	for _, name := range []string{"stagger-dc", "single-replica", "purge-only"} {
+		if f := cmd.Flag(name); f.Changed {
+			ok, err := cmd.Flags().GetBool(name)
+			if err != nil {
+				return err
+			}
+			props[strings.Replace(name, "-", "_", 1)] = ok
 		}
-		return res.Render(cmd.OutOrStdout())
 	}
 
-	if t.ID == "" {
-		id, err := client.CreateTask(ctx, cfgCluster, t)
+	if err := backupHooksUpdate(props, cmd); err != nil {
+		return err
+	}
+
+	t.Properties = props
+
+	return nil
+}
+
+// backupHooksUpdate merges hook flags with hooks properties of the task,
+// setting a hook flag to an empty string removes the hook.
+func backupHooksUpdate(props map[string]interface{}, cmd *cobra.Command) error {
+	hooks, ok := props["hooks"].(map[string]interface{})
+	if !ok {
+		hooks = make(map[string]interface{})
+	}
+
+	changed := false
+	for flag, key := range map[string]string{
+		"pre-snapshot-hook":   "pre_snapshot",
+		"post-snapshot-hook":  "post_snapshot",
+		"post-upload-hook":    "post_upload",
+		"hook-failure-policy": "on_failure",
+	} {
+		f := cmd.Flag(flag)
+		if f == nil || !f.Changed {
+			continue
+		}
+		v, err := cmd.Flags().GetString(flag)
 		if err != nil {
 			return err
 		}
-		t.ID = id.String()
-	} else if err := client.UpdateTask(ctx, cfgCluster, t); err != nil {
-		return err
+		if v == "" {
+			delete(hooks, key)
+		} else {
+			hooks[key] = v
+		}
+		changed = true
 	}
 
-	fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, t.ID))
-
+	if changed {
+		if len(hooks) == 0 {
+			delete(props, "hooks")
+		} else {
+			props["hooks"] = hooks
+		}
+	}
 	return nil
 }
 
@@ -163,6 +250,14 @@ func commonFlagsUpdate(t *managerclient.Task, cmd *cobra.Command) error {
 		t.Schedule.NumRetries = nr
 	}
 
+	if f := cmd.Flag("window"); f.Changed {
+		w, err := cmd.Flags().GetStringSlice("window")
+		if err != nil {
+			return err
+		}
+		t.Schedule.Window = w
+	}
+
 	if f := cmd.Flag("keyspace"); f != nil && f.Changed {
 		keyspace, err := cmd.Flags().GetStringSlice("keyspace")
 		if err != nil {
@@ -374,6 +469,80 @@ func init() {
 	register(cmd, backupCmd)
 }
 
+var backupDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compares two backup snapshots",
+	Long: `Compares files of two backup snapshots based on their manifests.
+
+Manifests created by older versions do not record sizes of files, for them sizes are estimated as the average file size of a table and are marked with ~.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			location     []string
+			allClusters  bool
+			keyspace     []string
+			snapshotTags []string
+			showNodes    bool
+
+			err error
+		)
+
+		location, err = cmd.Flags().GetStringSlice("location")
+		if err != nil {
+			return err
+		}
+		allClusters, err = cmd.Flags().GetBool("all-clusters")
+		if err != nil {
+			return err
+		}
+		keyspace, err = cmd.Flags().GetStringSlice("keyspace")
+		if err != nil {
+			return err
+		}
+		snapshotTags, err = cmd.Flags().GetStringSlice("snapshot-tag")
+		if err != nil {
+			return err
+		}
+		if len(snapshotTags) != 2 {
+			return errors.New("exactly two snapshot tags are required")
+		}
+		showNodes, err = cmd.Flags().GetBool("show-nodes")
+		if err != nil {
+			return err
+		}
+
+		stillWaiting := atomic.NewBool(true)
+		time.AfterFunc(5*time.Second, func() {
+			if stillWaiting.Load() {
+				fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: this may take a while, we are reading metadata from backup location(s)\n")
+			}
+		})
+
+		diff, err := client.GetBackupDiff(ctx, cfgCluster, location, allClusters, keyspace, snapshotTags)
+		stillWaiting.Store(false)
+		if err != nil {
+			return err
+		}
+		diff.ShowNodes = showNodes
+
+		return diff.Render(cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	cmd := backupDiffCmd
+	fs := cmd.Flags()
+	fs.StringSliceP("location", "L", nil,
+		"comma-separated `list` of backup locations in the format [<dc>:]<provider>:<name> e.g. s3:my-bucket, the supported providers are: "+strings.Join(backupspec.Providers(), ", ")+". The <dc>: part is optional and is only needed when different datacenters are being used to upload data to different locations") // nolint: lll
+	fs.Bool("all-clusters", false,
+		"compare backups of any cluster stored in location")
+	fs.StringSliceP("keyspace", "K", nil,
+		"comma-separated `list` of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from comparison")
+	fs.StringSliceP("snapshot-tag", "T", nil, "snapshot `tag` as read from backup listing, specify the older and then the newer snapshot")
+	fs.Bool("show-nodes", false, "print comparison of tables of every node")
+	requireFlags(cmd, "snapshot-tag")
+	register(cmd, backupCmd)
+}
+
 var backupDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Deletes backup snapshot",
@@ -444,6 +613,58 @@ func init() {
 	register(cmd, backupCmd)
 }
 
+var backupEstimateCmd = &cobra.Command{
+	Use:   "estimate [<type/task-id>]",
+	Short: "Estimates storage usage and cost of backups",
+	Long: `Estimates storage usage, requests and monthly cost of backups in every location.
+The estimate is based on the current snapshot size, the part of data uploaded by previous backups of the cluster, the retention and the interval.
+If task is specified, the flags override its properties.`,
+	Args: cobra.MaximumNArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t := &managerclient.Task{
+			Type:       "backup",
+			Enabled:    true,
+			Schedule:   new(managerclient.Schedule),
+			Properties: make(map[string]interface{}),
+		}
+		if len(args) > 0 {
+			taskType, taskID, err := managerclient.TaskSplit(args[0])
+			if err != nil {
+				return err
+			}
+			if scheduler.TaskType(taskType) != scheduler.BackupTask {
+				return fmt.Errorf("backup estimate can't handle %s task", taskType)
+			}
+			if t, err = client.GetTask(ctx, cfgCluster, taskType, taskID); err != nil {
+				return err
+			}
+		}
+
+		if err := backupFlagsUpdate(t, cmd); err != nil {
+			return err
+		}
+
+		return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+			res, err := client.GetBackupEstimate(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			return res.Render(cmd.OutOrStdout())
+		})
+	},
+}
+
+func init() {
+	cmd := backupEstimateCmd
+	fs := backupFlags(cmd)
+	taskInitCommonFlags(fs)
+	for _, name := range []string{"dry-run", "show-tables", "start-date", "num-retries", "window"} {
+		fs.MarkHidden(name) // nolint: errcheck
+	}
+	register(cmd, backupCmd)
+}
+
 func backupFlags(cmd *cobra.Command) *pflag.FlagSet {
 	fs := cmd.Flags()
 	fs.StringSliceP("keyspace", "K", nil,
@@ -456,10 +677,32 @@ func backupFlags(cmd *cobra.Command) *pflag.FlagSet {
 		"number of backups which are to be stored")
 	fs.StringSlice("rate-limit", nil,
 		"comma-separated `list` of megabytes (MiB) per second rate limits expressed in the format [<dc>:]<limit>. The <dc>: part is optional and only needed when different datacenters need different upload limits. Set to 0 for no limit (default 100)") // nolint: lll
+	fs.String("rate-limit-schedule", "",
+		rateLimitScheduleUsage)
 	fs.StringSlice("snapshot-parallel", nil,
 		"comma-separated `list` of snapshot parallelism limits in the format [<dc>:]<limit>. The <dc>: part is optional and allows for specifying different limits in selected datacenters. If The <dc>: part is not set, the limit is global (e.g. 'dc1:2,5') the runs are parallel in n nodes (2 in dc1) and n nodes in all the other datacenters") // nolint: lll
 	fs.StringSlice("upload-parallel", nil,
 		"comma-separated `list` of upload parallelism limits in the format [<dc>:]<limit>. The <dc>: part is optional and allows for specifying different limits in selected datacenters. If The <dc>: part is not set the limit is global (e.g. 'dc1:2,5') the runs are parallel in n nodes (2 in dc1) and n nodes in all the other datacenters") // nolint: lll
+	fs.StringSlice("storage-class", nil,
+		"comma-separated `list` of storage classes of uploaded SSTables in the format [<dc>:]<class> e.g. 'STANDARD_IA' for S3, 'NEARLINE' for GCS or 'Cool' for Azure. The <dc>: part is optional and only needed when locations of different datacenters need different storage classes. Manifests and schema are always uploaded with the default storage class of the provider") // nolint: lll
+	fs.Bool("object-tags", false,
+		"set cluster ID, task ID and snapshot tag as metadata and tags (S3 only) of uploaded SSTables so that bucket lifecycle rules can act on them, tagging S3 objects requires s3:PutObjectTagging permission") // nolint: lll
+	fs.String("lock-period", "",
+		"`duration` for which uploaded files can't be deleted or overwritten counting from the snapshot time e.g. 30d, backups are not purged before the lock expires. S3 buckets need object lock enabled, GCS buckets need retention policy and Azure containers need immutability policy covering the period") // nolint: lll
+	fs.String("lock-mode", "",
+		"S3 object lock retention `mode`, governance or compliance, in compliance mode no one can remove the lock (default governance)")
+	fs.Bool("stagger-dc", false,
+		"back up datacenters one after another, snapshot and upload of a datacenter starts when upload of the previous one is done so that only one datacenter bears the I/O at a time") // nolint: lll
+	fs.Bool("single-replica", false,
+		"back up only nodes holding a single replica of every token range, it gives a complete but smaller backup, manifests of such backup are marked as partial") // nolint: lll
+	fs.String("pre-snapshot-hook", "",
+		"`name` of an executable in the agent hooks directory to run on every node before taking a snapshot, e.g. to pause ingest or flush application caches")
+	fs.String("post-snapshot-hook", "",
+		"`name` of an executable in the agent hooks directory to run on every node after taking a snapshot, it runs even if the pre snapshot hook or snapshot failed")
+	fs.String("post-upload-hook", "",
+		"`name` of an executable in the agent hooks directory to run on every node after the node's snapshot files are uploaded")
+	fs.String("hook-failure-policy", "",
+		"what to do when a hook fails or returns non-zero exit code, abort or continue the backup (default abort)")
 	fs.Bool("dry-run", false,
 		"validate and print backup information without scheduling a backup")
 	fs.Bool("show-tables", false, "print all table names for a keyspace. Used only in conjunction with --dry-run")
@@ -471,6 +714,42 @@ func backupFlags(cmd *cobra.Command) *pflag.FlagSet {
 	return fs
 }
 
+const rateLimitScheduleUsage = "space-separated `list` of time of day rate limits in the format [<dc>:]<hh:mm>,<limit> e.g. 'dc1:08:00,50 dc1:20:00,0', a limit applies from the given time (UTC) until the next limit in the DC. The <dc>: part is optional, if it's not set the schedule applies to datacenters without a schedule. The schedule takes precedence over --rate-limit" // nolint: lll
+
+var backupControlCmd = &cobra.Command{
+	Use:   "control",
+	Short: "Changes rate limits of a running backup",
+	Long: `Changes rate limits of a running backup.
+The rate limits and rate limit schedule replace the ones of the backup task
+for the rest of the run, limits are applied on the nodes immediately.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flag("rate-limit").Changed && !cmd.Flag("rate-limit-schedule").Changed {
+			return errors.New("at least one of rate-limit or rate-limit-schedule flags needs to be specified")
+		}
+
+		rateLimit, err := cmd.Flags().GetStringSlice("rate-limit")
+		if err != nil {
+			return err
+		}
+		rateLimitSchedule, err := cmd.Flags().GetString("rate-limit-schedule")
+		if err != nil {
+			return err
+		}
+
+		return client.SetBackupRateLimit(ctx, cfgCluster, rateLimit, strings.Fields(rateLimitSchedule))
+	},
+}
+
+func init() {
+	cmd := backupControlCmd
+	fs := cmd.Flags()
+	fs.StringSlice("rate-limit", nil,
+		"comma-separated `list` of megabytes (MiB) per second rate limits expressed in the format [<dc>:]<limit>. Set to 0 for no limit")
+	fs.String("rate-limit-schedule", "",
+		rateLimitScheduleUsage)
+	register(cmd, backupCmd)
+}
+
 var backupValidateCmd = &cobra.Command{
 	Use:   "validate",
 	Short: "Validates backup files in remote locations",
@@ -526,7 +805,7 @@ func init() {
 	cmd := backupValidateCmd
 	fs := cmd.Flags()
 	fs.StringSliceP("location", "L", nil,
-		"comma-separated `list` of backup locations in the format [<dc>:]<provider>:<name> e.g. s3:my-bucket. The <dc>: part is optional and is only needed when different datacenters are being used to upload data to different locations. The supported providers are: s3, gcs, azure") // nolint: lll
+		"comma-separated `list` of backup locations in the format [<dc>:]<provider>:<name> e.g. s3:my-bucket. The <dc>: part is optional and is only needed when different datacenters are being used to upload data to different locations. The supported providers are: "+strings.Join(backupspec.Providers(), ", ")) // nolint: lll
 	fs.Bool("delete-orphaned-files", false, "delete data files not belonging to any snapshot if they are found")
 	fs.Int("parallel", 0, "number of hosts to analyze in parallel")
 	taskInitCommonFlagsWithParams(fs, 0)
@@ -32,6 +32,11 @@ var (
 	cfgClusterPassword        string
 	cfgClusterSSLUserCertFile string
 	cfgClusterSSLUserKeyFile  string
+	cfgClusterLabels          map[string]string
+
+	cfgClusterAgentTLSCertFile string
+	cfgClusterAgentTLSKeyFile  string
+	cfgClusterAgentTLSCAFile   string
 )
 
 func clusterInitCommonFlags(cmd *cobra.Command) {
@@ -43,6 +48,46 @@ func clusterInitCommonFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&cfgClusterPassword, "password", "p", "", "CQL `password` associated with user")
 	cmd.Flags().StringVar(&cfgClusterSSLUserCertFile, "ssl-user-cert-file", "", "`path` to client certificate when using client/server encryption with require_client_auth enabled")
 	cmd.Flags().StringVar(&cfgClusterSSLUserKeyFile, "ssl-user-key-file", "", "`path` to key associated with ssl-user-cert-file")
+	cmd.Flags().StringVar(&cfgClusterAgentTLSCertFile, "agent-tls-cert-file", "", "`path` to client certificate presented to Scylla Manager Agents that require client certificates")
+	cmd.Flags().StringVar(&cfgClusterAgentTLSKeyFile, "agent-tls-key-file", "", "`path` to key associated with agent-tls-cert-file")
+	cmd.Flags().StringVar(&cfgClusterAgentTLSCAFile, "agent-tls-ca-file", "", "`path` to CA certificates used to verify Scylla Manager Agent certificates, agent certificates are not verified if not set")
+	cmd.Flags().StringToStringVar(&cfgClusterLabels, "label", nil, "cluster `labels` in key=value format, e.g. env=prod,team=payments, used to select clusters with the --selector flag")
+}
+
+// clusterSetAgentTLS sets agent TLS files of the cluster from flags,
+// it returns true if any of the files was set.
+func clusterSetAgentTLS(c *managerclient.Cluster) (bool, error) {
+	if cfgClusterAgentTLSCertFile != "" && cfgClusterAgentTLSKeyFile == "" {
+		return false, errors.New("missing flag \"agent-tls-key-file\"")
+	}
+	if cfgClusterAgentTLSKeyFile != "" && cfgClusterAgentTLSCertFile == "" {
+		return false, errors.New("missing flag \"agent-tls-cert-file\"")
+	}
+
+	ok := false
+	if cfgClusterAgentTLSCertFile != "" {
+		b0, err := readFile(cfgClusterAgentTLSCertFile)
+		if err != nil {
+			return false, err
+		}
+		c.AgentTLSCertFile = b0
+
+		b1, err := readFile(cfgClusterAgentTLSKeyFile)
+		if err != nil {
+			return false, err
+		}
+		c.AgentTLSKeyFile = b1
+		ok = true
+	}
+	if cfgClusterAgentTLSCAFile != "" {
+		b, err := readFile(cfgClusterAgentTLSCAFile)
+		if err != nil {
+			return false, err
+		}
+		c.AgentTLSCaFile = b
+		ok = true
+	}
+	return ok, nil
 }
 
 func clusterAddedMessage(w io.Writer, id, name string) error {
@@ -102,6 +147,7 @@ var clusterAddCmd = &cobra.Command{
 			AuthToken: cfgClusterAuthToken,
 			Username:  cfgClusterUsername,
 			Password:  cfgClusterPassword,
+			Labels:    cfgClusterLabels,
 		}
 		if cfgClusterPort != 10001 {
 			c.Port = cfgClusterPort
@@ -134,6 +180,10 @@ var clusterAddCmd = &cobra.Command{
 			c.SslUserKeyFile = b1
 		}
 
+		if _, err := clusterSetAgentTLS(c); err != nil {
+			return err
+		}
+
 		withoutRepair, err := cmd.Flags().GetBool("without-repair")
 		if err != nil {
 			return err
@@ -205,6 +255,25 @@ var clusterUpdateCmd = &cobra.Command{
 			cluster.AuthToken = cfgClusterAuthToken
 			ok = true
 		}
+		if cmd.Flags().Changed("label") {
+			if cluster.Labels == nil {
+				cluster.Labels = make(map[string]string, len(cfgClusterLabels))
+			}
+			for k, v := range cfgClusterLabels {
+				cluster.Labels[k] = v
+			}
+			ok = true
+		}
+		if cmd.Flags().Changed("delete-label") {
+			keys, err := cmd.Flags().GetStringSlice("delete-label")
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				delete(cluster.Labels, k)
+			}
+			ok = true
+		}
 
 		if cfgClusterUsername != "" && cfgClusterPassword == "" {
 			return errors.New("missing flag \"password\"")
@@ -236,9 +305,18 @@ var clusterUpdateCmd = &cobra.Command{
 			ok = true
 		}
 
+		agentTLS, err := clusterSetAgentTLS(cluster)
+		if err != nil {
+			return err
+		}
+		if agentTLS {
+			ok = true
+		}
+
 		var (
 			deleteCQLCredentials bool
 			deleteSSLUserCert    bool
+			deleteAgentTLS       bool
 		)
 		if cmd.Flags().Changed("delete-cql-credentials") {
 			deleteCQLCredentials = true
@@ -246,12 +324,15 @@ var clusterUpdateCmd = &cobra.Command{
 		if cmd.Flags().Changed("delete-ssl-user-cert") {
 			deleteSSLUserCert = true
 		}
+		if cmd.Flags().Changed("delete-agent-tls") {
+			deleteAgentTLS = true
+		}
 
-		if !ok && !deleteCQLCredentials && !deleteSSLUserCert {
+		if !ok && !deleteCQLCredentials && !deleteSSLUserCert && !deleteAgentTLS {
 			return errors.New("nothing to do")
 		}
 
-		if err := client.DeleteClusterSecrets(ctx, cfgCluster, deleteCQLCredentials, deleteSSLUserCert); err != nil {
+		if err := client.DeleteClusterSecrets(ctx, cfgCluster, deleteCQLCredentials, deleteSSLUserCert, deleteAgentTLS); err != nil {
 			return err
 		}
 		return client.UpdateCluster(ctx, cluster)
@@ -263,6 +344,8 @@ func init() {
 	clusterInitCommonFlags(cmd)
 	cmd.Flags().Bool("delete-cql-credentials", false, "delete CQL username and password if added, features that require CQL may not work")
 	cmd.Flags().Bool("delete-ssl-user-cert", false, "delete SSL user certificate if added")
+	cmd.Flags().Bool("delete-agent-tls", false, "delete agent client certificate and CA if added")
+	cmd.Flags().StringSlice("delete-label", nil, "comma-separated `list` of label keys to delete")
 	register(cmd, clusterCmd)
 }
 
@@ -280,6 +363,32 @@ func init() {
 	register(cmd, clusterCmd)
 }
 
+var clusterRotateTokenCmd = &cobra.Command{
+	Use:   "rotate-token",
+	Short: "Switches cluster to a new agent auth token",
+	Long: `Switches cluster to a new agent auth token.
+The token is saved as pending and checked against agents on all nodes,
+the current token is retired only if all agents accept the new token.
+Add the new token to auth_tokens in agent config files and reload the agents
+before running this command. If some agents reject the token, fix their config
+and run this command again without --token to retry with the pending token.`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if cancel, _ := cmd.Flags().GetBool("cancel"); cancel {
+			return client.CancelAuthTokenRotation(ctx, cfgCluster)
+		}
+		token, _ := cmd.Flags().GetString("token")
+		return client.RotateAuthToken(ctx, cfgCluster, token)
+	},
+}
+
+func init() {
+	cmd := clusterRotateTokenCmd
+	cmd.Flags().String("token", "", "new agent auth `token`, if not set the pending token is used")
+	cmd.Flags().Bool("cancel", false, "remove the pending token and keep using the current one")
+	register(cmd, clusterCmd)
+}
+
 var clusterListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Shows managed clusters",
@@ -0,0 +1,150 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/scylladb/scylla-manager/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var compactionCmd = &cobra.Command{
+	Use:   "compaction",
+	Short: "Schedules major compactions",
+	Long: `Schedules major compactions
+
+Major compaction merges all SSTables of a table into one, it purges expired data and tombstones that are eligible for garbage collection.
+Data centers are compacted in parallel, the --parallel flag specifies how many nodes in a data center are compacted at the same time.
+Before a node is compacted it's checked if it has enough free disk space to rewrite the largest of the tables, compaction of nodes without the headroom fails.
+Use the --window flag to run compaction only in maintenance windows, when a window ends compaction is stopped and it continues in the next window.`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t := &managerclient.Task{
+			Type:       "compaction",
+			Enabled:    true,
+			Schedule:   new(managerclient.Schedule),
+			Properties: make(map[string]interface{}),
+		}
+
+		return compactionTaskUpdate(t, cmd)
+	},
+}
+
+func compactionTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
+	if err := commonFlagsUpdate(t, cmd); err != nil {
+		return err
+	}
+
+	props := t.Properties.(map[string]interface{})
+
+	if f := cmd.Flag("parallel"); f.Changed {
+		parallel, err := cmd.Flags().GetStringSlice("parallel")
+		if err != nil {
+			return err
+		}
+		props["parallel"] = parallel
+	}
+
+	if f := cmd.Flag("continue"); f.Changed {
+		c, err := cmd.Flags().GetBool("continue")
+		if err != nil {
+			return err
+		}
+		props["continue"] = c
+	}
+
+	t.Properties = props
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			res, err := client.GetCompactionTarget(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			showTables, err := cmd.Flags().GetBool("show-tables")
+			if err != nil {
+				return err
+			}
+			if showTables {
+				res.ShowTables = -1
+			}
+
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, compaction is not scheduled\n\n")
+			return res.Render(cmd.OutOrStdout())
+		}
+
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
+
+		return nil
+	})
+}
+
+func init() {
+	cmd := compactionCmd
+	taskInitCommonFlags(compactionFlags(cmd))
+	register(cmd, rootCmd)
+}
+
+func compactionFlags(cmd *cobra.Command) *pflag.FlagSet {
+	fs := cmd.Flags()
+	fs.StringSliceP("keyspace", "K", nil,
+		"comma-separated `list` of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from compaction")
+	fs.StringSlice("dc", nil, "comma-separated `list` of datacenter glob patterns, e.g. 'dc1,!otherdc*', used to specify the DCs to include or exclude from compaction")
+	fs.StringSlice("parallel", nil,
+		"comma-separated `list` of compaction parallelism limits in the format [<dc>:]<limit>. The <dc>: part is optional and allows for specifying different limits in selected datacenters. If the <dc>: part is not set the limit is global (e.g. 'dc1:2,5'). Set to 0 to compact all nodes in a datacenter at once (default 1)") // nolint: lll
+	fs.Bool("continue", true, "resume the last unfinished run skipping tables already compacted")
+	fs.Bool("dry-run", false, "validate and print compaction information without scheduling a compaction")
+	fs.Bool("show-tables", false, "print all table names for a keyspace. Used only in conjunction with --dry-run")
+	return fs
+}
+
+var compactionUpdateCmd = &cobra.Command{
+	Use:   "update <type/task-id>",
+	Short: "Modifies a compaction task",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskType, taskID, err := managerclient.TaskSplit(args[0])
+		if err != nil {
+			return err
+		}
+
+		if scheduler.TaskType(taskType) != scheduler.CompactionTask {
+			return fmt.Errorf("compaction update can't handle %s task", taskType)
+		}
+
+		t, err := client.GetTask(ctx, cfgCluster, taskType, taskID)
+		if err != nil {
+			return err
+		}
+
+		return compactionTaskUpdate(t, cmd)
+	},
+}
+
+func init() {
+	cmd := compactionUpdateCmd
+	fs := compactionFlags(cmd)
+	fs.StringP("enabled", "e", "true", "enabled")
+	taskInitCommonFlags(fs)
+	register(cmd, compactionCmd)
+}
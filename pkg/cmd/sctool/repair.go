@@ -117,36 +117,39 @@ func repairTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
 		return err
 	}
 
-	if dryRun {
-		res, err := client.GetRepairTarget(ctx, cfgCluster, t)
-		if err != nil {
-			return err
-		}
-		showTables, err := cmd.Flags().GetBool("show-tables")
-		if err != nil {
-			return err
-		}
-		if showTables {
-			res.ShowTables = -1
-		}
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			res, err := client.GetRepairTarget(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			showTables, err := cmd.Flags().GetBool("show-tables")
+			if err != nil {
+				return err
+			}
+			if showTables {
+				res.ShowTables = -1
+			}
 
-		fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, repair is not scheduled\n\n")
-		return res.Render(cmd.OutOrStdout())
-	}
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, repair is not scheduled\n\n")
+			return res.Render(cmd.OutOrStdout())
+		}
 
-	if t.ID == "" {
-		id, err := client.CreateTask(ctx, cfgCluster, t)
-		if err != nil {
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
 			return err
 		}
-		t.ID = id.String()
-	} else if err := client.UpdateTask(ctx, cfgCluster, t); err != nil {
-		return err
-	}
 
-	fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, t.ID))
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
 
-	return nil
+		return nil
+	})
 }
 
 func init() {
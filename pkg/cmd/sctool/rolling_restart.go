@@ -0,0 +1,140 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/scylladb/scylla-manager/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var rollingRestartCmd = &cobra.Command{
+	Use:     "rolling-restart",
+	Aliases: []string{"rolling_restart"},
+	Short:   "Schedules rolling restarts of Scylla nodes",
+	Long: `Schedules rolling restarts of Scylla nodes
+
+Nodes are restarted one at a time, a node is drained and the Scylla service is restarted by Scylla Manager Agent running on the node.
+Before the next node is restarted the restarted node must respond to CQL and REST pings and all the nodes in the cluster must be UN.
+The rolling restart is aborted on the first failure, all the nodes in the cluster must be UN to start it.
+By default data centers are restarted in alphabetical order, the --dc-order flag specifies the data centers to restart first.`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t := &managerclient.Task{
+			Type:       "rolling_restart",
+			Enabled:    true,
+			Schedule:   new(managerclient.Schedule),
+			Properties: make(map[string]interface{}),
+		}
+
+		return rollingRestartTaskUpdate(t, cmd)
+	},
+}
+
+func rollingRestartTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
+	if err := commonFlagsUpdate(t, cmd); err != nil {
+		return err
+	}
+
+	props := t.Properties.(map[string]interface{})
+
+	if f := cmd.Flag("dc-order"); f.Changed {
+		dcOrder, err := cmd.Flags().GetStringSlice("dc-order")
+		if err != nil {
+			return err
+		}
+		props["dc_order"] = dcOrder
+	}
+
+	if f := cmd.Flag("continue"); f.Changed {
+		c, err := cmd.Flags().GetBool("continue")
+		if err != nil {
+			return err
+		}
+		props["continue"] = c
+	}
+
+	t.Properties = props
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			res, err := client.GetRollingRestartTarget(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, rolling restart is not scheduled\n\n")
+			return res.Render(cmd.OutOrStdout())
+		}
+
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
+
+		return nil
+	})
+}
+
+func init() {
+	cmd := rollingRestartCmd
+	taskInitCommonFlags(rollingRestartFlags(cmd))
+	register(cmd, rootCmd)
+}
+
+func rollingRestartFlags(cmd *cobra.Command) *pflag.FlagSet {
+	fs := cmd.Flags()
+	fs.StringSlice("dc", nil, "comma-separated `list` of datacenter glob patterns, e.g. 'dc1,!otherdc*', used to specify the DCs to include or exclude from rolling restart")
+	fs.StringSlice("dc-order", nil, "comma-separated `list` of datacenters in the order they are restarted, datacenters not listed are restarted afterwards in alphabetical order")
+	fs.Bool("continue", true, "resume the last unfinished run skipping nodes already restarted")
+	fs.Bool("dry-run", false, "validate and print the nodes in the restart order without scheduling a rolling restart")
+	return fs
+}
+
+var rollingRestartUpdateCmd = &cobra.Command{
+	Use:   "update <type/task-id>",
+	Short: "Modifies a rolling restart task",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskType, taskID, err := managerclient.TaskSplit(args[0])
+		if err != nil {
+			return err
+		}
+
+		if scheduler.TaskType(taskType) != scheduler.RollingRestartTask {
+			return fmt.Errorf("rolling-restart update can't handle %s task", taskType)
+		}
+
+		t, err := client.GetTask(ctx, cfgCluster, taskType, taskID)
+		if err != nil {
+			return err
+		}
+
+		return rollingRestartTaskUpdate(t, cmd)
+	},
+}
+
+func init() {
+	cmd := rollingRestartUpdateCmd
+	fs := rollingRestartFlags(cmd)
+	fs.StringP("enabled", "e", "true", "enabled")
+	taskInitCommonFlags(fs)
+	register(cmd, rollingRestartCmd)
+}
@@ -21,6 +21,7 @@ var (
 	cfgAPICertFile string
 	cfgAPIKeyFile  string
 	cfgCluster     string
+	cfgSelector    string
 
 	client managerclient.Client
 )
@@ -61,8 +62,19 @@ Documentation is available online at ` + docsBaseURL,
 		}
 		client = c
 
+		// Selector replaces cluster
+		if cfgSelector != "" {
+			if !supportsSelector(cmd) {
+				return errors.Errorf("flag \"selector\" is not supported by %q", cmd.CommandPath())
+			}
+			if cmd.Flags().Changed("cluster") {
+				return errors.New("flags \"cluster\" and \"selector\" are mutually exclusive")
+			}
+			cfgCluster = ""
+		}
+
 		// RequireFlags cluster
-		if needsCluster(cmd) {
+		if needsCluster(cmd) && cfgSelector == "" {
 			if os.Getenv("SCYLLA_MANAGER_CLUSTER") == "" {
 				if err := cmd.Root().MarkFlagRequired("cluster"); err != nil {
 					return err
@@ -82,6 +94,14 @@ func needsCluster(cmd *cobra.Command) bool {
 	return true
 }
 
+func supportsSelector(cmd *cobra.Command) bool {
+	switch cmd {
+	case statusCmd, taskListCmd, suspendCmd, resumeCmd, repairCmd, backupCmd, cleanupCmd, compactionCmd, rollingRestartCmd, snapshotCmd, sstableMaintenanceCmd:
+		return true
+	}
+	return false
+}
+
 func init() {
 	f := rootCmd.PersistentFlags()
 
@@ -102,4 +122,5 @@ func init() {
 	f.StringVar(&cfgAPIKeyFile, "api-key-file", os.Getenv("SCYLLA_MANAGER_API_KEY_FILE"), "`path` to HTTPS client key to access Scylla Manager server")
 
 	f.StringVarP(&cfgCluster, "cluster", "c", os.Getenv("SCYLLA_MANAGER_CLUSTER"), "Specifies the target cluster `name` or ID")
+	f.StringVar(&cfgSelector, "selector", "", "Specifies the target clusters by `labels`, e.g. env=prod,team!=payments, supported by status, task list, suspend, resume, repair and backup commands")
 }
@@ -0,0 +1,273 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Schedules local snapshots and manages snapshots kept on nodes",
+	Long: `Schedules local snapshots and manages snapshots kept on nodes
+
+A local snapshot is taken on all the nodes in parallel and is not uploaded to a backup location, it's meant as a fast safety net before risky operations i.e. an upgrade.
+Local snapshots are tagged sm_local_<date>UTC and are not deleted by backups, use the list, delete and clear subcommands to manage them.`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t := &managerclient.Task{
+			Type:       "snapshot",
+			Enabled:    true,
+			Schedule:   new(managerclient.Schedule),
+			Properties: make(map[string]interface{}),
+		}
+
+		return snapshotTaskUpdate(t, cmd)
+	},
+}
+
+func snapshotTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
+	if err := commonFlagsUpdate(t, cmd); err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			res, err := client.GetSnapshotTarget(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+
+			showTables, err := cmd.Flags().GetBool("show-tables")
+			if err != nil {
+				return err
+			}
+			if showTables {
+				res.ShowTables = -1
+			}
+
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, snapshot is not scheduled\n\n")
+			return res.Render(cmd.OutOrStdout())
+		}
+
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
+
+		return nil
+	})
+}
+
+func init() {
+	cmd := snapshotCmd
+	taskInitCommonFlags(snapshotFlags(cmd))
+	register(cmd, rootCmd)
+}
+
+func snapshotFlags(cmd *cobra.Command) *pflag.FlagSet {
+	fs := cmd.Flags()
+	fs.StringSliceP("keyspace", "K", nil,
+		"comma-separated `list` of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from snapshot")
+	fs.StringSlice("dc", nil,
+		"comma-separated `list` of datacenter glob patterns, e.g. 'dc1,!otherdc*' used to specify the DCs to include or exclude from snapshot")
+	fs.Bool("dry-run", false, "validate and print snapshot information without scheduling a snapshot")
+	fs.Bool("show-tables", false, "print all table names for a keyspace")
+	return fs
+}
+
+var snapshotUpdateCmd = &cobra.Command{
+	Use:   "update <type/task-id>",
+	Short: "Modifies a snapshot task",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskType, taskID, err := managerclient.TaskSplit(args[0])
+		if err != nil {
+			return err
+		}
+
+		if scheduler.TaskType(taskType) != scheduler.SnapshotTask {
+			return fmt.Errorf("snapshot update can't handle %s task", taskType)
+		}
+
+		t, err := client.GetTask(ctx, cfgCluster, taskType, taskID)
+		if err != nil {
+			return err
+		}
+
+		return snapshotTaskUpdate(t, cmd)
+	},
+}
+
+func init() {
+	cmd := snapshotUpdateCmd
+	fs := snapshotFlags(cmd)
+	fs.StringP("enabled", "e", "true", "enabled")
+	taskInitCommonFlags(fs)
+	register(cmd, snapshotCmd)
+}
+
+// snapshotFilter holds the values of flags selecting snapshots on nodes.
+type snapshotFilter struct {
+	host        []string
+	dc          []string
+	snapshotTag []string
+	olderThan   strfmt.DateTime
+}
+
+func parseSnapshotFilter(cmd *cobra.Command) (snapshotFilter, error) {
+	var (
+		f   snapshotFilter
+		err error
+	)
+
+	if f.host, err = cmd.Flags().GetStringSlice("host"); err != nil {
+		return f, err
+	}
+	if f.dc, err = cmd.Flags().GetStringSlice("dc"); err != nil {
+		return f, err
+	}
+	if cmd.Flag("snapshot-tag") != nil {
+		if f.snapshotTag, err = cmd.Flags().GetStringSlice("snapshot-tag"); err != nil {
+			return f, err
+		}
+	}
+	if v := cmd.Flag("older-than"); v.Changed {
+		d, err := duration.ParseDuration(v.Value.String())
+		if err != nil {
+			return f, err
+		}
+		if d <= 0 {
+			return f, errors.New("older-than must be greater than 0")
+		}
+		f.olderThan = strfmt.DateTime(timeutc.Now().Add(-d.Duration()))
+	}
+
+	return f, nil
+}
+
+func snapshotFilterFlags(cmd *cobra.Command) *pflag.FlagSet {
+	fs := cmd.Flags()
+	fs.StringSlice("host", nil, "comma-separated `list` of node addresses, all nodes if not specified")
+	fs.StringSlice("dc", nil,
+		"comma-separated `list` of datacenter glob patterns, e.g. 'dc1,!otherdc*' used to specify the DCs to include or exclude")
+	fs.String("older-than", "",
+		"select only snapshots taken more than the `duration` ago, e.g. 7d, valid units are d, h, m, s; snapshots with tags that do not encode the date are never selected")
+	return fs
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists snapshots kept on nodes with their sizes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := parseSnapshotFilter(cmd)
+		if err != nil {
+			return err
+		}
+
+		list, err := client.ListNodeSnapshots(ctx, cfgCluster, f.host, f.dc, f.snapshotTag, f.olderThan)
+		if err != nil {
+			return err
+		}
+
+		return list.Render(cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	cmd := snapshotListCmd
+	fs := snapshotFilterFlags(cmd)
+	fs.StringSliceP("snapshot-tag", "T", nil, "comma-separated `list` of snapshot tags")
+	register(cmd, snapshotCmd)
+}
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Deletes snapshots kept on nodes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := parseSnapshotFilter(cmd)
+		if err != nil {
+			return err
+		}
+
+		list, err := client.DeleteNodeSnapshots(ctx, cfgCluster, f.host, f.dc, f.snapshotTag, f.olderThan, false)
+		if err != nil {
+			return err
+		}
+
+		return renderDeletedSnapshots(cmd, list)
+	},
+}
+
+func init() {
+	cmd := snapshotDeleteCmd
+	fs := snapshotFilterFlags(cmd)
+	fs.StringSliceP("snapshot-tag", "T", nil, "comma-separated `list` of snapshot tags as read from snapshot listing")
+	requireFlags(cmd, "snapshot-tag")
+	register(cmd, snapshotCmd)
+}
+
+var snapshotClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Deletes all snapshots kept on nodes",
+	Long: `Deletes all snapshots kept on nodes
+
+All the snapshots, including snapshots not taken by Scylla Manager, are deleted from the selected nodes.
+Use --older-than to delete only the snapshots taken before the given time.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := parseSnapshotFilter(cmd)
+		if err != nil {
+			return err
+		}
+
+		list, err := client.DeleteNodeSnapshots(ctx, cfgCluster, f.host, f.dc, nil, f.olderThan, true)
+		if err != nil {
+			return err
+		}
+
+		return renderDeletedSnapshots(cmd, list)
+	},
+}
+
+func init() {
+	cmd := snapshotClearCmd
+	snapshotFilterFlags(cmd)
+	register(cmd, snapshotCmd)
+}
+
+// renderDeletedSnapshots prints the deleted snapshots and returns an error
+// if snapshots could not be deleted on any node.
+func renderDeletedSnapshots(cmd *cobra.Command, list managerclient.NodeSnapshotsSlice) error {
+	if err := list.Render(cmd.OutOrStdout()); err != nil {
+		return err
+	}
+	for _, n := range list {
+		if n.Error != "" {
+			return errors.New("failed to delete snapshots on some nodes")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scylladb/scylla-manager/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var sstableMaintenanceCmd = &cobra.Command{
+	Use:     "sstable-maintenance",
+	Aliases: []string{"sstable_maintenance"},
+	Short:   "Schedules SSTable scrubs and upgrades",
+	Long: `Schedules SSTable scrubs and upgrades
+
+The --mode flag specifies the operation, "scrub" checks SSTables for corruption and handles corrupted data according to the --scrub-mode flag, "upgrade" rewrites SSTables to the current SSTable format i.e. after Scylla upgrade.
+Scrub modes are "abort" that stops on the first corruption, "skip" that removes corrupted data, "segregate" that splits out of order data into separate SSTables and "validate" that only reports corruption without modifying SSTables.
+Before a keyspace is processed on a node a snapshot of the keyspace is taken, the snapshot tag is shown in the task progress, use --snapshot=false to disable it.
+Keyspaces on a node are processed one at a time, data centers are processed in parallel, the --parallel flag specifies how many nodes in a data center are processed at the same time.
+Use the --window flag to run only in maintenance windows, when a window ends the operation is stopped and it continues in the next window.`,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t := &managerclient.Task{
+			Type:       "sstable_maintenance",
+			Enabled:    true,
+			Schedule:   new(managerclient.Schedule),
+			Properties: make(map[string]interface{}),
+		}
+
+		return sstableMaintenanceTaskUpdate(t, cmd)
+	},
+}
+
+func sstableMaintenanceTaskUpdate(t *managerclient.Task, cmd *cobra.Command) error {
+	if err := commonFlagsUpdate(t, cmd); err != nil {
+		return err
+	}
+
+	props := t.Properties.(map[string]interface{})
+
+	for _, name := range []string{"mode", "scrub-mode"} {
+		if f := cmd.Flag(name); f.Changed {
+			v, err := cmd.Flags().GetString(name)
+			if err != nil {
+				return err
+			}
+			props[strings.ReplaceAll(name, "-", "_")] = v
+		}
+	}
+
+	for _, name := range []string{"include-all-sstables", "snapshot"} {
+		if f := cmd.Flag(name); f.Changed {
+			v, err := cmd.Flags().GetBool(name)
+			if err != nil {
+				return err
+			}
+			props[strings.ReplaceAll(name, "-", "_")] = v
+		}
+	}
+
+	if f := cmd.Flag("parallel"); f.Changed {
+		parallel, err := cmd.Flags().GetStringSlice("parallel")
+		if err != nil {
+			return err
+		}
+		props["parallel"] = parallel
+	}
+
+	if f := cmd.Flag("continue"); f.Changed {
+		c, err := cmd.Flags().GetBool("continue")
+		if err != nil {
+			return err
+		}
+		props["continue"] = c
+	}
+
+	t.Properties = props
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+		if dryRun {
+			res, err := client.GetSSTableMaintenanceTarget(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			showTables, err := cmd.Flags().GetBool("show-tables")
+			if err != nil {
+				return err
+			}
+			if showTables {
+				res.ShowTables = -1
+			}
+
+			fmt.Fprintf(cmd.OutOrStderr(), "NOTICE: dry run mode, SSTable maintenance is not scheduled\n\n")
+			return res.Render(cmd.OutOrStdout())
+		}
+
+		taskID := t.ID
+		if taskID == "" {
+			id, err := client.CreateTask(ctx, clusterID, t)
+			if err != nil {
+				return err
+			}
+			taskID = id.String()
+		} else if err := client.UpdateTask(ctx, clusterID, t); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), managerclient.TaskJoin(t.Type, taskID))
+
+		return nil
+	})
+}
+
+func init() {
+	cmd := sstableMaintenanceCmd
+	taskInitCommonFlags(sstableMaintenanceFlags(cmd))
+	requireFlags(cmd, "mode")
+	register(cmd, rootCmd)
+}
+
+func sstableMaintenanceFlags(cmd *cobra.Command) *pflag.FlagSet {
+	fs := cmd.Flags()
+	fs.String("mode", "", "SSTable maintenance operation, one of: scrub, upgrade")
+	fs.String("scrub-mode", "abort", "how scrub handles corrupted data, one of: abort, skip, segregate, validate")
+	fs.Bool("include-all-sstables", false, "upgrade also SSTables that are already in the current format")
+	fs.Bool("snapshot", true, "take a snapshot of a keyspace on a node before it's processed, it's not taken in the validate scrub mode")
+	fs.StringSliceP("keyspace", "K", nil,
+		"comma-separated `list` of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from SSTable maintenance")
+	fs.StringSlice("dc", nil, "comma-separated `list` of datacenter glob patterns, e.g. 'dc1,!otherdc*', used to specify the DCs to include or exclude from SSTable maintenance")
+	fs.StringSlice("parallel", nil,
+		"comma-separated `list` of SSTable maintenance parallelism limits in the format [<dc>:]<limit>. The <dc>: part is optional and allows for specifying different limits in selected datacenters. If the <dc>: part is not set the limit is global (e.g. 'dc1:2,5'). Set to 0 to process all nodes in a datacenter at once (default 1)") // nolint: lll
+	fs.Bool("continue", true, "resume the last unfinished run skipping keyspaces already processed")
+	fs.Bool("dry-run", false, "validate and print SSTable maintenance information without scheduling it")
+	fs.Bool("show-tables", false, "print all table names for a keyspace. Used only in conjunction with --dry-run")
+	return fs
+}
+
+var sstableMaintenanceUpdateCmd = &cobra.Command{
+	Use:   "update <type/task-id>",
+	Short: "Modifies an SSTable maintenance task",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskType, taskID, err := managerclient.TaskSplit(args[0])
+		if err != nil {
+			return err
+		}
+
+		if scheduler.TaskType(taskType) != scheduler.SSTableMaintenanceTask {
+			return fmt.Errorf("sstable-maintenance update can't handle %s task", taskType)
+		}
+
+		t, err := client.GetTask(ctx, cfgCluster, taskType, taskID)
+		if err != nil {
+			return err
+		}
+
+		return sstableMaintenanceTaskUpdate(t, cmd)
+	},
+}
+
+func init() {
+	cmd := sstableMaintenanceUpdateCmd
+	fs := sstableMaintenanceFlags(cmd)
+	fs.StringP("enabled", "e", "true", "enabled")
+	taskInitCommonFlags(fs)
+	register(cmd, sstableMaintenanceCmd)
+}
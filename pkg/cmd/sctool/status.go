@@ -3,7 +3,10 @@
 package main
 
 import (
+	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 	"github.com/spf13/cobra"
 )
 
@@ -12,14 +15,14 @@ var statusCmd = &cobra.Command{
 	Short: "Shows cluster status",
 
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var clusters []*managerclient.Cluster
-		if cfgCluster == "" {
-			var err error
-			if clusters, err = client.ListClusters(ctx); err != nil {
-				return err
-			}
-		} else {
-			clusters = []*managerclient.Cluster{{ID: cfgCluster}}
+		clusters, err := selectedClusters()
+		if err != nil {
+			return err
+		}
+
+		verbose, err := cmd.Flags().GetBool("verbose")
+		if err != nil {
+			return err
 		}
 
 		w := cmd.OutOrStdout()
@@ -28,7 +31,35 @@ var statusCmd = &cobra.Command{
 			if err != nil {
 				return err
 			}
-			return render(w, status)
+			if err := render(w, status); err != nil {
+				return err
+			}
+			if !verbose {
+				return nil
+			}
+			config, err := client.ClusterAgentConfig(ctx, clusterID)
+			if err != nil {
+				return err
+			}
+			return render(w, config)
+		}
+
+		if f := cmd.Flag("since"); f.Changed {
+			since, err := duration.ParseDuration(f.Value.String())
+			if err != nil {
+				return err
+			}
+			if since <= 0 {
+				return errors.New("since must be greater than 0")
+			}
+			until := timeutc.Now()
+			h = func(clusterID string) error {
+				history, err := client.ClusterStatusHistory(ctx, clusterID, until.Add(-since.Duration()), until)
+				if err != nil {
+					return err
+				}
+				return render(w, history)
+			}
 		}
 		for _, c := range clusters {
 			if cfgCluster == "" {
@@ -45,5 +76,10 @@ var statusCmd = &cobra.Command{
 
 func init() {
 	cmd := statusCmd
+	fs := cmd.Flags()
+	fs.String("since", "",
+		"show availability and ping RTT percentiles of nodes in the given `duration` based on health check history, e.g. 7d, valid units are d, h, m, s")
+	fs.Bool("verbose", false,
+		"show the effective Scylla Manager Agent config of every node, secrets are redacted")
 	register(cmd, rootCmd)
 }
@@ -10,7 +10,9 @@ var suspendCmd = &cobra.Command{
 	Use:   "suspend",
 	Short: "Stop execution of all tasks that are running on a cluster",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return client.Suspend(ctx, cfgCluster)
+		return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+			return client.Suspend(ctx, clusterID)
+		})
 	},
 }
 
@@ -27,7 +29,9 @@ var resumeCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		return client.Resume(ctx, cfgCluster, st)
+		return runOnSelectedClusters(cmd.OutOrStdout(), func(clusterID string) error {
+			return client.Resume(ctx, clusterID, st)
+		})
 	},
 }
 
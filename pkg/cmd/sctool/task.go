@@ -28,6 +28,8 @@ func taskInitCommonFlagsWithParams(fs *pflag.FlagSet, numRetries int64) {
 	fs.StringP("start-date", "s", "now", "task start date expressed in the RFC3339 format or now[+duration], e.g. now+3d2h10m, valid units are d, h, m, s")
 	fs.StringP("interval", "i", "0", "task schedule interval e.g. 3d2h10m, valid units are d, h, m, s")
 	fs.Int64P("num-retries", "r", numRetries, "number of times a scheduled task will retry to run before failing")
+	fs.StringSlice("window", nil,
+		"comma-separated `list` of time markers in the format [<weekday>-]<HH:MM>, every pair of markers specifies a time window when the task can run, e.g. 'Sat-22:00,Sun-06:00'. The <weekday>- part is optional and if not set the window repeats every day. Time is expressed in UTC. When the window ends the task is paused and it continues in the next window") // nolint: lll
 }
 
 var taskCmd = &cobra.Command{
@@ -66,14 +68,9 @@ var taskListCmd = &cobra.Command{
 			return err
 		}
 
-		var clusters []*managerclient.Cluster
-		if cfgCluster == "" {
-			clusters, err = client.ListClusters(ctx)
-			if err != nil {
-				return err
-			}
-		} else {
-			clusters = []*managerclient.Cluster{{ID: cfgCluster}}
+		clusters, err := selectedClusters()
+		if err != nil {
+			return err
 		}
 
 		w := cmd.OutOrStdout()
@@ -319,6 +316,13 @@ var taskUpdateCmd = &cobra.Command{
 			}
 			changed = true
 		}
+		if f := cmd.Flag("window"); f.Changed {
+			t.Schedule.Window, err = cmd.Flags().GetStringSlice("window")
+			if err != nil {
+				return err
+			}
+			changed = true
+		}
 		if !changed {
 			return errors.New("nothing to change")
 		}
@@ -385,22 +389,128 @@ var taskProgressCmd = &cobra.Command{
 			runID = "latest"
 		}
 
-		switch scheduler.TaskType(taskType) {
-		case scheduler.HealthCheckAlternatorTask, scheduler.HealthCheckCQLTask, scheduler.HealthCheckRESTTask:
-			fmt.Fprintf(w, "Use: sctool status -c %s\n", cfgCluster)
-			return statusCmd.RunE(statusCmd, nil)
-		case scheduler.RepairTask:
-			return renderRepairProgress(cmd, w, t, runID)
-		case scheduler.BackupTask:
-			return renderBackupProgress(cmd, w, t, runID)
-		case scheduler.ValidateBackupTask:
-			return renderValidateBackupProgress(cmd, w, t, runID)
+		renderProgress := func() error {
+			switch scheduler.TaskType(taskType) {
+			case scheduler.HealthCheckAlternatorTask, scheduler.HealthCheckCQLTask, scheduler.HealthCheckRESTTask:
+				fmt.Fprintf(w, "Use: sctool status -c %s\n", cfgCluster)
+				return statusCmd.RunE(statusCmd, nil)
+			case scheduler.RepairTask:
+				return renderRepairProgress(cmd, w, t, runID)
+			case scheduler.BackupTask:
+				return renderBackupProgress(cmd, w, t, runID)
+			case scheduler.ValidateBackupTask:
+				return renderValidateBackupProgress(cmd, w, t, runID)
+			case scheduler.CleanupTask:
+				return renderCleanupProgress(cmd, w, t, runID)
+			case scheduler.CompactionTask:
+				return renderCompactionProgress(cmd, w, t, runID)
+			case scheduler.RollingRestartTask:
+				return renderRollingRestartProgress(cmd, w, t, runID)
+			case scheduler.SSTableMaintenanceTask:
+				return renderSSTableMaintenanceProgress(cmd, w, t, runID)
+			case scheduler.SnapshotTask:
+				return renderSnapshotProgress(cmd, w, t, runID)
+			}
+			return nil
 		}
 
-		return nil
+		watch, err := cmd.Flags().GetBool("watch")
+		if err != nil {
+			return err
+		}
+		if !watch {
+			return renderProgress()
+		}
+		return watchTaskProgress(w, t, taskID, runID, renderProgress)
 	},
 }
 
+// taskProgressWatchInterval specifies minimal time between rendering
+// progress in watch mode.
+var taskProgressWatchInterval = time.Second
+
+var errWatchDone = errors.New("watch done")
+
+// watchTaskProgress renders progress every time the task run status or
+// progress changes until the run ends.
+func watchTaskProgress(w io.Writer, t *managerclient.Task, taskID uuid.UUID, runID string, renderProgress func() error) error {
+	var lastRender time.Time
+	render := func() error {
+		fmt.Fprint(w, "\033[H\033[2J")
+		lastRender = timeutc.Now()
+		return renderProgress()
+	}
+	if err := render(); err != nil {
+		return err
+	}
+
+	// Events are not replayed, return if the run has already ended
+	ended, err := taskRunEnded(t, taskID, runID)
+	if err != nil {
+		return err
+	}
+	if ended {
+		return nil
+	}
+
+	filter := managerclient.EventsFilter{
+		TaskID: t.ID,
+	}
+	if runID != "latest" {
+		filter.RunID = runID
+	}
+	err = client.WatchEvents(ctx, cfgCluster, filter, func(e *managerclient.Event) error {
+		if e.Type == "run_status" && runEnded(e) {
+			if err := render(); err != nil {
+				return err
+			}
+			return errWatchDone
+		}
+		if timeutc.Since(lastRender) < taskProgressWatchInterval {
+			return nil
+		}
+		return render()
+	})
+	if errors.Is(err, errWatchDone) {
+		return nil
+	}
+	return err
+}
+
+// taskRunEnded returns true if the run is not running anymore. Only one run
+// of a task runs at a time so if the run is not the latest run it has ended.
+func taskRunEnded(t *managerclient.Task, taskID uuid.UUID, runID string) (bool, error) {
+	runs, err := client.GetTaskHistory(ctx, cfgCluster, t.Type, taskID, 1)
+	if err != nil {
+		return false, err
+	}
+	if len(runs) == 0 {
+		return false, nil
+	}
+	if runID != "latest" && runs[0].ID != runID {
+		return true, nil
+	}
+	return statusEnded(runs[0].Status), nil
+}
+
+func runEnded(e *managerclient.Event) bool {
+	d, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	status, _ := d["status"].(string)
+	return statusEnded(status)
+}
+
+func statusEnded(status string) bool {
+	switch scheduler.Status(status) {
+	case scheduler.StatusDone, scheduler.StatusError, scheduler.StatusStopped, scheduler.StatusAborted:
+		return true
+	default:
+		return false
+	}
+}
+
 func renderRepairProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
 	p, err := client.RepairProgress(ctx, cfgCluster, t.ID, runID)
 	if err != nil {
@@ -490,6 +600,140 @@ func renderValidateBackupProgress(cmd *cobra.Command, w io.Writer, t *managercli
 	return render(w, p)
 }
 
+func renderCleanupProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
+	p, err := client.CleanupProgress(ctx, cfgCluster, t.ID, runID)
+	if err != nil {
+		return err
+	}
+
+	p.Detailed, err = cmd.Flags().GetBool("details")
+	if err != nil {
+		return err
+	}
+
+	hf, err := cmd.Flags().GetStringSlice("host")
+	if err != nil {
+		return err
+	}
+	if err := p.SetHostFilter(hf); err != nil {
+		return err
+	}
+
+	kf, err := cmd.Flags().GetStringSlice("keyspace")
+	if err != nil {
+		return err
+	}
+	if err := p.SetKeyspaceFilter(kf); err != nil {
+		return err
+	}
+
+	p.Task = t
+
+	return render(w, p)
+}
+
+func renderCompactionProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
+	p, err := client.CompactionProgress(ctx, cfgCluster, t.ID, runID)
+	if err != nil {
+		return err
+	}
+
+	p.Detailed, err = cmd.Flags().GetBool("details")
+	if err != nil {
+		return err
+	}
+
+	hf, err := cmd.Flags().GetStringSlice("host")
+	if err != nil {
+		return err
+	}
+	if err := p.SetHostFilter(hf); err != nil {
+		return err
+	}
+
+	kf, err := cmd.Flags().GetStringSlice("keyspace")
+	if err != nil {
+		return err
+	}
+	if err := p.SetKeyspaceFilter(kf); err != nil {
+		return err
+	}
+
+	p.Task = t
+
+	return render(w, p)
+}
+
+func renderRollingRestartProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
+	p, err := client.RollingRestartProgress(ctx, cfgCluster, t.ID, runID)
+	if err != nil {
+		return err
+	}
+
+	hf, err := cmd.Flags().GetStringSlice("host")
+	if err != nil {
+		return err
+	}
+	if err := p.SetHostFilter(hf); err != nil {
+		return err
+	}
+
+	p.Task = t
+
+	return render(w, p)
+}
+
+func renderSnapshotProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
+	p, err := client.SnapshotProgress(ctx, cfgCluster, t.ID, runID)
+	if err != nil {
+		return err
+	}
+
+	hf, err := cmd.Flags().GetStringSlice("host")
+	if err != nil {
+		return err
+	}
+	if err := p.SetHostFilter(hf); err != nil {
+		return err
+	}
+
+	p.Task = t
+
+	return render(w, p)
+}
+
+func renderSSTableMaintenanceProgress(cmd *cobra.Command, w io.Writer, t *managerclient.Task, runID string) error {
+	p, err := client.SSTableMaintenanceProgress(ctx, cfgCluster, t.ID, runID)
+	if err != nil {
+		return err
+	}
+
+	p.Detailed, err = cmd.Flags().GetBool("details")
+	if err != nil {
+		return err
+	}
+
+	hf, err := cmd.Flags().GetStringSlice("host")
+	if err != nil {
+		return err
+	}
+	if err := p.SetHostFilter(hf); err != nil {
+		return err
+	}
+
+	kf, err := cmd.Flags().GetStringSlice("keyspace")
+	if err != nil {
+		return err
+	}
+	if err := p.SetKeyspaceFilter(kf); err != nil {
+		return err
+	}
+
+	p.Task = t
+
+	return render(w, p)
+}
+
 func init() {
 	cmd := taskProgressCmd
 	fs := cmd.Flags()
@@ -497,5 +741,6 @@ func init() {
 	fs.StringSliceP("keyspace", "K", nil, "comma-separated `list` of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*'")
 	fs.StringSlice("host", nil, "comma-separated list of host glob patterns, e.g. '1.1.1.*,!1.2.*.4")
 	fs.String("run", "", "show progress of a particular run, see sctool task history")
+	fs.BoolP("watch", "w", false, "keep showing progress as it changes until the run ends")
 	register(cmd, taskCmd)
 }
@@ -126,3 +126,22 @@ func TestTaskTimeSortingFunctions(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusEnded(t *testing.T) {
+	t.Parallel()
+
+	for status, ended := range map[string]bool{
+		"NEW":      false,
+		"RUNNING":  false,
+		"WAITING":  false,
+		"STOPPING": false,
+		"DONE":     true,
+		"ERROR":    true,
+		"STOPPED":  true,
+		"ABORTED":  true,
+	} {
+		if v := statusEnded(status); v != ended {
+			t.Errorf("statusEnded(%s) = %v, expected %v", status, v, ended)
+		}
+	}
+}
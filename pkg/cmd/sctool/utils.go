@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/managerclient"
 	"github.com/scylladb/scylla-manager/pkg/util/fsutil"
 	"github.com/spf13/cobra"
@@ -56,3 +57,50 @@ func unescapeFilters(strs []string) []string {
 	}
 	return strs
 }
+
+// selectedClusters returns clusters matching the selector if --selector is
+// set, the cluster specified with --cluster, or all the clusters if none of
+// the flags is set.
+func selectedClusters() ([]*managerclient.Cluster, error) {
+	if cfgSelector != "" {
+		clusters, err := client.ListClustersWithSelector(ctx, cfgSelector)
+		if err != nil {
+			return nil, err
+		}
+		if len(clusters) == 0 {
+			return nil, errors.Errorf("no clusters match selector %q", cfgSelector)
+		}
+		return clusters, nil
+	}
+	if cfgCluster == "" {
+		return client.ListClusters(ctx)
+	}
+	return []*managerclient.Cluster{{ID: cfgCluster}}, nil
+}
+
+// runOnSelectedClusters calls f for the cluster specified with --cluster,
+// or for every cluster matching the selector if --selector is set.
+// In the latter case errors are printed to w, and the number of failed
+// clusters is reported.
+func runOnSelectedClusters(w io.Writer, f func(clusterID string) error) error {
+	if cfgSelector == "" {
+		return f(cfgCluster)
+	}
+
+	clusters, err := selectedClusters()
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, c := range clusters {
+		managerclient.FormatClusterName(w, c)
+		if err := f(c.ID); err != nil {
+			printError(w, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed on %d out of %d clusters", failed, len(clusters))
+	}
+	return nil
+}
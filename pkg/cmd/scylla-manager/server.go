@@ -15,14 +15,20 @@ import (
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/scylla-manager/pkg/config"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/restapi"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/cleanup"
 	"github.com/scylladb/scylla-manager/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/pkg/service/compaction"
 	"github.com/scylladb/scylla-manager/pkg/service/healthcheck"
 	"github.com/scylladb/scylla-manager/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/pkg/service/rollingrestart"
 	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+	"github.com/scylladb/scylla-manager/pkg/service/sstablemaintenance"
 	"github.com/scylladb/scylla-manager/pkg/store"
 	"github.com/scylladb/scylla-manager/pkg/util/certutil"
 	"github.com/scylladb/scylla-manager/pkg/util/httppprof"
@@ -37,11 +43,20 @@ type server struct {
 	session gocqlx.Session
 	logger  log.Logger
 
-	clusterSvc *cluster.Service
-	healthSvc  *healthcheck.Service
-	backupSvc  *backup.Service
-	repairSvc  *repair.Service
-	schedSvc   *scheduler.Service
+	clusterSvc    *cluster.Service
+	healthSvc     *healthcheck.Service
+	backupSvc     *backup.Service
+	cleanupSvc    *cleanup.Service
+	compactionSvc *compaction.Service
+	repairSvc     *repair.Service
+	restartSvc    *rollingrestart.Service
+	sstableSvc    *sstablemaintenance.Service
+	snapshotSvc   *snapshot.Service
+	schedSvc      *scheduler.Service
+	eventsBkr     *events.Broker
+
+	repairPauser     *repairPauser
+	cleanupScheduler *cleanupScheduler
 
 	httpServer       *http.Server
 	httpsServer      *http.Server
@@ -72,13 +87,17 @@ func (s *server) makeServices() error {
 	drawerStore := store.NewTableStore(s.session, table.Drawer)
 	secretsStore := store.NewTableStore(s.session, table.Secrets)
 
-	s.clusterSvc, err = cluster.NewService(s.session, metrics.NewClusterMetrics().MustRegister(), secretsStore, s.logger.Named("cluster"))
+	s.eventsBkr = events.NewBroker(events.DefaultHistorySize)
+
+	s.clusterSvc, err = cluster.NewService(s.session, s.config.Cluster, metrics.NewClusterMetrics().MustRegister(), secretsStore, s.logger.Named("cluster"))
 	if err != nil {
 		return errors.Wrapf(err, "cluster service")
 	}
 	s.clusterSvc.SetOnChangeListener(s.onClusterChange)
+	s.clusterSvc.SetOnTopologyChangeListener(s.onTopologyChange)
 
 	s.healthSvc, err = healthcheck.NewService(
+		s.session,
 		s.config.Healthcheck,
 		s.clusterSvc.Client,
 		secretsStore,
@@ -101,6 +120,26 @@ func (s *server) makeServices() error {
 		return errors.Wrapf(err, "backup service")
 	}
 
+	s.cleanupSvc, err = cleanup.NewService(
+		s.session,
+		s.config.Cleanup,
+		s.clusterSvc.Client,
+		s.logger.Named("cleanup"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "cleanup service")
+	}
+
+	s.compactionSvc, err = compaction.NewService(
+		s.session,
+		s.config.Compaction,
+		s.clusterSvc.Client,
+		s.logger.Named("compaction"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "compaction service")
+	}
+
 	s.repairSvc, err = repair.NewService(
 		s.session,
 		s.config.Repair,
@@ -112,6 +151,36 @@ func (s *server) makeServices() error {
 		return errors.Wrapf(err, "repair service")
 	}
 
+	s.restartSvc, err = rollingrestart.NewService(
+		s.session,
+		s.config.RollingRestart,
+		s.clusterSvc.Client,
+		s.healthSvc.PingNode,
+		s.logger.Named("rolling_restart"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "rolling restart service")
+	}
+
+	s.sstableSvc, err = sstablemaintenance.NewService(
+		s.session,
+		s.config.SSTableMaintenance,
+		s.clusterSvc.Client,
+		s.logger.Named("sstable_maintenance"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "sstable maintenance service")
+	}
+
+	s.snapshotSvc, err = snapshot.NewService(
+		s.session,
+		s.clusterSvc.Client,
+		s.logger.Named("snapshot"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "snapshot service")
+	}
+
 	s.schedSvc, err = scheduler.NewService(
 		s.session,
 		metrics.NewSchedulerMetrics().MustRegister(),
@@ -122,12 +191,28 @@ func (s *server) makeServices() error {
 		return errors.Wrapf(err, "scheduler service")
 	}
 
+	// Publish run status, progress and topology events
+	s.clusterSvc.SetEventPublisher(s.eventsBkr)
+	s.backupSvc.SetEventPublisher(s.eventsBkr)
+	s.cleanupSvc.SetEventPublisher(s.eventsBkr)
+	s.compactionSvc.SetEventPublisher(s.eventsBkr)
+	s.repairSvc.SetEventPublisher(s.eventsBkr)
+	s.restartSvc.SetEventPublisher(s.eventsBkr)
+	s.sstableSvc.SetEventPublisher(s.eventsBkr)
+	s.snapshotSvc.SetEventPublisher(s.eventsBkr)
+	s.schedSvc.SetEventPublisher(s.eventsBkr)
+
 	// Register the runners
 	s.schedSvc.SetRunner(scheduler.BackupTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.backupSvc.Runner()})
+	s.schedSvc.SetRunner(scheduler.CleanupTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.cleanupSvc.Runner()})
+	s.schedSvc.SetRunner(scheduler.CompactionTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.compactionSvc.Runner()})
 	s.schedSvc.SetRunner(scheduler.HealthCheckAlternatorTask, s.healthSvc.AlternatorRunner())
 	s.schedSvc.SetRunner(scheduler.HealthCheckCQLTask, s.healthSvc.CQLRunner())
 	s.schedSvc.SetRunner(scheduler.HealthCheckRESTTask, s.healthSvc.RESTRunner())
 	s.schedSvc.SetRunner(scheduler.RepairTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.repairSvc.Runner()})
+	s.schedSvc.SetRunner(scheduler.RollingRestartTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.restartSvc.Runner()})
+	s.schedSvc.SetRunner(scheduler.SSTableMaintenanceTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.sstableSvc.Runner()})
+	s.schedSvc.SetRunner(scheduler.SnapshotTask, scheduler.PolicyRunner{scheduler.NewLockClusterPolicy(), s.snapshotSvc.Runner()})
 	s.schedSvc.SetRunner(scheduler.ValidateBackupTask, s.backupSvc.ValidationRunner())
 
 	// Add additional properties on task run.
@@ -171,6 +256,14 @@ func (s *server) makeServices() error {
 		return jsonutil.Set(properties, "location", s.backupSvc.ExtractLocations(ctx, tp)), nil
 	})
 
+	// Topology change policies
+	if s.config.Cluster.TopologyPolicies.PauseRepairOnNodeJoin {
+		s.repairPauser = newRepairPauser(s.schedSvc, s.logger.Named("topology"))
+	}
+	if s.config.Cluster.TopologyPolicies.CleanupOnNodeAdded {
+		s.cleanupScheduler = newCleanupScheduler(s.schedSvc, s.logger.Named("topology"))
+	}
+
 	return nil
 }
 
@@ -210,13 +303,36 @@ func (s *server) onClusterChange(ctx context.Context, c cluster.Change) error {
 	return nil
 }
 
+func (s *server) onTopologyChange(ctx context.Context, u cluster.TopologyUpdate) error {
+	s.healthSvc.InvalidateCache(u.ClusterID)
+
+	if s.repairPauser != nil {
+		if err := s.repairPauser.onTopologyChange(ctx, u); err != nil {
+			return errors.Wrapf(err, "pause repair on node join for cluster %s", u.ClusterID)
+		}
+	}
+	if s.cleanupScheduler != nil {
+		if err := s.cleanupScheduler.onTopologyChange(ctx, u); err != nil {
+			return errors.Wrapf(err, "schedule cleanup on node added for cluster %s", u.ClusterID)
+		}
+	}
+
+	return nil
+}
+
 func (s *server) makeServers(ctx context.Context) error {
 	services := restapi.Services{
-		Cluster:     s.clusterSvc,
-		HealthCheck: s.healthSvc,
-		Repair:      s.repairSvc,
-		Backup:      s.backupSvc,
-		Scheduler:   s.schedSvc,
+		Cluster:            s.clusterSvc,
+		HealthCheck:        s.healthSvc,
+		Repair:             s.repairSvc,
+		Backup:             s.backupSvc,
+		Cleanup:            s.cleanupSvc,
+		Compaction:         s.compactionSvc,
+		RollingRestart:     s.restartSvc,
+		SSTableMaintenance: s.sstableSvc,
+		Snapshot:           s.snapshotSvc,
+		Scheduler:          s.schedSvc,
+		Events:             s.eventsBkr,
 	}
 	h := restapi.New(services, s.logger.Named("http"))
 
@@ -307,6 +423,7 @@ func (s *server) startServices(ctx context.Context) error {
 	if err := s.schedSvc.LoadTasks(ctx); err != nil {
 		return errors.Wrapf(err, "schedule service")
 	}
+	s.clusterSvc.StartTopologyWatcher(ctx)
 	return nil
 }
 
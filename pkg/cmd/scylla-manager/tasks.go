@@ -68,3 +68,21 @@ func makeAutoRepairTask(clusterID uuid.UUID) *scheduler.Task {
 		Properties: emptyProperties,
 	}
 }
+
+// autoCleanupTaskName is the name of a task that runs cleanup after nodes
+// are added to a cluster.
+const autoCleanupTaskName = "cleanup_on_node_added"
+
+func makeAutoCleanupTask(clusterID uuid.UUID) *scheduler.Task {
+	return &scheduler.Task{
+		ClusterID: clusterID,
+		Type:      scheduler.CleanupTask,
+		Name:      autoCleanupTaskName,
+		Enabled:   true,
+		Sched: scheduler.Schedule{
+			StartDate:  timeutc.Now(),
+			NumRetries: 3,
+		},
+		Properties: []byte(`{"continue":false}`),
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2017 ScyllaDB
+
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+// repairPauser stops running repairs of a cluster when a node starts joining
+// the cluster, and starts them again when there are no joining nodes.
+type repairPauser struct {
+	schedSvc *scheduler.Service
+	logger   log.Logger
+
+	mu     sync.Mutex
+	paused map[uuid.UUID][]*scheduler.Task
+}
+
+func newRepairPauser(schedSvc *scheduler.Service, logger log.Logger) *repairPauser {
+	return &repairPauser{
+		schedSvc: schedSvc,
+		logger:   logger,
+		paused:   make(map[uuid.UUID][]*scheduler.Task),
+	}
+}
+
+func (p *repairPauser) onTopologyChange(ctx context.Context, u cluster.TopologyUpdate) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(u.Joining) > 0 {
+		return p.pause(ctx, u)
+	}
+	return p.resume(ctx, u.ClusterID)
+}
+
+func (p *repairPauser) pause(ctx context.Context, u cluster.TopologyUpdate) error {
+	tasks, err := p.schedSvc.ListTasks(ctx, u.ClusterID, scheduler.ListFilter{
+		TaskType: []scheduler.TaskType{scheduler.RepairTask},
+		Status:   []scheduler.Status{scheduler.StatusRunning},
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, t := range tasks {
+		p.logger.Info(ctx, "Pausing repair while nodes are joining",
+			"cluster_id", u.ClusterID,
+			"task_id", t.ID,
+			"joining", u.Joining,
+		)
+		if err := p.schedSvc.StopTask(ctx, &t.Task); err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		p.paused[u.ClusterID] = append(p.paused[u.ClusterID], &t.Task)
+	}
+	return errs
+}
+
+func (p *repairPauser) resume(ctx context.Context, clusterID uuid.UUID) error {
+	tasks := p.paused[clusterID]
+	delete(p.paused, clusterID)
+
+	var errs error
+	for _, t := range tasks {
+		p.logger.Info(ctx, "Resuming repair, all nodes joined",
+			"cluster_id", clusterID,
+			"task_id", t.ID,
+		)
+		errs = multierr.Append(errs, p.schedSvc.StartTask(ctx, t))
+	}
+	return errs
+}
+
+// cleanupScheduler runs cleanup of a cluster after nodes were added and
+// there are no more joining nodes. The cleanup task is created on first use
+// and started again on subsequent node additions.
+type cleanupScheduler struct {
+	schedSvc *scheduler.Service
+	logger   log.Logger
+}
+
+func newCleanupScheduler(schedSvc *scheduler.Service, logger log.Logger) *cleanupScheduler {
+	return &cleanupScheduler{
+		schedSvc: schedSvc,
+		logger:   logger,
+	}
+}
+
+func (c *cleanupScheduler) onTopologyChange(ctx context.Context, u cluster.TopologyUpdate) error {
+	if len(u.Joining) > 0 || !nodesAdded(u.Changes) {
+		return nil
+	}
+
+	tasks, err := c.schedSvc.ListTasks(ctx, u.ClusterID, scheduler.ListFilter{
+		TaskType: []scheduler.TaskType{scheduler.CleanupTask},
+		Disabled: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	t := makeAutoCleanupTask(u.ClusterID)
+	for _, v := range tasks {
+		if v.Name != autoCleanupTaskName {
+			continue
+		}
+		if v.Status == scheduler.StatusRunning {
+			c.logger.Info(ctx, "Cleanup is already running", "cluster_id", u.ClusterID, "task_id", v.ID)
+			return nil
+		}
+		t.ID = v.ID
+		break
+	}
+
+	c.logger.Info(ctx, "Scheduling cleanup after nodes were added", "cluster_id", u.ClusterID, "task_id", t.ID)
+	return c.schedSvc.PutTask(ctx, t)
+}
+
+func nodesAdded(changes []cluster.TopologyChange) bool {
+	for _, ch := range changes {
+		if ch.Type == cluster.NodeAdded || ch.Type == cluster.NodeJoined {
+			return true
+		}
+	}
+	return false
+}
@@ -3,10 +3,14 @@
 package config
 
 import (
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
 	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/multipart"
 	"github.com/scylladb/scylla-manager/pkg/util/cfgutil"
 	"go.uber.org/multierr"
 )
@@ -18,8 +22,9 @@ const (
 
 // ScyllaConfig contains selected elements of Scylla configuration.
 type ScyllaConfig struct {
-	APIAddress string `yaml:"api_address"`
-	APIPort    string `yaml:"api_port"`
+	APIAddress  string `yaml:"api_address"`
+	APIPort     string `yaml:"api_port"`
+	ServiceName string `yaml:"service_name"`
 
 	ListenAddress     string
 	PrometheusAddress string
@@ -40,23 +45,118 @@ func (c ScyllaConfig) Validate() (errs error) {
 	return
 }
 
+// HooksConfig specifies the directory of scripts that can be run as backup
+// hooks. Hooks are disabled if Dir is empty.
+type HooksConfig struct {
+	Dir     string        `yaml:"dir"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func (c HooksConfig) Validate() (errs error) {
+	if c.Dir != "" && !filepath.IsAbs(c.Dir) {
+		errs = multierr.Append(errs, errors.New("dir must be an absolute path"))
+	}
+	if c.Timeout <= 0 {
+		errs = multierr.Append(errs, errors.New("timeout must be greater than 0"))
+	}
+	return
+}
+
+// UpgradeConfig specifies the rclone location the agent downloads new versions
+// of its binary from. Upgrades are disabled if Location is empty.
+// Binaries must be signed with the ed25519 key matching PublicKeyFile.
+type UpgradeConfig struct {
+	Location      string `yaml:"location"`
+	PublicKeyFile string `yaml:"public_key_file"`
+}
+
+func (c UpgradeConfig) Validate() (errs error) {
+	if c.Location != "" && c.PublicKeyFile == "" {
+		errs = multierr.Append(errs, errors.New("public_key_file must be set if location is set"))
+	}
+	return
+}
+
+// ResumableUploadConfig specifies uploads of large files that are done in
+// parts and can be resumed after interruption. State of the uploads is kept
+// in StateDir, resumable uploads are disabled if StateDir is empty.
+// Files smaller than MinFileSize are uploaded from scratch, uploads older than
+// MaxAge are aborted.
+type ResumableUploadConfig struct {
+	StateDir    string        `yaml:"state_dir"`
+	MinFileSize string        `yaml:"min_file_size"`
+	MaxAge      time.Duration `yaml:"max_age"`
+}
+
+func (c ResumableUploadConfig) Validate() (errs error) {
+	if c.StateDir != "" && !filepath.IsAbs(c.StateDir) {
+		errs = multierr.Append(errs, errors.New("state_dir must be an absolute path"))
+	}
+	var size fs.SizeSuffix
+	if err := size.Set(c.MinFileSize); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, "min_file_size"))
+	} else if size <= 0 {
+		errs = multierr.Append(errs, errors.New("min_file_size must be greater than 0"))
+	}
+	if c.MaxAge < 0 {
+		errs = multierr.Append(errs, errors.New("max_age must not be negative"))
+	}
+	return
+}
+
+// Options returns multipart upload options, c must be valid.
+func (c ResumableUploadConfig) Options() multipart.Options {
+	var size fs.SizeSuffix
+	size.Set(c.MinFileSize) // nolint: errcheck
+	return multipart.Options{
+		StateDir:    c.StateDir,
+		MinFileSize: int64(size),
+		MaxAge:      c.MaxAge,
+	}
+}
+
+// FileConfig specifies the root directory of the file provider, typically
+// a mount point of a shared file system like NFS that is present on all
+// the nodes. The provider is disabled if Root is empty.
+type FileConfig struct {
+	Root string `yaml:"root"`
+}
+
+func (c FileConfig) Validate() (errs error) {
+	if c.Root != "" && !filepath.IsAbs(c.Root) {
+		errs = multierr.Append(errs, errors.New("root must be an absolute path"))
+	}
+	if c.Root != "" && filepath.Clean(c.Root) == "/" {
+		errs = multierr.Append(errs, errors.New("root must not be the root directory"))
+	}
+	return
+}
+
 // AgentConfig specifies the agent and scylla configuration.
 type AgentConfig struct {
-	AuthToken   string               `yaml:"auth_token"`
-	HTTPS       string               `yaml:"https"`
-	HTTPSPort   int                  `yaml:"https_port"`
-	TLSVersion  TLSVersion           `yaml:"tls_version"`
-	TLSCertFile string               `yaml:"tls_cert_file"`
-	TLSKeyFile  string               `yaml:"tls_key_file"`
-	Prometheus  string               `yaml:"prometheus"`
-	Debug       string               `yaml:"debug"`
-	CPU         int                  `yaml:"cpu"`
-	Logger      LogConfig            `yaml:"logger"`
-	Scylla      ScyllaConfig         `yaml:"scylla"`
-	Rclone      rclone.GlobalOptions `yaml:"rclone"`
-	S3          rclone.S3Options     `yaml:"s3"`
-	GCS         rclone.GCSOptions    `yaml:"gcs"`
-	Azure       rclone.AzureOptions  `yaml:"azure"`
+	AuthToken       string                `yaml:"auth_token"`
+	AuthTokens      []string              `yaml:"auth_tokens"`
+	HTTPS           string                `yaml:"https"`
+	HTTPSPort       int                   `yaml:"https_port"`
+	TLSVersion      TLSVersion            `yaml:"tls_version"`
+	TLSCertFile     string                `yaml:"tls_cert_file"`
+	TLSKeyFile      string                `yaml:"tls_key_file"`
+	TLSClientCAFile string                `yaml:"tls_client_ca_file"`
+	Prometheus      string                `yaml:"prometheus"`
+	Debug           string                `yaml:"debug"`
+	CPU             int                   `yaml:"cpu"`
+	Logger          LogConfig             `yaml:"logger"`
+	Scylla          ScyllaConfig          `yaml:"scylla"`
+	Hooks           HooksConfig           `yaml:"hooks"`
+	Upgrade         UpgradeConfig         `yaml:"upgrade"`
+	ResumableUpload ResumableUploadConfig `yaml:"resumable_upload"`
+	Rclone          rclone.GlobalOptions  `yaml:"rclone"`
+	File            FileConfig            `yaml:"file"`
+	S3              rclone.S3Options      `yaml:"s3"`
+	GCS             rclone.GCSOptions     `yaml:"gcs"`
+	Azure           rclone.AzureOptions   `yaml:"azure"`
+	SFTP            rclone.SFTPOptions    `yaml:"sftp"`
+	WebDAV          rclone.WebDAVOptions  `yaml:"webdav"`
 }
 
 func DefaultAgentConfig() AgentConfig {
@@ -70,12 +170,23 @@ func DefaultAgentConfig() AgentConfig {
 		Scylla: ScyllaConfig{
 			APIAddress:    "0.0.0.0",
 			APIPort:       "10000",
+			ServiceName:   "scylla-server",
 			DataDirectory: "/var/lib/scylla/data",
 		},
+		Hooks: HooksConfig{
+			Timeout: 5 * time.Minute,
+		},
+		ResumableUpload: ResumableUploadConfig{
+			StateDir:    "/var/lib/scylla/scylla-manager-agent/uploads",
+			MinFileSize: "1G",
+			MaxAge:      7 * 24 * time.Hour,
+		},
 		Rclone: rclone.DefaultGlobalOptions(),
 		S3:     rclone.DefaultS3Options(),
 		GCS:    rclone.DefaultGCSOptions(),
 		Azure:  rclone.DefaultAzureOptions(),
+		SFTP:   rclone.DefaultSFTPOptions(),
+		WebDAV: rclone.DefaultWebDAVOptions(),
 	}
 }
 
@@ -90,6 +201,18 @@ func (c AgentConfig) Validate() (errs error) {
 	// Validate Scylla config
 	errs = multierr.Append(errs, errors.Wrap(c.Scylla.Validate(), "scylla"))
 
+	// Validate hooks config
+	errs = multierr.Append(errs, errors.Wrap(c.Hooks.Validate(), "hooks"))
+
+	// Validate upgrade config
+	errs = multierr.Append(errs, errors.Wrap(c.Upgrade.Validate(), "upgrade"))
+
+	// Validate resumable upload config
+	errs = multierr.Append(errs, errors.Wrap(c.ResumableUpload.Validate(), "resumable_upload"))
+
+	// Validate file config
+	errs = multierr.Append(errs, errors.Wrap(c.File.Validate(), "file"))
+
 	// Validate S3 config
 	errs = multierr.Append(errs, errors.Wrap(c.S3.Validate(), "s3"))
 
@@ -101,6 +224,18 @@ func (c AgentConfig) HasTLSCert() bool {
 	return c.TLSCertFile != "" || c.TLSKeyFile != ""
 }
 
+// ValidAuthTokens returns AuthToken followed by AuthTokens, empty tokens are
+// skipped.
+func (c AgentConfig) ValidAuthTokens() []string {
+	var tokens []string
+	for _, t := range append([]string{c.AuthToken}, c.AuthTokens...) {
+		if t != "" {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens
+}
+
 // ObfuscatedAgentConfig returns AgentConfig with secrets replaced with ******.
 func ObfuscatedAgentConfig(c AgentConfig) AgentConfig {
 	secrets := []*string{
@@ -112,6 +247,19 @@ func ObfuscatedAgentConfig(c AgentConfig) AgentConfig {
 		&c.GCS.ClientSecret,
 		&c.GCS.ServiceAccountCredentials,
 		&c.Azure.Key,
+		&c.SFTP.Pass,
+		&c.SFTP.KeyPem,
+		&c.SFTP.KeyFilePass,
+		&c.WebDAV.Pass,
+		&c.WebDAV.BearerToken,
+	}
+	if c.AuthTokens != nil {
+		tokens := make([]string, len(c.AuthTokens))
+		for i := range c.AuthTokens {
+			tokens[i] = c.AuthTokens[i]
+			secrets = append(secrets, &tokens[i])
+		}
+		c.AuthTokens = tokens
 	}
 	for _, s := range secrets {
 		*s = strings.Repeat("*", len(*s))
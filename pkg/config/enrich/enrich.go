@@ -17,6 +17,7 @@ func AgentConfigFromAPI(ctx context.Context, addr string, c *config.AgentConfig)
 	if err != nil {
 		return err
 	}
+	scyllaConfig.ServiceName = c.Scylla.ServiceName
 	c.Scylla = scyllaConfig
 
 	if c.HTTPS == "" {
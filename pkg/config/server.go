@@ -8,8 +8,13 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/cleanup"
+	"github.com/scylladb/scylla-manager/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/pkg/service/compaction"
 	"github.com/scylladb/scylla-manager/pkg/service/healthcheck"
 	"github.com/scylladb/scylla-manager/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/pkg/service/rollingrestart"
+	"github.com/scylladb/scylla-manager/pkg/service/sstablemaintenance"
 	"github.com/scylladb/scylla-manager/pkg/util/cfgutil"
 )
 
@@ -41,20 +46,25 @@ type SSLConfig struct {
 
 // ServerConfig contains configuration structure for scylla manager.
 type ServerConfig struct {
-	HTTP        string             `yaml:"http"`
-	HTTPS       string             `yaml:"https"`
-	TLSVersion  TLSVersion         `yaml:"tls_version"`
-	TLSCertFile string             `yaml:"tls_cert_file"`
-	TLSKeyFile  string             `yaml:"tls_key_file"`
-	TLSCAFile   string             `yaml:"tls_ca_file"`
-	Prometheus  string             `yaml:"prometheus"`
-	Debug       string             `yaml:"debug"`
-	Logger      LogConfig          `yaml:"logger"`
-	Database    DBConfig           `yaml:"database"`
-	SSL         SSLConfig          `yaml:"ssl"`
-	Healthcheck healthcheck.Config `yaml:"healthcheck"`
-	Backup      backup.Config      `yaml:"backup"`
-	Repair      repair.Config      `yaml:"repair"`
+	HTTP               string                    `yaml:"http"`
+	HTTPS              string                    `yaml:"https"`
+	TLSVersion         TLSVersion                `yaml:"tls_version"`
+	TLSCertFile        string                    `yaml:"tls_cert_file"`
+	TLSKeyFile         string                    `yaml:"tls_key_file"`
+	TLSCAFile          string                    `yaml:"tls_ca_file"`
+	Prometheus         string                    `yaml:"prometheus"`
+	Debug              string                    `yaml:"debug"`
+	Logger             LogConfig                 `yaml:"logger"`
+	Database           DBConfig                  `yaml:"database"`
+	SSL                SSLConfig                 `yaml:"ssl"`
+	Cluster            cluster.Config            `yaml:"cluster"`
+	Healthcheck        healthcheck.Config        `yaml:"healthcheck"`
+	Backup             backup.Config             `yaml:"backup"`
+	Cleanup            cleanup.Config            `yaml:"cleanup"`
+	Compaction         compaction.Config         `yaml:"compaction"`
+	Repair             repair.Config             `yaml:"repair"`
+	RollingRestart     rollingrestart.Config     `yaml:"rolling_restart"`
+	SSTableMaintenance sstablemaintenance.Config `yaml:"sstable_maintenance"`
 }
 
 func DefaultServerConfig() ServerConfig {
@@ -75,9 +85,14 @@ func DefaultServerConfig() ServerConfig {
 		SSL: SSLConfig{
 			Validate: true,
 		},
-		Healthcheck: healthcheck.DefaultConfig(),
-		Backup:      backup.DefaultConfig(),
-		Repair:      repair.DefaultConfig(),
+		Cluster:            cluster.DefaultConfig(),
+		Healthcheck:        healthcheck.DefaultConfig(),
+		Backup:             backup.DefaultConfig(),
+		Cleanup:            cleanup.DefaultConfig(),
+		Compaction:         compaction.DefaultConfig(),
+		Repair:             repair.DefaultConfig(),
+		RollingRestart:     rollingrestart.DefaultConfig(),
+		SSTableMaintenance: sstablemaintenance.DefaultConfig(),
 	}
 
 	return config
@@ -100,12 +115,27 @@ func (c ServerConfig) Validate() error {
 	if c.Database.ReplicationFactor <= 0 {
 		return errors.New("invalid database.replication_factor <= 0")
 	}
+	if err := c.Cluster.Validate(); err != nil {
+		return errors.Wrap(err, "cluster")
+	}
 	if err := c.Backup.Validate(); err != nil {
 		return errors.Wrap(err, "backup")
 	}
+	if err := c.Cleanup.Validate(); err != nil {
+		return errors.Wrap(err, "cleanup")
+	}
+	if err := c.Compaction.Validate(); err != nil {
+		return errors.Wrap(err, "compaction")
+	}
 	if err := c.Repair.Validate(); err != nil {
 		return errors.Wrap(err, "repair")
 	}
+	if err := c.RollingRestart.Validate(); err != nil {
+		return errors.Wrap(err, "rolling_restart")
+	}
+	if err := c.SSTableMaintenance.Validate(); err != nil {
+		return errors.Wrap(err, "sstable_maintenance")
+	}
 
 	return nil
 }
@@ -11,8 +11,13 @@ import (
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/scylla-manager/pkg/config"
 	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/cleanup"
+	"github.com/scylladb/scylla-manager/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/pkg/service/compaction"
 	"github.com/scylladb/scylla-manager/pkg/service/healthcheck"
 	"github.com/scylladb/scylla-manager/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/pkg/service/rollingrestart"
+	"github.com/scylladb/scylla-manager/pkg/service/sstablemaintenance"
 	"github.com/scylladb/scylla-manager/pkg/testutils"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -66,17 +71,34 @@ func TestConfigModification(t *testing.T) {
 			UserCertFile: "ssl.cert",
 			UserKeyFile:  "ssl.key",
 		},
+		Cluster: cluster.Config{
+			TopologyCheckInterval: time.Minute,
+			TopologyPolicies: cluster.TopologyPolicies{
+				PauseRepairOnNodeJoin: true,
+				CleanupOnNodeAdded:    true,
+			},
+		},
 		Healthcheck: healthcheck.Config{
-			RelativeTimeout: time.Second,
-			MaxTimeout:      1 * time.Minute,
-			Probes:          500,
-			NodeInfoTTL:     time.Second,
+			RelativeTimeout:       time.Second,
+			MaxTimeout:            1 * time.Minute,
+			Probes:                500,
+			NodeInfoTTL:           time.Second,
+			HistorySampleInterval: 30 * time.Second,
+			DiskFreeMinPercent:    20,
+			ClockSkewMax:          500 * time.Millisecond,
+			CertExpiryMin:         7 * 24 * time.Hour,
 		},
 		Backup: backup.Config{
 			DiskSpaceFreeMinPercent:   1,
 			LongPollingTimeoutSeconds: 5,
 			AgeMax:                    12 * time.Hour,
 		},
+		Cleanup: cleanup.Config{
+			DiskSpaceFreeMinPercent: 5,
+		},
+		Compaction: compaction.Config{
+			DiskSpaceFreeMinPercent: 15,
+		},
 		Repair: repair.Config{
 			PollInterval:                    500 * time.Millisecond,
 			LongPollingTimeoutSeconds:       5,
@@ -85,6 +107,13 @@ func TestConfigModification(t *testing.T) {
 			ForceRepairType:                 repair.TypeAuto,
 			Murmur3PartitionerIgnoreMSBBits: 12,
 		},
+		RollingRestart: rollingrestart.Config{
+			WaitTimeout:  30 * time.Minute,
+			PollInterval: 10 * time.Second,
+		},
+		SSTableMaintenance: sstablemaintenance.Config{
+			DiskSpaceFreeMinPercent: 20,
+		},
 	}
 
 	if diff := cmp.Diff(c, golden, serverConfigCmpOpts); diff != "" {
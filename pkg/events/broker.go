@@ -0,0 +1,171 @@
+// Copyright (C) 2017 ScyllaDB
+
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+const (
+	// DefaultHistorySize is the default number of events kept for resuming
+	// streams.
+	DefaultHistorySize = 4096
+	// subscriptionBufferSize is the number of live events that can be queued
+	// for a subscriber before it is dropped.
+	subscriptionBufferSize = 256
+)
+
+// Filter specifies which events are delivered to a Subscription.
+// Zero values match all events.
+type Filter struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Types     []Type
+}
+
+func (f Filter) match(e Event) bool {
+	if f.ClusterID != uuid.Nil && f.ClusterID != e.ClusterID {
+		return false
+	}
+	if f.TaskID != uuid.Nil && f.TaskID != e.TaskID {
+		return false
+	}
+	if f.RunID != uuid.Nil && f.RunID != e.RunID {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == e.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription delivers events matching a filter on channel C.
+// C is closed when the subscription is closed or when subscriber is too slow
+// to consume events, in that case the stream can be resumed with a new
+// subscription using the last received event ID.
+type Subscription struct {
+	C <-chan Event
+
+	c      chan Event
+	filter Filter
+	broker *Broker
+	closed bool
+}
+
+// Close stops delivery of events and closes C.
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
+
+// Broker is an in-memory Publisher that fans out events to subscribers.
+// It keeps a bounded history of events so that subscribers can resume from
+// the last event they received.
+//
+// Event IDs are initialised with the current time in microseconds so that
+// they keep growing across restarts of the process.
+type Broker struct {
+	historySize int
+
+	mu      sync.Mutex
+	seq     uint64
+	history []Event
+	subs    map[*Subscription]struct{}
+}
+
+var _ Publisher = &Broker{}
+
+// NewBroker creates a Broker that keeps historySize last events.
+func NewBroker(historySize int) *Broker {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Broker{
+		historySize: historySize,
+		seq:         uint64(timeutc.Now().UnixNano() / 1000),
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish implements Publisher.
+func (b *Broker) Publish(ctx context.Context, e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e.ID = b.seq
+	if e.Time.IsZero() {
+		e.Time = timeutc.Now()
+	}
+
+	if len(b.history) >= b.historySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:len(b.history)-1]
+	}
+	b.history = append(b.history, e)
+
+	for s := range b.subs {
+		if !s.filter.match(e) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			b.closeLocked(s)
+		}
+	}
+}
+
+// Subscribe returns a new Subscription for events matching the filter.
+// If lastEventID is not zero events from history with greater IDs are
+// replayed before live events.
+func (b *Broker) Subscribe(f Filter, lastEventID uint64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []Event
+	if lastEventID != 0 {
+		for _, e := range b.history {
+			if e.ID > lastEventID && f.match(e) {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	c := make(chan Event, len(replay)+subscriptionBufferSize)
+	for _, e := range replay {
+		c <- e
+	}
+	s := &Subscription{
+		C:      c,
+		c:      c,
+		filter: f,
+		broker: b,
+	}
+	b.subs[s] = struct{}{}
+	return s
+}
+
+func (b *Broker) unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	b.closeLocked(s)
+	b.mu.Unlock()
+}
+
+func (b *Broker) closeLocked(s *Subscription) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	delete(b.subs, s)
+	close(s.c)
+}
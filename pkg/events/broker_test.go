@@ -0,0 +1,102 @@
+// Copyright (C) 2017 ScyllaDB
+
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+func TestBrokerSubscribe(t *testing.T) {
+	ctx := context.Background()
+	c0 := uuid.MustRandom()
+	c1 := uuid.MustRandom()
+
+	b := NewBroker(10)
+	s := b.Subscribe(Filter{ClusterID: c0}, 0)
+	defer s.Close()
+
+	b.Publish(ctx, Event{Type: RunStatus, ClusterID: c1})
+	b.Publish(ctx, Event{Type: RunStatus, ClusterID: c0})
+
+	e := <-s.C
+	if e.ClusterID != c0 {
+		t.Fatalf("ClusterID = %s, expected %s", e.ClusterID, c0)
+	}
+	if e.ID == 0 {
+		t.Fatal("expected ID to be set")
+	}
+	if e.Time.IsZero() {
+		t.Fatal("expected Time to be set")
+	}
+	select {
+	case e := <-s.C:
+		t.Fatalf("unexpected event %+v", e)
+	default:
+	}
+}
+
+func TestBrokerSubscribeResume(t *testing.T) {
+	ctx := context.Background()
+	c := uuid.MustRandom()
+
+	b := NewBroker(3)
+	for i := 0; i < 5; i++ {
+		b.Publish(ctx, Event{Type: BackupProgress, ClusterID: c, Data: i})
+	}
+
+	s := b.Subscribe(Filter{}, 1)
+	defer s.Close()
+
+	// Only last 3 events are kept in history
+	for i := 2; i < 5; i++ {
+		e := <-s.C
+		if e.Data != i {
+			t.Fatalf("Data = %v, expected %v", e.Data, i)
+		}
+	}
+
+	last := b.history[len(b.history)-1].ID
+	s1 := b.Subscribe(Filter{}, last-1)
+	defer s1.Close()
+	if e := <-s1.C; e.ID != last {
+		t.Fatalf("ID = %d, expected %d", e.ID, last)
+	}
+}
+
+func TestBrokerFilterTypes(t *testing.T) {
+	ctx := context.Background()
+
+	b := NewBroker(10)
+	s := b.Subscribe(Filter{Types: []Type{RepairProgress}}, 0)
+	defer s.Close()
+
+	b.Publish(ctx, Event{Type: BackupProgress})
+	b.Publish(ctx, Event{Type: RepairProgress})
+
+	if e := <-s.C; e.Type != RepairProgress {
+		t.Fatalf("Type = %s, expected %s", e.Type, RepairProgress)
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+
+	b := NewBroker(10)
+	s := b.Subscribe(Filter{}, 0)
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		b.Publish(ctx, Event{Type: RunStatus})
+	}
+
+	n := 0
+	for range s.C {
+		n++
+	}
+	if n != subscriptionBufferSize {
+		t.Fatalf("got %d events, expected %d", n, subscriptionBufferSize)
+	}
+	// Closing dropped subscription is a noop
+	s.Close()
+}
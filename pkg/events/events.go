@@ -0,0 +1,55 @@
+// Copyright (C) 2017 ScyllaDB
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Type specifies kind of Event.
+type Type string
+
+// Type enumeration.
+const (
+	RunStatus                  Type = "run_status"
+	BackupProgress             Type = "backup_progress"
+	CleanupProgress            Type = "cleanup_progress"
+	CompactionProgress         Type = "compaction_progress"
+	SSTableMaintenanceProgress Type = "sstable_maintenance_progress"
+	RepairProgress             Type = "repair_progress"
+	RollingRestartProgress     Type = "rolling_restart_progress"
+	SnapshotProgress           Type = "snapshot_progress"
+	ValidateBackupProgress     Type = "validate_backup_progress"
+	TopologyChange             Type = "topology_change"
+)
+
+// Event is a single state change of a task run or a cluster topology change
+// that is streamed to clients.
+// ID is assigned by Broker, it is monotonic within a Broker lifetime and can
+// be used to resume the stream.
+type Event struct {
+	ID        uint64      `json:"id"`
+	Type      Type        `json:"type"`
+	ClusterID uuid.UUID   `json:"cluster_id"`
+	TaskType  string      `json:"task_type,omitempty"`
+	TaskID    uuid.UUID   `json:"task_id"`
+	RunID     uuid.UUID   `json:"run_id"`
+	Time      time.Time   `json:"time"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Publisher is implemented by event sinks, services use it to report run
+// state changes.
+type Publisher interface {
+	Publish(ctx context.Context, e Event)
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(ctx context.Context, e Event) {}
+
+// NopPublisher discards all events.
+var NopPublisher Publisher = nopPublisher{}
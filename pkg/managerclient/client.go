@@ -26,6 +26,8 @@ var disableOpenAPIDebugOnce sync.Once
 // Client provides means to interact with Scylla Manager.
 type Client struct {
 	operations operations.ClientService
+	httpClient *http.Client
+	url        *url.URL
 }
 
 // DefaultTLSConfig specifies default TLS configuration used when creating a new
@@ -61,7 +63,11 @@ func NewClient(rawURL string, transport http.RoundTripper) (Client, error) {
 	// we change that to SCTOOL_DUMP_HTTP
 	r.Debug, _ = strconv.ParseBool(os.Getenv("SCTOOL_DUMP_HTTP"))
 
-	return Client{operations: operations.New(r, strfmt.Default)}, nil
+	return Client{
+		operations: operations.New(r, strfmt.Default),
+		httpClient: httpClient,
+		url:        u,
+	}, nil
 }
 
 // CreateCluster creates a new cluster.
@@ -115,7 +121,7 @@ func (c Client) DeleteCluster(ctx context.Context, clusterID string) error {
 }
 
 // DeleteClusterSecrets removes cluster secrets.
-func (c Client) DeleteClusterSecrets(ctx context.Context, clusterID string, cqlCreds, sslUserCert bool) error {
+func (c Client) DeleteClusterSecrets(ctx context.Context, clusterID string, cqlCreds, sslUserCert, agentTLS bool) error {
 	ok := false
 	p := &operations.DeleteClusterClusterIDParams{
 		Context:   ctx,
@@ -129,6 +135,10 @@ func (c Client) DeleteClusterSecrets(ctx context.Context, clusterID string, cqlC
 		p.SslUserCert = &sslUserCert
 		ok = true
 	}
+	if agentTLS {
+		p.AgentTLS = &agentTLS
+		ok = true
+	}
 
 	if !ok {
 		return nil
@@ -138,11 +148,41 @@ func (c Client) DeleteClusterSecrets(ctx context.Context, clusterID string, cqlC
 	return err
 }
 
+// RotateAuthToken switches cluster to a new agent auth token, if token is
+// empty the pending token is used.
+func (c Client) RotateAuthToken(ctx context.Context, clusterID, token string) error {
+	_, err := c.operations.PutClusterClusterIDAuthToken(&operations.PutClusterClusterIDAuthTokenParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+		Token:     models.AuthToken(token),
+	})
+	return err
+}
+
+// CancelAuthTokenRotation removes the pending agent auth token.
+func (c Client) CancelAuthTokenRotation(ctx context.Context, clusterID string) error {
+	_, err := c.operations.DeleteClusterClusterIDAuthToken(&operations.DeleteClusterClusterIDAuthTokenParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	return err
+}
+
 // ListClusters returns clusters.
 func (c Client) ListClusters(ctx context.Context) (ClusterSlice, error) {
-	resp, err := c.operations.GetClusters(&operations.GetClustersParams{
+	return c.ListClustersWithSelector(ctx, "")
+}
+
+// ListClustersWithSelector returns clusters with labels matching the label
+// selector, i.e. env=prod,team!=payments.
+func (c Client) ListClustersWithSelector(ctx context.Context, selector string) (ClusterSlice, error) {
+	p := &operations.GetClustersParams{
 		Context: ctx,
-	})
+	}
+	if selector != "" {
+		p.SetSelector(&selector)
+	}
+	resp, err := c.operations.GetClusters(p)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +203,37 @@ func (c Client) ClusterStatus(ctx context.Context, clusterID string) (ClusterSta
 	return ClusterStatus(resp.Payload), nil
 }
 
+// ClusterStatusHistory returns availability of cluster nodes calculated from
+// health check history between since and until.
+func (c Client) ClusterStatusHistory(ctx context.Context, clusterID string, since, until time.Time) (*StatusHistory, error) {
+	s := strfmt.DateTime(since)
+	u := strfmt.DateTime(until)
+	resp, err := c.operations.GetClusterClusterIDStatusHistory(&operations.GetClusterClusterIDStatusHistoryParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		Since:     &s,
+		Until:     &u,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatusHistory{StatusHistory: *resp.Payload}, nil
+}
+
+// ClusterAgentConfig returns the effective agent config of cluster nodes.
+func (c Client) ClusterAgentConfig(ctx context.Context, clusterID string) (AgentConfig, error) {
+	resp, err := c.operations.GetClusterClusterIDStatusAgentConfig(&operations.GetClusterClusterIDStatusAgentConfigParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return AgentConfig(resp.Payload), nil
+}
+
 // GetRepairTarget fetches information about repair target.
 func (c *Client) GetRepairTarget(ctx context.Context, clusterID string, t *Task) (*RepairTarget, error) {
 	resp, err := c.operations.GetClusterClusterIDTasksRepairTarget(&operations.GetClusterClusterIDTasksRepairTargetParams{
@@ -191,6 +262,92 @@ func (c *Client) GetBackupTarget(ctx context.Context, clusterID string, t *Task)
 	return &BackupTarget{BackupTarget: *resp.Payload}, nil
 }
 
+// GetBackupEstimate fetches forecast of storage usage and cost of backups of
+// the task.
+func (c *Client) GetBackupEstimate(ctx context.Context, clusterID string, t *Task) (*BackupEstimate, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksBackupEstimate(&operations.GetClusterClusterIDTasksBackupEstimateParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackupEstimate{BackupEstimate: *resp.Payload}, nil
+}
+
+// GetCleanupTarget fetches information about cleanup target.
+func (c *Client) GetCleanupTarget(ctx context.Context, clusterID string, t *Task) (*CleanupTarget, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksCleanupTarget(&operations.GetClusterClusterIDTasksCleanupTargetParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CleanupTarget{CleanupTarget: *resp.Payload}, nil
+}
+
+// GetCompactionTarget fetches information about compaction target.
+func (c *Client) GetCompactionTarget(ctx context.Context, clusterID string, t *Task) (*CompactionTarget, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksCompactionTarget(&operations.GetClusterClusterIDTasksCompactionTargetParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompactionTarget{CompactionTarget: *resp.Payload}, nil
+}
+
+// GetRollingRestartTarget fetches information about rolling restart target.
+func (c *Client) GetRollingRestartTarget(ctx context.Context, clusterID string, t *Task) (*RollingRestartTarget, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksRollingRestartTarget(&operations.GetClusterClusterIDTasksRollingRestartTargetParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RollingRestartTarget{RollingRestartTarget: *resp.Payload}, nil
+}
+
+// GetSSTableMaintenanceTarget fetches information about SSTable maintenance
+// target.
+func (c *Client) GetSSTableMaintenanceTarget(ctx context.Context, clusterID string, t *Task) (*SSTableMaintenanceTarget, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksSstableMaintenanceTarget(&operations.GetClusterClusterIDTasksSstableMaintenanceTargetParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSTableMaintenanceTarget{SSTableMaintenanceTarget: *resp.Payload}, nil
+}
+
+// GetSnapshotTarget fetches information about snapshot target.
+func (c *Client) GetSnapshotTarget(ctx context.Context, clusterID string, t *Task) (*SnapshotTarget, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksSnapshotTarget(&operations.GetClusterClusterIDTasksSnapshotTargetParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotTarget{SnapshotTarget: *resp.Payload}, nil
+}
+
 // CreateTask creates a new task.
 func (c *Client) CreateTask(ctx context.Context, clusterID string, t *Task) (uuid.UUID, error) {
 	params := &operations.PostClusterClusterIDTasksParams{
@@ -390,6 +547,91 @@ func (c Client) ValidateBackupProgress(ctx context.Context, clusterID, taskID, r
 	}, nil
 }
 
+// CleanupProgress returns cleanup progress.
+func (c Client) CleanupProgress(ctx context.Context, clusterID, taskID, runID string) (CleanupProgress, error) {
+	resp, err := c.operations.GetClusterClusterIDTaskCleanupTaskIDRunID(&operations.GetClusterClusterIDTaskCleanupTaskIDRunIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		RunID:     runID,
+	})
+	if err != nil {
+		return CleanupProgress{}, err
+	}
+
+	return CleanupProgress{
+		TaskRunCleanupProgress: resp.Payload,
+	}, nil
+}
+
+// CompactionProgress returns compaction progress.
+func (c Client) CompactionProgress(ctx context.Context, clusterID, taskID, runID string) (CompactionProgress, error) {
+	resp, err := c.operations.GetClusterClusterIDTaskCompactionTaskIDRunID(&operations.GetClusterClusterIDTaskCompactionTaskIDRunIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		RunID:     runID,
+	})
+	if err != nil {
+		return CompactionProgress{}, err
+	}
+
+	return CompactionProgress{
+		TaskRunCompactionProgress: resp.Payload,
+	}, nil
+}
+
+// RollingRestartProgress returns rolling restart progress.
+func (c Client) RollingRestartProgress(ctx context.Context, clusterID, taskID, runID string) (RollingRestartProgress, error) {
+	resp, err := c.operations.GetClusterClusterIDTaskRollingRestartTaskIDRunID(&operations.GetClusterClusterIDTaskRollingRestartTaskIDRunIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		RunID:     runID,
+	})
+	if err != nil {
+		return RollingRestartProgress{}, err
+	}
+
+	return RollingRestartProgress{
+		TaskRunRollingRestartProgress: resp.Payload,
+	}, nil
+}
+
+// SSTableMaintenanceProgress returns SSTable maintenance progress.
+func (c Client) SSTableMaintenanceProgress(ctx context.Context, clusterID, taskID, runID string) (SSTableMaintenanceProgress, error) {
+	resp, err := c.operations.GetClusterClusterIDTaskSstableMaintenanceTaskIDRunID(&operations.GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		RunID:     runID,
+	})
+	if err != nil {
+		return SSTableMaintenanceProgress{}, err
+	}
+
+	return SSTableMaintenanceProgress{
+		TaskRunSSTableMaintenanceProgress: resp.Payload,
+	}, nil
+}
+
+// SnapshotProgress returns snapshot progress.
+func (c Client) SnapshotProgress(ctx context.Context, clusterID, taskID, runID string) (SnapshotProgress, error) {
+	resp, err := c.operations.GetClusterClusterIDTaskSnapshotTaskIDRunID(&operations.GetClusterClusterIDTaskSnapshotTaskIDRunIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		RunID:     runID,
+	})
+	if err != nil {
+		return SnapshotProgress{}, err
+	}
+
+	return SnapshotProgress{
+		TaskRunSnapshotProgress: resp.Payload,
+	}, nil
+}
+
 // ListBackups returns listing of available backups.
 func (c Client) ListBackups(ctx context.Context, clusterID string,
 	locations []string, allClusters bool, keyspace []string, minDate, maxDate strfmt.DateTime) (BackupListItems, error) {
@@ -439,6 +681,28 @@ func (c Client) ListBackupFiles(ctx context.Context, clusterID string,
 	return resp.Payload, nil
 }
 
+// GetBackupDiff compares files of two backup snapshots.
+func (c Client) GetBackupDiff(ctx context.Context, clusterID string,
+	locations []string, allClusters bool, keyspace []string, snapshotTags []string) (*BackupDiff, error) {
+	p := &operations.GetClusterClusterIDBackupsDiffParams{
+		Context:      ctx,
+		ClusterID:    clusterID,
+		Locations:    locations,
+		Keyspace:     keyspace,
+		SnapshotTags: snapshotTags,
+	}
+	if !allClusters {
+		p.QueryClusterID = &clusterID
+	}
+
+	resp, err := c.operations.GetClusterClusterIDBackupsDiff(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackupDiff{BackupDiff: *resp.Payload}, nil
+}
+
 // DeleteSnapshot deletes backup snapshot with all data associated with it.
 func (c Client) DeleteSnapshot(ctx context.Context, clusterID string,
 	locations []string, snapshotTags []string) error {
@@ -453,6 +717,52 @@ func (c Client) DeleteSnapshot(ctx context.Context, clusterID string,
 	return err
 }
 
+// ListNodeSnapshots returns snapshots kept on nodes.
+func (c Client) ListNodeSnapshots(ctx context.Context, clusterID string,
+	host, dc, snapshotTag []string, olderThan strfmt.DateTime) (NodeSnapshotsSlice, error) {
+	p := &operations.GetClusterClusterIDSnapshotsParams{
+		Context:     ctx,
+		ClusterID:   clusterID,
+		Host:        host,
+		Dc:          dc,
+		SnapshotTag: snapshotTag,
+	}
+	if !time.Time(olderThan).IsZero() {
+		p.OlderThan = &olderThan
+	}
+
+	resp, err := c.operations.GetClusterClusterIDSnapshots(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// DeleteNodeSnapshots deletes snapshots kept on nodes, it returns the deleted
+// snapshots.
+func (c Client) DeleteNodeSnapshots(ctx context.Context, clusterID string,
+	host, dc, snapshotTag []string, olderThan strfmt.DateTime, all bool) (NodeSnapshotsSlice, error) {
+	p := &operations.DeleteClusterClusterIDSnapshotsParams{
+		Context:     ctx,
+		ClusterID:   clusterID,
+		Host:        host,
+		Dc:          dc,
+		SnapshotTag: snapshotTag,
+		All:         &all,
+	}
+	if !time.Time(olderThan).IsZero() {
+		p.OlderThan = &olderThan
+	}
+
+	resp, err := c.operations.DeleteClusterClusterIDSnapshots(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
 // Version returns server version.
 func (c Client) Version(ctx context.Context) (*models.Version, error) {
 	resp, err := c.operations.GetVersion(&operations.GetVersionParams{
@@ -465,6 +775,19 @@ func (c Client) Version(ctx context.Context) (*models.Version, error) {
 	return resp.Payload, nil
 }
 
+// SetBackupRateLimit updates ongoing backup rate limits and rate limit
+// schedule.
+func (c Client) SetBackupRateLimit(ctx context.Context, clusterID string, rateLimit, rateLimitSchedule []string) error {
+	p := &operations.PutClusterClusterIDBackupsRateLimitParams{
+		Context:           ctx,
+		ClusterID:         clusterID,
+		RateLimit:         rateLimit,
+		RateLimitSchedule: rateLimitSchedule,
+	}
+	_, err := c.operations.PutClusterClusterIDBackupsRateLimit(p) // nolint: errcheck
+	return err
+}
+
 // SetRepairIntensity updates ongoing repair intensity.
 func (c Client) SetRepairIntensity(ctx context.Context, clusterID string, intensity float64) error {
 	p := &operations.PutClusterClusterIDRepairsIntensityParams{
@@ -11,9 +11,14 @@ import (
 )
 
 const (
-	backupTaskType         = "backup"
-	repairTaskType         = "repair"
-	validateBackupTaskType = "validate_backup"
+	backupTaskType             = "backup"
+	cleanupTaskType            = "cleanup"
+	compactionTaskType         = "compaction"
+	repairTaskType             = "repair"
+	rollingRestartTaskType     = "rolling_restart"
+	snapshotTaskType           = "snapshot"
+	sstableMaintenanceTaskType = "sstable_maintenance"
+	validateBackupTaskType     = "validate_backup"
 )
 
 // CmdRenderType defines CmdRenderer output type.
@@ -81,8 +86,14 @@ func byteCount(val string) string {
 }
 
 // writeProp adds a map from argument to the task property.
+// Nested properties are referenced with dot separated path i.e. hooks.post_upload.
 // Transformers will be applied to the value in the provided order.
 func (rc *CmdRenderer) writeProp(arg, prop string, transformers ...transformer) {
+	rc.writePropSep(arg, prop, ",", transformers...)
+}
+
+// writePropSep is like writeProp but list values are joined with sep.
+func (rc *CmdRenderer) writePropSep(arg, prop, sep string, transformers ...transformer) {
 	if rc.task.Properties == nil {
 		return
 	}
@@ -90,7 +101,13 @@ func (rc *CmdRenderer) writeProp(arg, prop string, transformers ...transformer)
 	if !ok {
 		return
 	}
-	v, ok := p[prop]
+	path := strings.Split(prop, ".")
+	for _, k := range path[:len(path)-1] {
+		if p, ok = p[k].(map[string]interface{}); !ok {
+			return
+		}
+	}
+	v, ok := p[path[len(path)-1]]
 	if !ok || v == nil {
 		return
 	}
@@ -103,7 +120,7 @@ func (rc *CmdRenderer) writeProp(arg, prop string, transformers ...transformer)
 		for i := range tmp {
 			tmp[i] = val[i].(string)
 		}
-		out := strings.Join(tmp, ",")
+		out := strings.Join(tmp, sep)
 		for i := range transformers {
 			out = transformers[i](out)
 		}
@@ -112,13 +129,17 @@ func (rc *CmdRenderer) writeProp(arg, prop string, transformers ...transformer)
 		if len(val) == 0 {
 			return
 		}
-		out := strings.Join(val, ",")
+		out := strings.Join(val, sep)
 		for i := range transformers {
 			out = transformers[i](out)
 		}
 		rc.writeArg(arg, " ", out)
 	case bool:
-		rc.writeArg(arg)
+		if val {
+			rc.writeArg(arg)
+		} else {
+			rc.writeArg(arg, "=", "false")
+		}
 	default:
 		out := fmt.Sprintf("%v", v)
 		for i := range transformers {
@@ -141,6 +162,9 @@ func (rc CmdRenderer) Render(w io.Writer) error {
 		if rc.task.Schedule.Interval != "" {
 			rc.writeArg("--interval", " ", rc.task.Schedule.Interval)
 		}
+		if len(rc.task.Schedule.Window) > 0 {
+			rc.writeArg("--window", " ", strings.Join(rc.task.Schedule.Window, ","))
+		}
 		fallthrough
 	case RenderTypeArgs:
 		switch rc.task.Type {
@@ -150,9 +174,38 @@ func (rc CmdRenderer) Render(w io.Writer) error {
 			rc.writeProp("-L", "location")
 			rc.writeProp("--retention", "retention")
 			rc.writeProp("--rate-limit", "rate_limit")
+			rc.writePropSep("--rate-limit-schedule", "rate_limit_schedule", " ", quoted)
 			rc.writeProp("--snapshot-parallel", "snapshot_parallel", quoted)
 			rc.writeProp("--upload-parallel", "upload_parallel", quoted)
+			rc.writeProp("--storage-class", "storage_class", quoted)
+			rc.writeProp("--object-tags", "object_tags")
+			rc.writeProp("--lock-period", "lock_period")
+			rc.writeProp("--lock-mode", "lock_mode")
+			rc.writeProp("--stagger-dc", "stagger_dc")
+			rc.writeProp("--single-replica", "single_replica")
 			rc.writeProp("--purge-only", "purge_only")
+			rc.writeProp("--pre-snapshot-hook", "hooks.pre_snapshot", quoted)
+			rc.writeProp("--post-snapshot-hook", "hooks.post_snapshot", quoted)
+			rc.writeProp("--post-upload-hook", "hooks.post_upload", quoted)
+			rc.writeProp("--hook-failure-policy", "hooks.on_failure")
+		case cleanupTaskType, compactionTaskType:
+			rc.writeProp("-K", "keyspace", quoted)
+			rc.writeProp("--dc", "dc", quoted)
+			rc.writeProp("--parallel", "parallel", quoted)
+		case rollingRestartTaskType:
+			rc.writeProp("--dc", "dc", quoted)
+			rc.writeProp("--dc-order", "dc_order", quoted)
+		case snapshotTaskType:
+			rc.writeProp("-K", "keyspace", quoted)
+			rc.writeProp("--dc", "dc", quoted)
+		case sstableMaintenanceTaskType:
+			rc.writeProp("--mode", "mode")
+			rc.writeProp("--scrub-mode", "scrub_mode")
+			rc.writeProp("--include-all-sstables", "include_all_sstables")
+			rc.writeProp("--snapshot", "snapshot")
+			rc.writeProp("-K", "keyspace", quoted)
+			rc.writeProp("--dc", "dc", quoted)
+			rc.writeProp("--parallel", "parallel", quoted)
 		case repairTaskType:
 			rc.writeProp("-K", "keyspace", quoted)
 			rc.writeProp("--dc", "dc", quoted)
@@ -46,12 +46,18 @@ func TestCmdlineRender(t *testing.T) {
 			NumRetries: 3,
 		},
 		Properties: map[string]interface{}{
-			"keyspace":          []interface{}{"test_keyspace_dc1_rf3.*", "!test_keyspace_dc2*"},
-			"dc":                []interface{}{"dc1", "dc2"},
-			"snapshot_parallel": []interface{}{"dc1:2", "dc2:3"},
-			"upload_parallel":   []interface{}{"dc1:4", "dc2:1"},
-			"rate_limit":        2,
-			"retention":         3,
+			"keyspace":            []interface{}{"test_keyspace_dc1_rf3.*", "!test_keyspace_dc2*"},
+			"dc":                  []interface{}{"dc1", "dc2"},
+			"snapshot_parallel":   []interface{}{"dc1:2", "dc2:3"},
+			"upload_parallel":     []interface{}{"dc1:4", "dc2:1"},
+			"rate_limit":          2,
+			"rate_limit_schedule": []interface{}{"dc1:08:00,50", "dc1:20:00,0"},
+			"retention":           3,
+			"hooks": map[string]interface{}{
+				"pre_snapshot":  "pause_ingest.sh",
+				"post_snapshot": "resume_ingest.sh",
+				"on_failure":    "continue",
+			},
 		},
 	}
 
@@ -74,7 +80,82 @@ func TestCmdlineRender(t *testing.T) {
 		},
 	}
 
-	for _, task := range []*Task{repairTask, backupTask, backupTaskNilProperties} {
+	cleanupTask := &Task{
+		ClusterID: "564a4ef1-0f37-40c5-802c-d08d788b8503",
+		Type:      "cleanup",
+		Name:      "cleanup",
+		Schedule: &Schedule{
+			StartDate:  strfmt.DateTime(time.Date(2019, 3, 18, 23, 0, 0, 0, time.UTC)),
+			NumRetries: 3,
+		},
+		Properties: map[string]interface{}{
+			"keyspace": []interface{}{"test_keyspace_dc1_rf3.*"},
+			"dc":       []interface{}{"dc1"},
+			"parallel": []interface{}{"2", "dc1:1"},
+		},
+	}
+
+	compactionTask := &Task{
+		ClusterID: "564a4ef1-0f37-40c5-802c-d08d788b8503",
+		Type:      "compaction",
+		Name:      "compaction",
+		Schedule: &Schedule{
+			Interval:   "7d",
+			StartDate:  strfmt.DateTime(time.Date(2019, 3, 18, 23, 0, 0, 0, time.UTC)),
+			NumRetries: 3,
+			Window:     []string{"Sat-22:00", "Sun-6:00"},
+		},
+		Properties: map[string]interface{}{
+			"keyspace": []interface{}{"test_keyspace_dc1_rf3.*"},
+			"parallel": []interface{}{"2"},
+		},
+	}
+
+	sstableMaintenanceTask := &Task{
+		ClusterID: "564a4ef1-0f37-40c5-802c-d08d788b8503",
+		Type:      "sstable_maintenance",
+		Name:      "sstable_maintenance",
+		Schedule: &Schedule{
+			StartDate:  strfmt.DateTime(time.Date(2019, 3, 18, 23, 0, 0, 0, time.UTC)),
+			NumRetries: 3,
+		},
+		Properties: map[string]interface{}{
+			"mode":       "scrub",
+			"scrub_mode": "skip",
+			"snapshot":   false,
+			"keyspace":   []interface{}{"test_keyspace_dc1_rf3.*"},
+		},
+	}
+
+	rollingRestartTask := &Task{
+		ClusterID: "564a4ef1-0f37-40c5-802c-d08d788b8503",
+		Type:      "rolling_restart",
+		Name:      "rolling_restart",
+		Schedule: &Schedule{
+			StartDate:  strfmt.DateTime(time.Date(2019, 3, 18, 23, 0, 0, 0, time.UTC)),
+			NumRetries: 0,
+		},
+		Properties: map[string]interface{}{
+			"dc":       []interface{}{"dc1", "dc2"},
+			"dc_order": []interface{}{"dc2", "dc1"},
+		},
+	}
+
+	snapshotTask := &Task{
+		ClusterID: "564a4ef1-0f37-40c5-802c-d08d788b8503",
+		Type:      "snapshot",
+		Name:      "snapshot",
+		Schedule: &Schedule{
+			StartDate:  strfmt.DateTime(time.Date(2019, 3, 18, 23, 0, 0, 0, time.UTC)),
+			NumRetries: 0,
+		},
+		Properties: map[string]interface{}{
+			"keyspace": []interface{}{"test_keyspace_dc1_rf3.*", "!system_auth"},
+			"dc":       []interface{}{"dc1"},
+		},
+	}
+
+	for _, task := range []*Task{repairTask, backupTask, backupTaskNilProperties, cleanupTask, compactionTask, sstableMaintenanceTask, rollingRestartTask, snapshotTask} {
 		for _, r := range []CmdRenderType{RenderAll, RenderArgs, RenderTypeArgs} {
 			t.Run(task.Name+" "+fmt.Sprint(r), func(t *testing.T) {
 				var buf bytes.Buffer
@@ -0,0 +1,122 @@
+// Copyright (C) 2017 ScyllaDB
+
+package managerclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EventsFilter specifies events that should be streamed.
+type EventsFilter struct {
+	TaskID string
+	RunID  string
+	Types  []string
+}
+
+// WatchEventsRetryInterval specifies how long to wait before reconnecting
+// a broken events stream.
+var WatchEventsRetryInterval = time.Second
+
+// WatchEvents streams cluster events and calls f for every received event.
+// If the stream is interrupted it's resumed from the last received event.
+// Streaming stops when context is canceled or f returns an error, in that
+// case the error is returned.
+func (c Client) WatchEvents(ctx context.Context, clusterID string, filter EventsFilter, f func(e *Event) error) error {
+	var lastEventID int64
+	for {
+		err := c.streamEvents(ctx, clusterID, filter, lastEventID, func(e *Event) error {
+			lastEventID = e.ID
+			return f(e)
+		})
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(WatchEventsRetryInterval):
+		}
+	}
+}
+
+// streamEvents reads a single events stream, it returns nil if the stream
+// ended and can be resumed.
+func (c Client) streamEvents(ctx context.Context, clusterID string, filter EventsFilter, lastEventID int64, f func(e *Event) error) error {
+	u := *c.url
+	u.Path = path.Join(u.Path, "cluster", clusterID, "events")
+	q := url.Values{}
+	if filter.TaskID != "" {
+		q.Set("task_id", filter.TaskID)
+	}
+	if filter.RunID != "" {
+		q.Set("run_id", filter.RunID)
+	}
+	for _, t := range filter.Types {
+		q.Add("type", t)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(lastEventID, 10))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.Wrap(err, "stream events")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+			return errors.Errorf("stream events: unexpected status code %d", resp.StatusCode)
+		}
+		return errors.Errorf("stream events: %s", e.Message)
+	}
+
+	var data strings.Builder
+	s := bufio.NewScanner(resp.Body)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			e := new(Event)
+			if err := json.Unmarshal([]byte(data.String()), e); err != nil {
+				return errors.Wrap(err, "decode event")
+			}
+			data.Reset()
+			if err := f(e); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	// Stream ended, it can be resumed.
+	return nil
+}
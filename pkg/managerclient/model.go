@@ -38,13 +38,13 @@ type ClusterSlice []*models.Cluster
 
 // Render renders ClusterSlice in a tabular format.
 func (cs ClusterSlice) Render(w io.Writer) error {
-	t := table.New("ID", "Name", "Port")
+	t := table.New("ID", "Name", "Port", "Labels")
 	for _, c := range cs {
 		p := "default"
 		if c.Port != 0 {
 			p = fmt.Sprint(c.Port)
 		}
-		t.AddRow(c.ID, c.Name, p)
+		t.AddRow(c.ID, c.Name, p, FormatLabels(c.Labels))
 	}
 	if _, err := w.Write([]byte(t.String())); err != nil {
 		return err
@@ -150,6 +150,7 @@ func (cs ClusterStatus) Render(w io.Writer) error {
 		if s.RestCause != "" {
 			errors = append(errors, fmt.Sprintf("%s REST: %s", s.Host, s.RestCause))
 		}
+		errors = append(errors, nodeChecksErrors(s)...)
 
 		var (
 			cpus          = "-"
@@ -184,6 +185,104 @@ func (cs ClusterStatus) Render(w io.Writer) error {
 	return nil
 }
 
+// StatusHistory contains availability of cluster nodes and datacenters.
+type StatusHistory struct {
+	models.StatusHistory
+}
+
+// Render renders StatusHistory in a tabular format.
+func (sh StatusHistory) Render(w io.Writer) error {
+	fmt.Fprintf(w, "Since: %s\nUntil: %s\n\n", FormatTime(sh.Since), FormatTime(sh.Until))
+	if len(sh.Nodes) == 0 {
+		fmt.Fprintln(w, "No health check history")
+		return nil
+	}
+
+	hasAlternator := false
+	for _, a := range sh.Datacenters {
+		if a.Alternator != nil {
+			hasAlternator = true
+		}
+	}
+	headers := []interface{}{"Address"}
+	if hasAlternator {
+		headers = append(headers, "Alternator")
+	}
+	headers = append(headers, "CQL", "REST")
+
+	row := func(name string, a *models.Availability) []interface{} {
+		r := []interface{}{name}
+		if hasAlternator {
+			r = append(r, formatPingStats(a.Alternator))
+		}
+		return append(r, formatPingStats(a.Cql), formatPingStats(a.Rest))
+	}
+
+	for _, dc := range sh.Datacenters {
+		t := table.New(headers...)
+		t.AddRow(row("All", dc)...)
+		t.AddSeparator()
+		for _, n := range sh.Nodes {
+			if n.Dc == dc.Dc {
+				t.AddRow(row(n.Host, n)...)
+			}
+		}
+		fmt.Fprintf(w, "Datacenter: %s\n%s", dc.Dc, t)
+	}
+	return nil
+}
+
+// AgentConfig contains the effective agent config of cluster nodes.
+type AgentConfig []*models.NodeAgentConfig
+
+// Render renders agent config of every node in YAML format.
+func (ac AgentConfig) Render(w io.Writer) error {
+	for _, c := range ac {
+		fmt.Fprintf(w, "Agent config: %s (%s)\n", c.Host, c.Dc)
+		if c.Error != "" {
+			fmt.Fprintf(w, "Error: %s\n\n", c.Error)
+			continue
+		}
+		for _, l := range strings.Split(strings.TrimRight(c.Config, "\n"), "\n") {
+			fmt.Fprintf(w, "  %s\n", l)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func formatPingStats(s *models.PingStats) string {
+	if s == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%% (p50 %.0fms, p95 %.0fms, p99 %.0fms)", s.Availability, s.RttP50Ms, s.RttP95Ms, s.RttP99Ms)
+}
+
+// nodeChecksErrors returns descriptions of failed node checks other than
+// pings.
+func nodeChecksErrors(s *models.ClusterStatusItems0) []string {
+	var out []string
+	if s.DiskStatus != "" && s.DiskStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s disk: %s (%.0f%% free)", s.Host, s.DiskStatus, s.DiskFreePercent))
+	}
+	if s.ClockStatus != "" && s.ClockStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s clock: %s (%.0fms offset)", s.Host, s.ClockStatus, s.ClockOffsetMs))
+	}
+	if s.CertStatus != "" && s.CertStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s certificate: %s (expires %s)", s.Host, s.CertStatus, FormatTime(s.CertExpiry)))
+	}
+	if s.SchemaStatus != "" && s.SchemaStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s schema: %s (version %s)", s.Host, s.SchemaStatus, s.SchemaVersion))
+	}
+	if s.GossipStatus != "" && s.GossipStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s gossip: %s %s", s.Host, s.GossipStatus, s.GossipCause))
+	}
+	if s.AgentVersionStatus != "" && s.AgentVersionStatus != "OK" {
+		out = append(out, fmt.Sprintf("%s agent version: %s %s", s.Host, s.AgentVersionStatus, s.AgentVersionCause))
+	}
+	return out
+}
+
 // Task is a scheduler.Task representation.
 type Task = models.Task
 
@@ -257,6 +356,29 @@ Locations:
 {{- range .Location }}
   - {{ . }}
 {{- end }}
+{{- if .StorageClass }}
+
+Storage Classes:
+{{- range .StorageClass }}
+  - {{ . }}
+{{- end }}
+{{- end }}
+{{- if .ObjectTags }}
+
+Object Tags: cluster ID, task ID, snapshot tag
+{{- end }}
+{{- if .LockPeriod }}
+
+Object Lock: {{ .LockPeriod }} {{ .LockMode }}
+{{- end }}
+{{- if .StaggerDc }}
+
+Datacenters are backed up one after another
+{{- end }}
+{{- if .SingleReplica }}
+
+Only nodes holding a single replica of every token range are backed up
+{{- end }}
 
 Bandwidth Limits:
 {{- if .RateLimit -}}
@@ -266,6 +388,13 @@ Bandwidth Limits:
 {{- else }}
   - Unlimited
 {{- end }}
+{{- if .RateLimitSchedule }}
+
+Bandwidth Schedule (UTC):
+{{- range .RateLimitSchedule }}
+  - {{ . }} MiB/s
+{{- end }}
+{{- end }}
 
 Snapshot Parallel Limits:
 {{- if .SnapshotParallel -}}
@@ -284,6 +413,20 @@ Upload Parallel Limits:
 {{- else }}
   - All hosts in parallel
 {{- end }}
+{{- with .Hooks }}
+
+Hooks:
+{{- if .PreSnapshot }}
+  - pre snapshot: {{ .PreSnapshot }}
+{{- end }}
+{{- if .PostSnapshot }}
+  - post snapshot: {{ .PostSnapshot }}
+{{- end }}
+{{- if .PostUpload }}
+  - post upload: {{ .PostUpload }}
+{{- end }}
+  - on failure: {{ .OnFailure }}
+{{- end }}
 
 Retention: Last {{ .Retention }} backups
 
@@ -300,6 +443,230 @@ func (t BackupTarget) Render(w io.Writer) error {
 	return temp.Execute(w, t)
 }
 
+// BackupEstimate is a forecast of storage usage and cost of backups.
+type BackupEstimate struct {
+	models.BackupEstimate
+}
+
+const backupEstimateTemplate = `Snapshot size: ~{{ StringByteCount .Size }} ({{ .Sstables }} SSTables)
+Retention: Last {{ .Retention }} backups, every {{ .Interval }}
+{{- if .Runs }}
+Upload ratio: {{ Percent .UploadRatio }} of snapshot size (based on {{ .Runs }} previous runs)
+{{- else }}
+Upload ratio: {{ Percent .UploadRatio }} of snapshot size (no previous runs)
+{{- end }}
+{{ range .Locations }}
+Location: {{ .Location }}{{ if .StorageClass }} ({{ .StorageClass }}){{ end }}
+  Nodes: {{ .Nodes }}
+  Snapshot size: ~{{ StringByteCount .Size }}
+  Storage growth:
+{{- range $i, $s := .Growth }}
+    - after backup {{ inc $i }}: ~{{ StringByteCount $s }}
+{{- end }}
+  Requests per backup: ~{{ .RequestsPerBackup }}
+  Requests per month: ~{{ .RequestsPerMonth }}
+{{- if .CostPerMonth }}
+  Cost per month: ~{{ FormatCost .CostPerMonth }} (storage {{ FormatCost .StorageCostPerMonth }}, requests {{ FormatCost .RequestsCostPerMonth }})
+{{- else }}
+  Cost per month: unknown, set prices of the provider in the backup section of scylla-manager.yaml
+{{- end }}
+{{ end -}}
+`
+
+// Render implements Renderer interface.
+func (e BackupEstimate) Render(w io.Writer) error {
+	temp := template.Must(template.New("estimate").Funcs(template.FuncMap{
+		"StringByteCount": StringByteCount,
+		"Percent": func(v float64) string {
+			return fmt.Sprintf("%.1f%%", v*100)
+		},
+		"FormatCost": func(v *float64) string {
+			return fmt.Sprintf("%.2f", *v)
+		},
+		"inc": func(i int) int {
+			return i + 1
+		},
+	}).Parse(backupEstimateTemplate))
+	return temp.Execute(w, e)
+}
+
+// CleanupTarget is a representing results of dry running cleanup task.
+type CleanupTarget struct {
+	models.CleanupTarget
+	ShowTables int
+}
+
+const cleanupTargetTemplate = `Data Centers:
+{{ range .Dc }}  - {{ . }}
+{{ end }}
+Keyspaces:
+{{- range .Units }}
+  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
+{{- end }}
+
+Hosts:
+{{- range .Hosts }}
+  - {{ . }}
+{{- end }}
+
+Parallel Limits:
+{{- if .Parallel -}}
+{{- range .Parallel }}
+  - {{ . }}
+{{- end }}
+{{- else }}
+  - 1 host per data center
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (t CleanupTarget) Render(w io.Writer) error {
+	temp := template.Must(template.New("target").Funcs(template.FuncMap{
+		"FormatTables": func(tables []string, all bool) string {
+			return FormatTables(t.ShowTables, tables, all)
+		},
+	}).Parse(cleanupTargetTemplate))
+	return temp.Execute(w, t)
+}
+
+// CompactionTarget is a representing results of dry running compaction task.
+type CompactionTarget struct {
+	models.CompactionTarget
+	ShowTables int
+}
+
+const compactionTargetTemplate = `Data Centers:
+{{ range .Dc }}  - {{ . }}
+{{ end }}
+Keyspaces:
+{{- range .Units }}
+  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
+{{- end }}
+
+Hosts:
+{{- range .Hosts }}
+  - {{ . }}
+{{- end }}
+
+Parallel Limits:
+{{- if .Parallel -}}
+{{- range .Parallel }}
+  - {{ . }}
+{{- end }}
+{{- else }}
+  - 1 host per data center
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (t CompactionTarget) Render(w io.Writer) error {
+	temp := template.Must(template.New("target").Funcs(template.FuncMap{
+		"FormatTables": func(tables []string, all bool) string {
+			return FormatTables(t.ShowTables, tables, all)
+		},
+	}).Parse(compactionTargetTemplate))
+	return temp.Execute(w, t)
+}
+
+// RollingRestartTarget is a representing results of dry running rolling
+// restart task.
+type RollingRestartTarget struct {
+	models.RollingRestartTarget
+}
+
+const rollingRestartTargetTemplate = `Data Centers:
+{{ range .Dc }}  - {{ . }}
+{{ end }}
+Restart Order:
+{{- range .Hosts }}
+  - {{ . }}
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (t RollingRestartTarget) Render(w io.Writer) error {
+	temp := template.Must(template.New("target").Parse(rollingRestartTargetTemplate))
+	return temp.Execute(w, t)
+}
+
+// SSTableMaintenanceTarget is a representing results of dry running SSTable
+// maintenance task.
+type SSTableMaintenanceTarget struct {
+	models.SSTableMaintenanceTarget
+	ShowTables int
+}
+
+const sstableMaintenanceTargetTemplate = `Mode: {{ .Mode }}{{ if .ScrubMode }} ({{ .ScrubMode }}){{ end }}{{ if .IncludeAllSstables }} (all SSTables){{ end }}
+Snapshot: {{ if .Snapshot }}yes{{ else }}no{{ end }}
+
+Data Centers:
+{{ range .Dc }}  - {{ . }}
+{{ end }}
+Keyspaces:
+{{- range .Units }}
+  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
+{{- end }}
+
+Hosts:
+{{- range .Hosts }}
+  - {{ . }}
+{{- end }}
+
+Parallel Limits:
+{{- if .Parallel -}}
+{{- range .Parallel }}
+  - {{ . }}
+{{- end }}
+{{- else }}
+  - 1 host per data center
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (t SSTableMaintenanceTarget) Render(w io.Writer) error {
+	temp := template.Must(template.New("target").Funcs(template.FuncMap{
+		"FormatTables": func(tables []string, all bool) string {
+			return FormatTables(t.ShowTables, tables, all)
+		},
+	}).Parse(sstableMaintenanceTargetTemplate))
+	return temp.Execute(w, t)
+}
+
+// SnapshotTarget is a representing results of dry running snapshot task.
+type SnapshotTarget struct {
+	models.SnapshotTarget
+	ShowTables int
+}
+
+const snapshotTargetTemplate = `Data Centers:
+{{ range .Dc }}  - {{ . }}
+{{ end }}
+Keyspaces:
+{{- range .Units }}
+  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
+{{- end }}
+
+Hosts:
+{{- range .Hosts }}
+  - {{ . }}
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (t SnapshotTarget) Render(w io.Writer) error {
+	temp := template.Must(template.New("target").Funcs(template.FuncMap{
+		"FormatTables": func(tables []string, all bool) string {
+			return FormatTables(t.ShowTables, tables, all)
+		},
+	}).Parse(snapshotTargetTemplate))
+	return temp.Execute(w, t)
+}
+
 // ExtendedTask is a representation of scheduler.Task with additional fields
 // from scheduler.Run.
 type ExtendedTask = models.ExtendedTask
@@ -350,6 +717,9 @@ func (et ExtendedTasks) Render(w io.Writer) error {
 // Schedule is a scheduler.Schedule representation.
 type Schedule = models.Schedule
 
+// Event is a task run status change or progress update.
+type Event = models.Event
+
 // TaskRun is a scheduler.TaskRun representation.
 type TaskRun = models.TaskRun
 
@@ -590,6 +960,11 @@ func (bp *BackupProgress) AggregateErrors() {
 			}
 		}
 	}
+	for _, h := range bp.Progress.Hooks {
+		if h.Error != "" {
+			bp.Errors = append(bp.Errors, fmt.Sprintf("%s: %s hook %s: %s", h.Host, h.Stage, h.Name, h.Error))
+		}
+	}
 }
 
 // Render renders *BackupProgress in a tabular format.
@@ -606,6 +981,12 @@ func (bp BackupProgress) Render(w io.Writer) error {
 		}
 	}
 
+	if bp.Progress != nil && len(bp.Progress.Hooks) > 0 {
+		if err := bp.addHookProgress(w); err != nil {
+			return err
+		}
+	}
+
 	if bp.Detailed && bp.Progress != nil && bp.Progress.Size > 0 {
 		if err := bp.addKeyspaceProgress(w); err != nil {
 			return err
@@ -614,6 +995,36 @@ func (bp BackupProgress) Render(w io.Writer) error {
 	return nil
 }
 
+func (bp BackupProgress) addHookProgress(w io.Writer) error {
+	fmt.Fprintln(w, "\nHooks:")
+
+	t := table.New("Host", "Stage", "Name", "Exit code", "Duration", "Error")
+	for _, h := range bp.Progress.Hooks {
+		if bp.hideHost(h.Host) {
+			continue
+		}
+		duration := "-"
+		if h.StartedAt != nil && h.CompletedAt != nil {
+			duration = FormatDuration(*h.StartedAt, *h.CompletedAt)
+		}
+		t.AddRow(h.Host, h.Stage, h.Name, h.ExitCode, duration, h.Error)
+	}
+	if _, err := io.WriteString(w, t.String()); err != nil {
+		return err
+	}
+
+	if !bp.Detailed {
+		return nil
+	}
+	for _, h := range bp.Progress.Hooks {
+		if bp.hideHost(h.Host) || h.Output == "" {
+			continue
+		}
+		fmt.Fprintf(w, "\nHost: %s %s hook %s output:\n%s\n", h.Host, h.Stage, h.Name, strings.TrimRight(h.Output, "\n"))
+	}
+	return nil
+}
+
 func (bp BackupProgress) addHostProgress(t *table.Table) {
 	t.AddRow("Host", "Progress", "Size", "Success", "Deduplicated", "Failed")
 	t.AddSeparator()
@@ -730,6 +1141,16 @@ Datacenters:	{{ range .Dcs }}
   - {{ . }}
 {{- end }}
 {{ end -}}
+{{ if .RateLimit -}}
+Rate limits:	{{ range .RateLimit }}
+  - {{ . }} MiB/s
+{{- end }}
+{{ end -}}
+{{ if .RateLimitSchedule -}}
+Rate limit schedule (UTC):	{{ range .RateLimitSchedule }}
+  - {{ . }} MiB/s
+{{- end }}
+{{ end -}}
 {{ else }}Progress:	0%
 {{ end }}
 {{- if .Errors -}}
@@ -825,12 +1246,21 @@ Orphaned files:	{{ .OrphanedFiles }} {{ if gt .OrphanedFiles 0 }}({{ StringByteC
 {{- if gt .DeletedFiles 0 }}
 Deleted files:	{{ .DeletedFiles }}
 {{- end }}
+{{- if gt .UnlockedFiles 0 }}
+Unlocked files:	{{ .UnlockedFiles }}
+{{- end }}
 {{- if .BrokenSnapshots }}
 
 Broken snapshots:	{{ range .BrokenSnapshots }}
   - {{ . }}
 {{- end }}
 {{- end }}
+{{- if .UnlockedSnapshots }}
+
+Unlocked snapshots:	{{ range .UnlockedSnapshots }}
+  - {{ . }}
+{{- end }}
+{{- end }}
 {{- end }}
 `
 
@@ -856,7 +1286,10 @@ func (p ValidateBackupProgress) arguments() string {
 func (p ValidateBackupProgress) aggregatedProgress() models.ValidateBackupProgress {
 	var a models.ValidateBackupProgress
 
-	bs := strset.New()
+	var (
+		bs = strset.New()
+		us = strset.New()
+	)
 	for _, i := range p.Progress {
 		a.Manifests += i.Manifests
 		a.ScannedFiles += i.ScannedFiles
@@ -865,9 +1298,13 @@ func (p ValidateBackupProgress) aggregatedProgress() models.ValidateBackupProgre
 		a.OrphanedFiles += i.OrphanedFiles
 		a.OrphanedBytes += i.OrphanedBytes
 		a.DeletedFiles += i.DeletedFiles
+		a.UnlockedFiles += i.UnlockedFiles
+		us.Add(i.UnlockedSnapshots...)
 	}
 	a.BrokenSnapshots = bs.List()
 	sort.Strings(a.BrokenSnapshots)
+	a.UnlockedSnapshots = us.List()
+	sort.Strings(a.UnlockedSnapshots)
 
 	return a
 }
@@ -914,24 +1351,733 @@ func (p ValidateBackupProgress) addHostProgress(w io.Writer) error {
 	return nil
 }
 
-// BackupListItems is a []backup.ListItem representation.
-type BackupListItems struct {
-	items       []*models.BackupListItem
-	AllClusters bool
-	ShowTables  int
-}
-
-const backupListItemTemplate = `backup/{{ .TaskID }}
-Snapshots:
-{{- range .SnapshotInfo }}
-  - {{ .SnapshotTag }} ({{ if eq .Size 0 }}n/a{{ else }}{{ StringByteCount .Size }}{{ end }}, {{ .Nodes }} nodes)
-{{- end }}
-Keyspaces:
-{{- range .Units }}
-  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
-{{- end }}
+// CleanupProgress prints cleanup task progress.
+type CleanupProgress struct {
+	*models.TaskRunCleanupProgress
+	Task     *Task
+	Detailed bool
 
-`
+	hostFilter     inexlist.InExList
+	keyspaceFilter inexlist.InExList
+}
+
+// SetHostFilter adds filtering rules used for rendering for host details.
+func (p *CleanupProgress) SetHostFilter(filters []string) (err error) {
+	p.hostFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+// SetKeyspaceFilter adds filtering rules used for rendering for keyspace details.
+func (p *CleanupProgress) SetKeyspaceFilter(filters []string) (err error) {
+	p.keyspaceFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+func (p CleanupProgress) hideHost(host string) bool {
+	if p.hostFilter.Size() > 0 {
+		return p.hostFilter.FirstMatch(host) == -1
+	}
+	return false
+}
+
+func (p CleanupProgress) hideKeyspace(keyspace string) bool {
+	if p.keyspaceFilter.Size() > 0 {
+		return p.keyspaceFilter.FirstMatch(keyspace) == -1
+	}
+	return false
+}
+
+// Render implements Renderer interface.
+func (p CleanupProgress) Render(w io.Writer) error {
+	if err := p.addHeader(w); err != nil {
+		return err
+	}
+
+	if p.Progress == nil || p.Progress.Total == 0 {
+		return nil
+	}
+
+	t := table.New()
+	p.addHostProgress(t)
+	if _, err := io.WriteString(w, t.String()); err != nil {
+		return err
+	}
+
+	if p.Detailed {
+		return p.addKeyspaceProgress(w)
+	}
+	return nil
+}
+
+var cleanupProgressTemplate = `{{ if arguments }}Arguments:	{{ arguments }}
+{{ end -}}
+{{ with .Run }}Status:		{{ .Status }}
+{{- if .Cause }}
+Cause:		{{ FormatError .Cause }}
+
+{{- end }}
+{{- if not (isZero .StartTime) }}
+Start time:	{{ FormatTime .StartTime }}
+{{- end -}}
+{{- if not (isZero .EndTime) }}
+End time:	{{ FormatTime .EndTime }}
+{{- end }}
+Duration:	{{ FormatDuration .StartTime .EndTime }}
+{{ end -}}
+{{ with .Progress }}Progress:	{{ FormatRepairProgress .Total .Success .Failed }}
+{{ if .Dcs -}}
+Datacenters:	{{ range .Dcs }}
+  - {{ . }}
+{{- end }}
+{{ end -}}
+{{ else }}Progress:	0%
+{{ end }}`
+
+func (p CleanupProgress) addHeader(w io.Writer) error {
+	temp := template.Must(template.New("cleanup_progress").Funcs(template.FuncMap{
+		"isZero":               isZero,
+		"FormatTime":           FormatTime,
+		"FormatDuration":       FormatDuration,
+		"FormatError":          FormatError,
+		"FormatRepairProgress": FormatRepairProgress,
+		"arguments":            p.arguments,
+	}).Parse(cleanupProgressTemplate))
+	return temp.Execute(w, p)
+}
+
+// arguments returns task arguments that task was created with.
+func (p CleanupProgress) arguments() string {
+	return NewCmdRenderer(p.Task, RenderTypeArgs).String()
+}
+
+func (p CleanupProgress) addHostProgress(t *table.Table) {
+	t.AddRow("Host", "DC", "Progress", "Keyspaces", "Success", "Failed")
+	t.AddSeparator()
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		t.AddRow(h.Host, h.Dc,
+			FormatRepairProgress(h.Total, h.Success, h.Failed),
+			h.Total,
+			h.Success,
+			h.Failed,
+		)
+	}
+	t.SetColumnAlignment(termtables.AlignRight, 2, 3, 4, 5)
+}
+
+func (p CleanupProgress) addKeyspaceProgress(w io.Writer) error {
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		fmt.Fprintf(w, "\nHost: %s\n", h.Host)
+
+		t := table.New("Keyspace", "Started at", "Completed at", "Duration", "Error")
+		for _, ks := range h.Keyspaces {
+			if p.hideKeyspace(ks.Keyspace) {
+				continue
+			}
+			var startedAt, completedAt strfmt.DateTime
+			if ks.StartedAt != nil {
+				startedAt = *ks.StartedAt
+			}
+			if ks.CompletedAt != nil {
+				completedAt = *ks.CompletedAt
+			}
+			duration := "-"
+			if ks.StartedAt != nil {
+				duration = FormatDuration(startedAt, completedAt)
+			}
+			t.AddRow(
+				ks.Keyspace,
+				FormatTime(startedAt),
+				FormatTime(completedAt),
+				duration,
+				ks.Error,
+			)
+		}
+		if _, err := w.Write([]byte(t.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompactionProgress prints compaction task progress.
+type CompactionProgress struct {
+	*models.TaskRunCompactionProgress
+	Task     *Task
+	Detailed bool
+
+	hostFilter     inexlist.InExList
+	keyspaceFilter inexlist.InExList
+}
+
+// SetHostFilter adds filtering rules used for rendering for host details.
+func (p *CompactionProgress) SetHostFilter(filters []string) (err error) {
+	p.hostFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+// SetKeyspaceFilter adds filtering rules used for rendering for keyspace details.
+func (p *CompactionProgress) SetKeyspaceFilter(filters []string) (err error) {
+	p.keyspaceFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+func (p CompactionProgress) hideHost(host string) bool {
+	if p.hostFilter.Size() > 0 {
+		return p.hostFilter.FirstMatch(host) == -1
+	}
+	return false
+}
+
+func (p CompactionProgress) hideTable(keyspace, table string) bool {
+	if p.keyspaceFilter.Size() > 0 {
+		return p.keyspaceFilter.FirstMatch(keyspace+"."+table) == -1
+	}
+	return false
+}
+
+// Render implements Renderer interface.
+func (p CompactionProgress) Render(w io.Writer) error {
+	if err := p.addHeader(w); err != nil {
+		return err
+	}
+
+	if p.Progress == nil || p.Progress.Total == 0 {
+		return nil
+	}
+
+	t := table.New()
+	p.addHostProgress(t)
+	if _, err := io.WriteString(w, t.String()); err != nil {
+		return err
+	}
+
+	if p.Detailed {
+		return p.addTableProgress(w)
+	}
+	return nil
+}
+
+var compactionProgressTemplate = `{{ if arguments }}Arguments:	{{ arguments }}
+{{ end -}}
+{{ with .Run }}Status:		{{ .Status }}
+{{- if .Cause }}
+Cause:		{{ FormatError .Cause }}
+
+{{- end }}
+{{- if not (isZero .StartTime) }}
+Start time:	{{ FormatTime .StartTime }}
+{{- end -}}
+{{- if not (isZero .EndTime) }}
+End time:	{{ FormatTime .EndTime }}
+{{- end }}
+Duration:	{{ FormatDuration .StartTime .EndTime }}
+{{ end -}}
+{{ with .Progress }}Progress:	{{ FormatRepairProgress .Total .Success .Failed }}
+Reclaimed:	{{ StringByteCount .Reclaimed }}
+{{ if .Dcs -}}
+Datacenters:	{{ range .Dcs }}
+  - {{ . }}
+{{- end }}
+{{ end -}}
+{{ else }}Progress:	0%
+{{ end }}`
+
+func (p CompactionProgress) addHeader(w io.Writer) error {
+	temp := template.Must(template.New("compaction_progress").Funcs(template.FuncMap{
+		"isZero":               isZero,
+		"FormatTime":           FormatTime,
+		"FormatDuration":       FormatDuration,
+		"FormatError":          FormatError,
+		"FormatRepairProgress": FormatRepairProgress,
+		"StringByteCount":      StringByteCount,
+		"arguments":            p.arguments,
+	}).Parse(compactionProgressTemplate))
+	return temp.Execute(w, p)
+}
+
+// arguments returns task arguments that task was created with.
+func (p CompactionProgress) arguments() string {
+	return NewCmdRenderer(p.Task, RenderTypeArgs).String()
+}
+
+func (p CompactionProgress) addHostProgress(t *table.Table) {
+	t.AddRow("Host", "DC", "Progress", "Tables", "Success", "Failed", "Reclaimed", "Duration")
+	t.AddSeparator()
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		duration := "-"
+		if h.StartedAt != nil {
+			var completedAt strfmt.DateTime
+			if h.CompletedAt != nil {
+				completedAt = *h.CompletedAt
+			}
+			duration = FormatDuration(*h.StartedAt, completedAt)
+		}
+		t.AddRow(h.Host, h.Dc,
+			FormatRepairProgress(h.Total, h.Success, h.Failed),
+			h.Total,
+			h.Success,
+			h.Failed,
+			StringByteCount(h.Reclaimed),
+			duration,
+		)
+	}
+	t.SetColumnAlignment(termtables.AlignRight, 2, 3, 4, 5, 6, 7)
+}
+
+func (p CompactionProgress) addTableProgress(w io.Writer) error {
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		fmt.Fprintf(w, "\nHost: %s\n", h.Host)
+
+		t := table.New("Table", "Started at", "Completed at", "Duration", "Size before", "Size after", "Error")
+		for _, tp := range h.Tables {
+			if p.hideTable(tp.Keyspace, tp.Table) {
+				continue
+			}
+			var startedAt, completedAt strfmt.DateTime
+			if tp.StartedAt != nil {
+				startedAt = *tp.StartedAt
+			}
+			if tp.CompletedAt != nil {
+				completedAt = *tp.CompletedAt
+			}
+			duration := "-"
+			if tp.StartedAt != nil {
+				duration = FormatDuration(startedAt, completedAt)
+			}
+			sizeAfter := "-"
+			if tp.CompletedAt != nil && tp.Error == "" {
+				sizeAfter = StringByteCount(tp.SizeAfter)
+			}
+			t.AddRow(
+				tp.Keyspace+"."+tp.Table,
+				FormatTime(startedAt),
+				FormatTime(completedAt),
+				duration,
+				StringByteCount(tp.SizeBefore),
+				sizeAfter,
+				tp.Error,
+			)
+		}
+		t.SetColumnAlignment(termtables.AlignRight, 4, 5)
+		if _, err := w.Write([]byte(t.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RollingRestartProgress prints rolling restart task progress.
+type RollingRestartProgress struct {
+	*models.TaskRunRollingRestartProgress
+	Task *Task
+
+	hostFilter inexlist.InExList
+}
+
+// SetHostFilter adds filtering rules used for rendering for host details.
+func (p *RollingRestartProgress) SetHostFilter(filters []string) (err error) {
+	p.hostFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+func (p RollingRestartProgress) hideHost(host string) bool {
+	if p.hostFilter.Size() > 0 {
+		return p.hostFilter.FirstMatch(host) == -1
+	}
+	return false
+}
+
+// Render implements Renderer interface.
+func (p RollingRestartProgress) Render(w io.Writer) error {
+	if err := p.addHeader(w); err != nil {
+		return err
+	}
+
+	if p.Progress == nil || p.Progress.Total == 0 {
+		return nil
+	}
+
+	t := table.New()
+	p.addHostProgress(t)
+	_, err := io.WriteString(w, t.String())
+	return err
+}
+
+var rollingRestartProgressTemplate = `{{ if arguments }}Arguments:	{{ arguments }}
+{{ end -}}
+{{ with .Run }}Status:		{{ .Status }}
+{{- if .Cause }}
+Cause:		{{ FormatError .Cause }}
+
+{{- end }}
+{{- if not (isZero .StartTime) }}
+Start time:	{{ FormatTime .StartTime }}
+{{- end -}}
+{{- if not (isZero .EndTime) }}
+End time:	{{ FormatTime .EndTime }}
+{{- end }}
+Duration:	{{ FormatDuration .StartTime .EndTime }}
+{{ end -}}
+{{ with .Progress }}Progress:	{{ FormatRepairProgress .Total .Success .Failed }}
+{{ if .Dcs -}}
+Datacenters:	{{ range .Dcs }}
+  - {{ . }}
+{{- end }}
+{{ end -}}
+{{ else }}Progress:	0%
+{{ end }}`
+
+func (p RollingRestartProgress) addHeader(w io.Writer) error {
+	temp := template.Must(template.New("rolling_restart_progress").Funcs(template.FuncMap{
+		"isZero":               isZero,
+		"FormatTime":           FormatTime,
+		"FormatDuration":       FormatDuration,
+		"FormatError":          FormatError,
+		"FormatRepairProgress": FormatRepairProgress,
+		"arguments":            p.arguments,
+	}).Parse(rollingRestartProgressTemplate))
+	return temp.Execute(w, p)
+}
+
+// arguments returns task arguments that task was created with.
+func (p RollingRestartProgress) arguments() string {
+	return NewCmdRenderer(p.Task, RenderTypeArgs).String()
+}
+
+func (p RollingRestartProgress) addHostProgress(t *table.Table) {
+	t.AddRow("Host", "DC", "Started at", "Completed at", "Duration", "Error")
+	t.AddSeparator()
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		var startedAt, completedAt strfmt.DateTime
+		if h.StartedAt != nil {
+			startedAt = *h.StartedAt
+		}
+		if h.CompletedAt != nil {
+			completedAt = *h.CompletedAt
+		}
+		duration := "-"
+		if h.StartedAt != nil {
+			duration = FormatDuration(startedAt, completedAt)
+		}
+		t.AddRow(
+			h.Host,
+			h.Dc,
+			FormatTime(startedAt),
+			FormatTime(completedAt),
+			duration,
+			h.Error,
+		)
+	}
+}
+
+// SSTableMaintenanceProgress prints SSTable maintenance task progress.
+type SSTableMaintenanceProgress struct {
+	*models.TaskRunSSTableMaintenanceProgress
+	Task     *Task
+	Detailed bool
+
+	hostFilter     inexlist.InExList
+	keyspaceFilter inexlist.InExList
+}
+
+// SetHostFilter adds filtering rules used for rendering for host details.
+func (p *SSTableMaintenanceProgress) SetHostFilter(filters []string) (err error) {
+	p.hostFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+// SetKeyspaceFilter adds filtering rules used for rendering for keyspace details.
+func (p *SSTableMaintenanceProgress) SetKeyspaceFilter(filters []string) (err error) {
+	p.keyspaceFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+func (p SSTableMaintenanceProgress) hideHost(host string) bool {
+	if p.hostFilter.Size() > 0 {
+		return p.hostFilter.FirstMatch(host) == -1
+	}
+	return false
+}
+
+func (p SSTableMaintenanceProgress) hideKeyspace(keyspace string) bool {
+	if p.keyspaceFilter.Size() > 0 {
+		return p.keyspaceFilter.FirstMatch(keyspace) == -1
+	}
+	return false
+}
+
+// Render implements Renderer interface.
+func (p SSTableMaintenanceProgress) Render(w io.Writer) error {
+	if err := p.addHeader(w); err != nil {
+		return err
+	}
+
+	if p.Progress == nil || p.Progress.Total == 0 {
+		return nil
+	}
+
+	t := table.New()
+	p.addHostProgress(t)
+	if _, err := io.WriteString(w, t.String()); err != nil {
+		return err
+	}
+
+	if p.Detailed {
+		return p.addKeyspaceProgress(w)
+	}
+	return nil
+}
+
+var sstableMaintenanceProgressTemplate = `{{ if arguments }}Arguments:	{{ arguments }}
+{{ end -}}
+{{ with .Run }}Status:		{{ .Status }}
+{{- if .Cause }}
+Cause:		{{ FormatError .Cause }}
+
+{{- end }}
+{{- if not (isZero .StartTime) }}
+Start time:	{{ FormatTime .StartTime }}
+{{- end -}}
+{{- if not (isZero .EndTime) }}
+End time:	{{ FormatTime .EndTime }}
+{{- end }}
+Duration:	{{ FormatDuration .StartTime .EndTime }}
+{{ end -}}
+{{ with .Progress }}{{ if .Mode }}Mode:		{{ .Mode }}{{ if .ScrubMode }} ({{ .ScrubMode }}){{ end }}
+{{ end -}}
+Progress:	{{ FormatRepairProgress .Total .Success .Failed }}
+{{ if .Dcs -}}
+Datacenters:	{{ range .Dcs }}
+  - {{ . }}
+{{- end }}
+{{ end -}}
+{{ else }}Progress:	0%
+{{ end }}`
+
+func (p SSTableMaintenanceProgress) addHeader(w io.Writer) error {
+	temp := template.Must(template.New("sstable_maintenance_progress").Funcs(template.FuncMap{
+		"isZero":               isZero,
+		"FormatTime":           FormatTime,
+		"FormatDuration":       FormatDuration,
+		"FormatError":          FormatError,
+		"FormatRepairProgress": FormatRepairProgress,
+		"arguments":            p.arguments,
+	}).Parse(sstableMaintenanceProgressTemplate))
+	return temp.Execute(w, p)
+}
+
+// arguments returns task arguments that task was created with.
+func (p SSTableMaintenanceProgress) arguments() string {
+	return NewCmdRenderer(p.Task, RenderTypeArgs).String()
+}
+
+func (p SSTableMaintenanceProgress) addHostProgress(t *table.Table) {
+	t.AddRow("Host", "DC", "Progress", "Keyspaces", "Success", "Failed")
+	t.AddSeparator()
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		t.AddRow(h.Host, h.Dc,
+			FormatRepairProgress(h.Total, h.Success, h.Failed),
+			h.Total,
+			h.Success,
+			h.Failed,
+		)
+	}
+	t.SetColumnAlignment(termtables.AlignRight, 2, 3, 4, 5)
+}
+
+func (p SSTableMaintenanceProgress) addKeyspaceProgress(w io.Writer) error {
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		fmt.Fprintf(w, "\nHost: %s\n", h.Host)
+
+		t := table.New("Keyspace", "Snapshot", "Started at", "Completed at", "Duration", "Error")
+		for _, ks := range h.Keyspaces {
+			if p.hideKeyspace(ks.Keyspace) {
+				continue
+			}
+			var startedAt, completedAt strfmt.DateTime
+			if ks.StartedAt != nil {
+				startedAt = *ks.StartedAt
+			}
+			if ks.CompletedAt != nil {
+				completedAt = *ks.CompletedAt
+			}
+			duration := "-"
+			if ks.StartedAt != nil {
+				duration = FormatDuration(startedAt, completedAt)
+			}
+			snapshotTag := "-"
+			if ks.SnapshotTag != "" {
+				snapshotTag = ks.SnapshotTag
+			}
+			t.AddRow(
+				ks.Keyspace,
+				snapshotTag,
+				FormatTime(startedAt),
+				FormatTime(completedAt),
+				duration,
+				ks.Error,
+			)
+		}
+		if _, err := w.Write([]byte(t.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SnapshotProgress prints snapshot task progress.
+type SnapshotProgress struct {
+	*models.TaskRunSnapshotProgress
+	Task *Task
+
+	hostFilter inexlist.InExList
+}
+
+// SetHostFilter adds filtering rules used for rendering for host details.
+func (p *SnapshotProgress) SetHostFilter(filters []string) (err error) {
+	p.hostFilter, err = inexlist.ParseInExList(filters)
+	return
+}
+
+func (p SnapshotProgress) hideHost(host string) bool {
+	if p.hostFilter.Size() > 0 {
+		return p.hostFilter.FirstMatch(host) == -1
+	}
+	return false
+}
+
+// Render implements Renderer interface.
+func (p SnapshotProgress) Render(w io.Writer) error {
+	if err := p.addHeader(w); err != nil {
+		return err
+	}
+
+	if p.Progress == nil || p.Progress.Total == 0 {
+		return nil
+	}
+
+	t := table.New()
+	p.addHostProgress(t)
+	t.SetColumnAlignment(termtables.AlignRight, 5)
+	_, err := io.WriteString(w, t.String())
+	return err
+}
+
+var snapshotProgressTemplate = `{{ if arguments }}Arguments:	{{ arguments }}
+{{ end -}}
+{{ with .Run }}Status:		{{ .Status }}
+{{- if .Cause }}
+Cause:		{{ FormatError .Cause }}
+
+{{- end }}
+{{- if not (isZero .StartTime) }}
+Start time:	{{ FormatTime .StartTime }}
+{{- end -}}
+{{- if not (isZero .EndTime) }}
+End time:	{{ FormatTime .EndTime }}
+{{- end }}
+Duration:	{{ FormatDuration .StartTime .EndTime }}
+{{ end -}}
+{{ with .Progress }}Progress:	{{ FormatRepairProgress .Total .Success .Failed }}
+{{ if .SnapshotTag -}}
+Snapshot Tag:	{{ .SnapshotTag }}
+{{ end -}}
+Size:		{{ StringByteCount .Size }}
+{{ if .Dcs -}}
+Datacenters:	{{ range .Dcs }}
+  - {{ . }}
+{{- end }}
+{{ end -}}
+{{ else }}Progress:	0%
+{{ end }}`
+
+func (p SnapshotProgress) addHeader(w io.Writer) error {
+	temp := template.Must(template.New("snapshot_progress").Funcs(template.FuncMap{
+		"isZero":               isZero,
+		"FormatTime":           FormatTime,
+		"FormatDuration":       FormatDuration,
+		"FormatError":          FormatError,
+		"FormatRepairProgress": FormatRepairProgress,
+		"StringByteCount":      StringByteCount,
+		"arguments":            p.arguments,
+	}).Parse(snapshotProgressTemplate))
+	return temp.Execute(w, p)
+}
+
+// arguments returns task arguments that task was created with.
+func (p SnapshotProgress) arguments() string {
+	return NewCmdRenderer(p.Task, RenderTypeArgs).String()
+}
+
+func (p SnapshotProgress) addHostProgress(t *table.Table) {
+	t.AddRow("Host", "DC", "Started at", "Completed at", "Duration", "Size", "Error")
+	t.AddSeparator()
+	for _, h := range p.Progress.Hosts {
+		if p.hideHost(h.Host) {
+			continue
+		}
+		var startedAt, completedAt strfmt.DateTime
+		if h.StartedAt != nil {
+			startedAt = *h.StartedAt
+		}
+		if h.CompletedAt != nil {
+			completedAt = *h.CompletedAt
+		}
+		duration := "-"
+		if h.StartedAt != nil {
+			duration = FormatDuration(startedAt, completedAt)
+		}
+		t.AddRow(
+			h.Host,
+			h.Dc,
+			FormatTime(startedAt),
+			FormatTime(completedAt),
+			duration,
+			StringByteCount(h.Size),
+			h.Error,
+		)
+	}
+}
+
+// BackupListItems is a []backup.ListItem representation.
+type BackupListItems struct {
+	items       []*models.BackupListItem
+	AllClusters bool
+	ShowTables  int
+}
+
+const backupListItemTemplate = `backup/{{ .TaskID }}
+Snapshots:
+{{- range .SnapshotInfo }}
+  - {{ .SnapshotTag }} ({{ if eq .Size 0 }}n/a{{ else }}{{ StringByteCount .Size }}{{ end }}, {{ .Nodes }} nodes{{ if .Partial }}, partial{{ end }})
+{{- end }}
+Keyspaces:
+{{- range .Units }}
+  - {{ .Keyspace }} {{ FormatTables .Tables .AllTables }}
+{{- end }}
+
+`
 
 // Render implements Renderer interface.
 func (bl BackupListItems) Render(w io.Writer) error {
@@ -957,3 +2103,106 @@ func (bl BackupListItems) Render(w io.Writer) error {
 	}
 	return nil
 }
+
+// BackupDiff is a comparison of two backup snapshots.
+type BackupDiff struct {
+	models.BackupDiff
+	ShowNodes bool
+}
+
+const backupDiffTemplate = `From: {{ .From }}
+To: {{ .To }}
+{{- if .Estimated }}
+Sizes marked with ~ are estimates, manifests created by older versions do not record sizes of files
+{{- end }}
+
+SSTables: {{ .AddedSstables }} added, {{ .RemovedSstables }} removed, {{ .SharedSstables }} shared
+Incremental upload: {{ Size .AddedBytes }} ({{ Percent .Churn }} of {{ .To }})
+Removed: {{ Size .RemovedBytes }}
+Shared: {{ Size .SharedBytes }}
+{{- if HighChurn }}
+
+High churn tables:
+{{- range HighChurn }}
+  - {{ .Keyspace }}.{{ .Table }} ({{ Percent .Churn }}, {{ Size .AddedBytes }})
+{{- end }}
+{{- end }}
+
+`
+
+// Render implements Renderer interface.
+func (d BackupDiff) Render(w io.Writer) error {
+	size := StringByteCount
+	if d.Estimated {
+		size = func(b int64) string {
+			return "~" + StringByteCount(b)
+		}
+	}
+	percent := func(v float64) string {
+		return fmt.Sprintf("%.1f%%", v*100)
+	}
+
+	temp := template.Must(template.New("backup_diff").Funcs(template.FuncMap{
+		"Size":    size,
+		"Percent": percent,
+		"HighChurn": func() []*models.BackupTableDiff {
+			var out []*models.BackupTableDiff
+			for _, t := range d.Tables {
+				if t.HighChurn {
+					out = append(out, t)
+				}
+			}
+			return out
+		},
+	}).Parse(backupDiffTemplate))
+	if err := temp.Execute(w, d); err != nil {
+		return err
+	}
+
+	tables := func(w io.Writer, v []*models.BackupTableDiff) {
+		t := table.New("Keyspace", "Table", "Added", "Removed", "Shared", "Upload", "Churn")
+		for _, td := range v {
+			t.AddRow(td.Keyspace, td.Table, td.AddedSstables, td.RemovedSstables, td.SharedSstables, size(td.AddedBytes), percent(td.Churn))
+		}
+		t.SetColumnAlignment(termtables.AlignRight, 2, 3, 4, 5, 6)
+		fmt.Fprint(w, t)
+	}
+
+	tables(w, d.Tables)
+	if d.ShowNodes {
+		for _, n := range d.Nodes {
+			fmt.Fprintf(w, "\nNode: %s (%s, %s)\n", n.IP, n.DC, n.NodeID)
+			tables(w, n.Tables)
+		}
+	}
+	return nil
+}
+
+// NodeSnapshotsSlice is a []snapshot.NodeSnapshots representation.
+type NodeSnapshotsSlice []*models.NodeSnapshots
+
+// Render renders NodeSnapshotsSlice in a tabular format.
+func (ns NodeSnapshotsSlice) Render(w io.Writer) error {
+	t := table.New("Host", "DC", "Snapshot Tag", "Created at", "Keyspaces", "Size", "True size")
+	for i, n := range ns {
+		if i > 0 {
+			t.AddSeparator()
+		}
+		if n.Error != "" {
+			t.AddRow(n.Host, n.Dc, "-", "-", "-", "-", FormatError(n.Error))
+			continue
+		}
+		for _, s := range n.Snapshots {
+			var createdAt strfmt.DateTime
+			if s.CreatedAt != nil {
+				createdAt = *s.CreatedAt
+			}
+			t.AddRow(n.Host, n.Dc, s.SnapshotTag, FormatTime(createdAt), strings.Join(s.Keyspaces, ", "),
+				StringByteCount(s.Size), StringByteCount(s.TrueSize))
+		}
+		t.AddRow(n.Host, n.Dc, "Total", "", "", "", StringByteCount(n.TrueSize))
+	}
+	t.SetColumnAlignment(termtables.AlignRight, 5, 6)
+	_, err := io.WriteString(w, t.String())
+	return err
+}
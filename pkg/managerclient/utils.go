@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -276,6 +277,16 @@ func FormatClusterName(w io.Writer, c *Cluster) {
 	fmt.Fprintf(w, "Cluster: %s (%s)\n", c.Name, c.ID)
 }
 
+// FormatLabels returns comma separated list of sorted key=value pairs.
+func FormatLabels(labels map[string]string) string {
+	out := make([]string, 0, len(labels))
+	for k, v := range labels {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return strings.Join(out, ",")
+}
+
 // FormatIntensity returns text representation of repair intensity.
 func FormatIntensity(v float64) string {
 	if v == -1 {
@@ -7,6 +7,7 @@ package localdir
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -22,10 +23,20 @@ const (
 
 // Init registers new data provider with rclone.
 func Init(name, description, rootDir string) {
+	register(name, description, NewFs(rootDir))
+}
+
+// InitStrict registers new data provider with rclone, unlike Init paths that
+// lead outside of rootDir through symbolic links are rejected.
+func InitStrict(name, description, rootDir string) {
+	register(name, description, NewStrictFs(rootDir))
+}
+
+func register(name, description string, newFs func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error)) {
 	fsi := &fs.RegInfo{
 		Name:        name,
 		Description: description,
-		NewFs:       NewFs(rootDir),
+		NewFs:       newFs,
 		Options: []fs.Option{{
 			Name: "nounc",
 			Help: "Disable UNC (long path names) conversion on Windows",
@@ -108,23 +119,89 @@ to override the default choice.`,
 	fs.Register(fsi)
 }
 
+// NewFs returns rclone NewFs function that creates local fs rooted at rootDir.
 func NewFs(rootDir string) func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
 	return func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
-		// filepath.Clean will turn everything that goes up and beyond root into
-		// a single /.
-		// We are prepending slash to turn input into an absolute path.
-		p := filepath.Clean("/" + root)
-		if len(root) > 1 && p == "/" {
-			// If root has more than one byte and after cleanPath we end up with
-			// empty path then we received invalid input.
-			return nil, errors.Wrap(fs.ErrorObjectNotFound, "accessing path outside of root")
-		}
-		var path string
-		if strings.HasPrefix(p, rootDir) {
-			path = p
-		} else {
-			path = filepath.Join(rootDir, p)
+		path, err := jail(rootDir, root)
+		if err != nil {
+			return nil, err
 		}
 		return local.NewFs(ctx, name, path, m)
 	}
 }
+
+// NewStrictFs is like NewFs but it also checks that the path does not
+// lead outside of rootDir through symbolic links. The returned fs checks
+// every remote path it's asked to access in the same way.
+func NewStrictFs(rootDir string) func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+	return func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+		path, err := jail(rootDir, root)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSymlinks(rootDir, path); err != nil {
+			return nil, err
+		}
+		f, err := local.NewFs(ctx, name, path, m)
+		switch {
+		case err == fs.ErrorIsFile:
+			// Local fs is rooted at the parent directory of the file
+			return newStrictFs(ctx, f, rootDir, filepath.Dir(path)), err
+		case err != nil:
+			return nil, err
+		default:
+			return newStrictFs(ctx, f, rootDir, path), nil
+		}
+	}
+}
+
+var errOutsideRoot = errors.Wrap(fs.ErrorObjectNotFound, "accessing path outside of root")
+
+func jail(rootDir, root string) (string, error) {
+	// filepath.Clean will turn everything that goes up and beyond root into
+	// a single /.
+	// We are prepending slash to turn input into an absolute path.
+	p := filepath.Clean("/" + root)
+	if len(root) > 1 && p == "/" {
+		// If root has more than one byte and after cleanPath we end up with
+		// empty path then we received invalid input.
+		return "", errOutsideRoot
+	}
+	if hasPathPrefix(p, rootDir) {
+		return p, nil
+	}
+	return filepath.Join(rootDir, p), nil
+}
+
+// checkSymlinks resolves symbolic links in the longest existing part of path
+// and checks that it's still within rootDir.
+func checkSymlinks(rootDir, path string) error {
+	r, err := filepath.EvalSymlinks(rootDir)
+	if err != nil {
+		return errors.Wrap(err, "resolve root")
+	}
+
+	p := path
+	for {
+		v, err := filepath.EvalSymlinks(p)
+		if err == nil {
+			if !hasPathPrefix(v, r) {
+				return errOutsideRoot
+			}
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if p == rootDir {
+			return errors.Wrap(err, "resolve root")
+		}
+		p = filepath.Dir(p)
+	}
+}
+
+// hasPathPrefix returns true if p is prefix or is located under prefix.
+func hasPathPrefix(p, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
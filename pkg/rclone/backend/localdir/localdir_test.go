@@ -4,6 +4,7 @@ package localdir
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -58,3 +59,79 @@ func TestNewFsWithGlobalCache(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestJail(t *testing.T) {
+	table := []struct {
+		Name     string
+		Root     string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "Relative",
+			Root:     "bucket/dir",
+			Expected: "/mnt/backup/bucket/dir",
+		},
+		{
+			Name:     "Rooted",
+			Root:     "/mnt/backup/bucket",
+			Expected: "/mnt/backup/bucket",
+		},
+		{
+			Name:     "Sibling with common prefix",
+			Root:     "/mnt/backup2/bucket",
+			Expected: "/mnt/backup/mnt/backup2/bucket",
+		},
+		{
+			Name:  "Outside of root",
+			Root:  "../..",
+			Error: true,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			p, err := jail("/mnt/backup", test.Root)
+			if test.Error {
+				if err == nil {
+					t.Fatalf("jail() = %s, expected error", p)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("jail() error", err)
+			}
+			if p != test.Expected {
+				t.Fatalf("jail() = %s, expected %s", p, test.Expected)
+			}
+		})
+	}
+}
+
+func TestCheckSymlinks(t *testing.T) {
+	var (
+		rootDir = t.TempDir()
+		outside = t.TempDir()
+	)
+	if err := os.Mkdir(filepath.Join(rootDir, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(rootDir, "dir"), filepath.Join(rootDir, "inside")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(rootDir, "outside")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []string{"dir", "dir/not/existing", "inside/not/existing"} {
+		if err := checkSymlinks(rootDir, filepath.Join(rootDir, p)); err != nil {
+			t.Errorf("checkSymlinks(%s) error %s", p, err)
+		}
+	}
+	for _, p := range []string{"outside", "outside/not/existing"} {
+		if err := checkSymlinks(rootDir, filepath.Join(rootDir, p)); err == nil {
+			t.Errorf("checkSymlinks(%s) expected error", p)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2017 ScyllaDB
+
+package localdir
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+
+	"github.com/rclone/rclone/fs"
+)
+
+// strictFs wraps local fs and checks that every remote it's asked to access
+// is located under rootDir, after cleaning the path and resolving symbolic
+// links.
+type strictFs struct {
+	fs.Fs
+	rootDir  string
+	root     string
+	features *fs.Features
+}
+
+func newStrictFs(ctx context.Context, f fs.Fs, rootDir, root string) *strictFs {
+	sf := &strictFs{
+		Fs:      f,
+		rootDir: filepath.Clean(rootDir),
+		root:    root,
+	}
+	ft := f.Features()
+	sf.features = (&fs.Features{
+		CaseInsensitive:         ft.CaseInsensitive,
+		DuplicateFiles:          ft.DuplicateFiles,
+		ReadMimeType:            ft.ReadMimeType,
+		WriteMimeType:           ft.WriteMimeType,
+		CanHaveEmptyDirectories: ft.CanHaveEmptyDirectories,
+		BucketBased:             ft.BucketBased,
+		BucketBasedRootOK:       ft.BucketBasedRootOK,
+		SetTier:                 ft.SetTier,
+		GetTier:                 ft.GetTier,
+		ServerSideAcrossConfigs: ft.ServerSideAcrossConfigs,
+		IsLocal:                 ft.IsLocal,
+		SlowModTime:             ft.SlowModTime,
+		SlowHash:                ft.SlowHash,
+	}).Fill(ctx, sf).Mask(ctx, f)
+	return sf
+}
+
+// check returns errOutsideRoot if remote leads outside of rootDir.
+func (f *strictFs) check(remote string) error {
+	p := filepath.Join(f.root, filepath.FromSlash(remote))
+	if !hasPathPrefix(p, f.rootDir) {
+		return errOutsideRoot
+	}
+	return checkSymlinks(f.rootDir, p)
+}
+
+func (f *strictFs) Features() *fs.Features {
+	return f.features
+}
+
+func (f *strictFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	if err := f.check(dir); err != nil {
+		return nil, err
+	}
+	return f.Fs.List(ctx, dir)
+}
+
+func (f *strictFs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	if err := f.check(remote); err != nil {
+		return nil, err
+	}
+	return f.Fs.NewObject(ctx, remote)
+}
+
+func (f *strictFs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	if err := f.check(src.Remote()); err != nil {
+		return nil, err
+	}
+	return f.Fs.Put(ctx, in, src, options...)
+}
+
+func (f *strictFs) PutStream(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	if err := f.check(src.Remote()); err != nil {
+		return nil, err
+	}
+	return f.Fs.Features().PutStream(ctx, in, src, options...)
+}
+
+func (f *strictFs) Mkdir(ctx context.Context, dir string) error {
+	if err := f.check(dir); err != nil {
+		return err
+	}
+	return f.Fs.Mkdir(ctx, dir)
+}
+
+func (f *strictFs) Rmdir(ctx context.Context, dir string) error {
+	if err := f.check(dir); err != nil {
+		return err
+	}
+	return f.Fs.Rmdir(ctx, dir)
+}
+
+func (f *strictFs) Purge(ctx context.Context, dir string) error {
+	if err := f.check(dir); err != nil {
+		return err
+	}
+	return f.Fs.Features().Purge(ctx, dir)
+}
+
+func (f *strictFs) Move(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+	if err := f.check(remote); err != nil {
+		return nil, err
+	}
+	return f.Fs.Features().Move(ctx, src, remote)
+}
+
+func (f *strictFs) DirMove(ctx context.Context, src fs.Fs, srcRemote, dstRemote string) error {
+	if err := f.check(dstRemote); err != nil {
+		return err
+	}
+	if sf, ok := src.(*strictFs); ok {
+		if err := sf.check(srcRemote); err != nil {
+			return err
+		}
+		src = sf.Fs
+	}
+	return f.Fs.Features().DirMove(ctx, src, srcRemote, dstRemote)
+}
+
+func (f *strictFs) OpenWriterAt(ctx context.Context, remote string, size int64) (fs.WriterAtCloser, error) {
+	if err := f.check(remote); err != nil {
+		return nil, err
+	}
+	return f.Fs.Features().OpenWriterAt(ctx, remote, size)
+}
+
+func (f *strictFs) About(ctx context.Context) (*fs.Usage, error) {
+	return f.Fs.Features().About(ctx)
+}
+
+func (f *strictFs) Command(ctx context.Context, name string, arg []string, opt map[string]string) (interface{}, error) {
+	return f.Fs.Features().Command(ctx, name, arg, opt)
+}
+
+var (
+	_ fs.Fs             = &strictFs{}
+	_ fs.Purger         = &strictFs{}
+	_ fs.PutStreamer    = &strictFs{}
+	_ fs.Mover          = &strictFs{}
+	_ fs.DirMover       = &strictFs{}
+	_ fs.Abouter        = &strictFs{}
+	_ fs.Commander      = &strictFs{}
+	_ fs.OpenWriterAter = &strictFs{}
+)
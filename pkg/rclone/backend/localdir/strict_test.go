@@ -0,0 +1,110 @@
+// Copyright (C) 2017 ScyllaDB
+
+package localdir
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/object"
+)
+
+func TestStrictFs(t *testing.T) {
+	var (
+		rootDir = t.TempDir()
+		outside = t.TempDir()
+		bucket  = filepath.Join(rootDir, "bucket")
+	)
+	if err := os.MkdirAll(filepath.Join(bucket, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(bucket, "dir"), filepath.Join(bucket, "inside")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(bucket, "outside")); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	f, err := NewStrictFs(rootDir)(ctx, "file", "bucket", configmap.Simple{})
+	if err != nil {
+		t.Fatal("NewStrictFs() error", err)
+	}
+
+	put := func(remote string) error {
+		b := []byte("data")
+		src := object.NewStaticObjectInfo(remote, time.Now(), int64(len(b)), true, nil, nil)
+		_, err := f.Put(ctx, bytes.NewReader(b), src)
+		return err
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		for _, remote := range []string{"file.txt", "dir/file.txt", "new/file.txt", "inside/file.txt", "../other/file.txt"} {
+			if err := put(remote); err != nil {
+				t.Errorf("Put(%s) error %s", remote, err)
+			}
+		}
+	})
+
+	t.Run("Outside of root", func(t *testing.T) {
+		for _, remote := range []string{"../../escaped.txt", "dir/../../../escaped.txt", "outside/escaped.txt", "outside/dir/escaped.txt"} {
+			if err := put(remote); err == nil {
+				t.Errorf("Put(%s) expected error", remote)
+			}
+		}
+		for _, p := range []string{filepath.Join(filepath.Dir(rootDir), "escaped.txt"), filepath.Join(outside, "escaped.txt")} {
+			if _, err := os.Stat(p); !os.IsNotExist(err) {
+				t.Errorf("File %s created", p)
+			}
+		}
+		if err := f.Mkdir(ctx, "../../escaped"); err == nil {
+			t.Error("Mkdir() expected error")
+		}
+		if _, err := f.NewObject(ctx, "outside/file.txt"); err == nil {
+			t.Error("NewObject() expected error")
+		}
+		if _, err := f.List(ctx, "outside"); err == nil {
+			t.Error("List() expected error")
+		}
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		o, err := f.NewObject(ctx, "file.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		move := f.Features().Move
+		if move == nil {
+			t.Fatal("Move not supported")
+		}
+		if _, err := move(ctx, o, "outside/moved.txt"); err == nil {
+			t.Error("Move() expected error")
+		}
+		if _, err := move(ctx, o, "dir/moved.txt"); err != nil {
+			t.Error("Move() error", err)
+		}
+	})
+
+	t.Run("DirMove", func(t *testing.T) {
+		dirMove := f.Features().DirMove
+		if dirMove == nil {
+			t.Fatal("DirMove not supported")
+		}
+		if err := dirMove(ctx, f, "new", "outside/new"); err == nil {
+			t.Error("DirMove() expected error")
+		}
+		if err := dirMove(ctx, f, "new", "moved"); err != nil {
+			t.Error("DirMove() error", err)
+		}
+	})
+
+	if _, ok := f.(fs.Abouter); !ok {
+		t.Error("About not supported")
+	}
+}
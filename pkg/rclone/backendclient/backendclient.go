@@ -0,0 +1,179 @@
+// Copyright (C) 2017 ScyllaDB
+
+// Package backendclient creates clients of S3, GCS and Azure APIs configured
+// the same way as the corresponding rclone remotes. The clients are used for
+// operations that are not supported by rclone i.e. resumable uploads and
+// object lock.
+package backendclient
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	azurebackend "github.com/rclone/rclone/backend/azureblob"
+	gcsbackend "github.com/rclone/rclone/backend/googlecloudstorage"
+	s3backend "github.com/rclone/rclone/backend/s3"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/fs/fshttp"
+	"github.com/rclone/rclone/lib/env"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	gcsScope             = "https://www.googleapis.com/auth/devstorage.read_write"
+	azureDefaultEndpoint = "blob.core.windows.net"
+)
+
+// Options reads options of the configured remote name into opt that must be
+// a pointer to options struct of the backend.
+func Options(name string, opt interface{}) error {
+	backend, _ := fs.ConfigFileGet(name, "type")
+	ri, err := fs.Find(backend)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(configstruct.Set(fs.ConfigMap(ri, name), opt), "read options")
+}
+
+// SplitRoot splits root of bucket based fs into bucket and path.
+func SplitRoot(root string) (bucket, prefix string) {
+	root = strings.Trim(root, "/")
+	if i := strings.Index(root, "/"); i >= 0 {
+		return root[:i], root[i+1:]
+	}
+	return root, ""
+}
+
+// S3 returns S3 client, requests are not retried if maxRetries is 0.
+func S3(ctx context.Context, opt s3backend.Options, maxRetries int) (*s3.S3, error) {
+	cfg := aws.NewConfig().
+		WithMaxRetries(maxRetries).
+		WithHTTPClient(fshttp.NewClient(ctx)).
+		WithS3ForcePathStyle(opt.ForcePathStyle && !opt.UseAccelerateEndpoint).
+		WithS3UseAccelerate(opt.UseAccelerateEndpoint).
+		WithRegion(opt.Region)
+	if opt.Region == "" {
+		cfg.WithRegion("us-east-1")
+	}
+	if opt.Endpoint != "" {
+		cfg.WithEndpoint(opt.Endpoint)
+	}
+	sopt := session.Options{Config: *cfg}
+	switch {
+	case opt.AccessKeyID != "" || opt.SecretAccessKey != "":
+		sopt.Config.Credentials = credentials.NewStaticCredentials(opt.AccessKeyID, opt.SecretAccessKey, opt.SessionToken)
+	case opt.EnvAuth:
+		sopt.SharedConfigState = session.SharedConfigEnable
+	default:
+		sopt.Config.Credentials = credentials.AnonymousCredentials
+	}
+	ses, err := session.NewSessionWithOptions(sopt)
+	if err != nil {
+		return nil, errors.Wrap(err, "create session")
+	}
+	return s3.New(ses), nil
+}
+
+// GCS returns HTTP client authorized to use GCS JSON API.
+func GCS(ctx context.Context, opt gcsbackend.Options) (*http.Client, error) {
+	hctx := context.WithValue(ctx, oauth2.HTTPClient, fshttp.NewClient(ctx))
+	creds := opt.ServiceAccountCredentials
+	if creds == "" && opt.ServiceAccountFile != "" {
+		b, err := ioutil.ReadFile(env.ShellExpand(opt.ServiceAccountFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "read service account file")
+		}
+		creds = string(b)
+	}
+	switch {
+	case opt.Anonymous:
+		return fshttp.NewClient(ctx), nil
+	case creds != "":
+		conf, err := google.JWTConfigFromJSON([]byte(creds), gcsScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse service account credentials")
+		}
+		return oauth2.NewClient(hctx, conf.TokenSource(hctx)), nil
+	default:
+		ts, err := google.DefaultTokenSource(hctx, gcsScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "get default credentials")
+		}
+		return oauth2.NewClient(hctx, ts), nil
+	}
+}
+
+// Azure returns Azure Blob service URL, requests are tried at most maxTries
+// times. It returns nil if authentication method of the remote is not
+// supported, such remotes shall be handled by rclone.
+func Azure(ctx context.Context, opt azurebackend.Options, maxTries int32) (*azblob.ServiceURL, error) {
+	if opt.Endpoint == "" {
+		opt.Endpoint = azureDefaultEndpoint
+	}
+
+	var (
+		c       azblob.Credential
+		rawURL  = fmt.Sprintf("https://%s.%s", opt.Account, opt.Endpoint)
+		userMSI = opt.MSIClientID != "" || opt.MSIObjectID != "" || opt.MSIResourceID != ""
+	)
+	switch {
+	case opt.Account != "" && opt.Key != "":
+		k, err := azblob.NewSharedKeyCredential(opt.Account, opt.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse credentials")
+		}
+		c = k
+	case opt.SASURL != "":
+		su, err := url.Parse(opt.SASURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse SAS URL")
+		}
+		if azblob.NewBlobURLParts(*su).ContainerName != "" {
+			// Container level SAS is not supported
+			return nil, nil
+		}
+		rawURL = opt.SASURL
+		c = azblob.NewAnonymousCredential()
+	case opt.UseMSI && !userMSI:
+		t, err := azurebackend.GetMSIToken(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "get MSI token")
+		}
+		c = azblob.NewTokenCredential(t.AccessToken, func(tc azblob.TokenCredential) time.Duration {
+			t, err := azurebackend.GetMSIToken(ctx, nil)
+			if err != nil {
+				fs.Errorf(nil, "Failed to refresh MSI token: %s", err)
+				return 0
+			}
+			tc.SetToken(t.AccessToken)
+			return t.Expires().Add(-time.Minute).Sub(timeutc.Now())
+		})
+	default:
+		// Other authentication methods are left to rclone
+		return nil, nil
+	}
+
+	su, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse URL")
+	}
+	p := azblob.NewPipeline(c, azblob.PipelineOptions{
+		Retry: azblob.RetryOptions{MaxTries: maxTries},
+	})
+	u := azblob.NewServiceURL(*su, p)
+	return &u, nil
+}
@@ -27,6 +27,54 @@ func InitFsConfigWithOptions(o GlobalOptions) {
 	*GetConfig() = o
 }
 
+// fsConfigUpdate holds config values waiting to be applied, rclone reads
+// the global config without synchronization so it's only changed when no
+// operation is running.
+var fsConfigUpdate struct {
+	mu      sync.Mutex
+	running int
+	pending *GlobalOptions
+}
+
+// SetFsConfigOptions sets custom config values keeping the registered
+// providers. It can be called when the server is running, if operations are
+// running the values are applied when the last of them is done.
+func SetFsConfigOptions(o GlobalOptions) {
+	fsConfigUpdate.mu.Lock()
+	defer fsConfigUpdate.mu.Unlock()
+
+	if fsConfigUpdate.running > 0 {
+		fsConfigUpdate.pending = &o
+		fs.Infof(nil, "config options will be applied when running operations are done")
+		return
+	}
+	*GetConfig() = o
+	fsConfigUpdate.pending = nil
+}
+
+// StartOperation marks start of an operation that reads the global config,
+// the returned function must be called when the operation is done.
+func StartOperation() (done func()) {
+	fsConfigUpdate.mu.Lock()
+	fsConfigUpdate.running++
+	fsConfigUpdate.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			fsConfigUpdate.mu.Lock()
+			defer fsConfigUpdate.mu.Unlock()
+
+			fsConfigUpdate.running--
+			if fsConfigUpdate.running == 0 && fsConfigUpdate.pending != nil {
+				*GetConfig() = *fsConfigUpdate.pending
+				fsConfigUpdate.pending = nil
+				fs.Infof(nil, "applied config options")
+			}
+		})
+	}
+}
+
 func initInMemoryConfig() {
 	c := new(inMemoryConf)
 	fs.ConfigFileGet = c.Get
@@ -70,7 +118,7 @@ func (c *inMemoryConf) Set(section, key, value string) (err error) {
 		s = make(map[string]string)
 	}
 	if value == "" {
-		delete(c.sections[section], value)
+		delete(c.sections[section], key)
 	} else {
 		s[key] = value
 		c.sections[section] = s
@@ -46,3 +46,19 @@ func TestInMemoryConfNotExposed(t *testing.T) {
 		})
 	}
 }
+
+func TestSetFsConfigOptionsWaitsForRunningOperations(t *testing.T) {
+	rclone.InitFsConfig()
+
+	done := rclone.StartOperation()
+	o := rclone.DefaultGlobalOptions()
+	o.Transfers = 99
+	rclone.SetFsConfigOptions(o)
+	if rclone.GetConfig().Transfers == 99 {
+		t.Fatal("Options applied while operation is running")
+	}
+	done()
+	if v := rclone.GetConfig().Transfers; v != 99 {
+		t.Fatalf("Transfers = %d, expected 99", v)
+	}
+}
@@ -22,6 +22,8 @@ import (
 	_ "github.com/rclone/rclone/backend/googlecloudstorage"
 	_ "github.com/rclone/rclone/backend/local"
 	_ "github.com/rclone/rclone/backend/s3"
+	_ "github.com/rclone/rclone/backend/sftp"
+	_ "github.com/rclone/rclone/backend/webdav"
 )
 
 var rclonePkgName = map[string]string{
@@ -29,6 +31,8 @@ var rclonePkgName = map[string]string{
 	"google cloud storage": "GCS",
 	"local":                "Local",
 	"s3":                   "S3",
+	"sftp":                 "SFTP",
+	"webdav":               "WebDAV",
 }
 
 func main() {
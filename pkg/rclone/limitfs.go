@@ -0,0 +1,149 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/rclone/rclone/fs"
+	"golang.org/x/time/rate"
+)
+
+// limiterBurst must be bigger than the biggest read, reads are split
+// otherwise.
+const limiterBurst = 4 * 1024 * 1024
+
+// NewBandwidthLimiter returns limiter of a given amount of bytes per second.
+// Set to 0 or less for full throttle.
+func NewBandwidthLimiter(bytesPerSecond int64) *rate.Limiter {
+	l := rate.NewLimiter(rate.Inf, limiterBurst)
+	SetBandwidthLimit(l, bytesPerSecond)
+	return l
+}
+
+// SetBandwidthLimit changes limit of a limiter returned by NewBandwidthLimiter,
+// it affects ongoing transfers.
+// Set to 0 or less for full throttle.
+func SetBandwidthLimit(l *rate.Limiter, bytesPerSecond int64) {
+	if bytesPerSecond <= 0 {
+		l.SetLimit(rate.Inf)
+		return
+	}
+	l.SetLimit(rate.Limit(bytesPerSecond))
+}
+
+// BandwidthLimitOf returns the amount of bytes per second allowed by l or -1
+// if it is not limited.
+func BandwidthLimitOf(l *rate.Limiter) int64 {
+	if v := l.Limit(); v != rate.Inf && v < math.MaxInt64 {
+		return int64(v)
+	}
+	return -1
+}
+
+// LimitedFs wraps f so that reading content of its objects is throttled by l.
+// It's used to limit bandwidth of a single job on top of the global rate limit.
+// Only listing, and reading objects is supported, it is meant to be used as
+// a source fs.
+func LimitedFs(ctx context.Context, f fs.Fs, l *rate.Limiter) fs.Fs {
+	lf := &limitedFs{
+		Fs:      f,
+		limiter: l,
+	}
+	lf.features = (&fs.Features{
+		CaseInsensitive:         true,
+		DuplicateFiles:          true,
+		ReadMimeType:            true,
+		WriteMimeType:           true,
+		CanHaveEmptyDirectories: true,
+		BucketBased:             true,
+		BucketBasedRootOK:       true,
+		SetTier:                 true,
+		GetTier:                 true,
+		ServerSideAcrossConfigs: true,
+		SlowModTime:             true,
+		SlowHash:                true,
+	}).Fill(ctx, lf).Mask(ctx, f)
+	return lf
+}
+
+type limitedFs struct {
+	fs.Fs
+	limiter  *rate.Limiter
+	features *fs.Features
+}
+
+func (f *limitedFs) Features() *fs.Features {
+	return f.features
+}
+
+func (f *limitedFs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	entries, err := f.Fs.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if o, ok := entries[i].(fs.Object); ok {
+			entries[i] = f.wrapObject(o)
+		}
+	}
+	return entries, nil
+}
+
+func (f *limitedFs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	o, err := f.Fs.NewObject(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapObject(o), nil
+}
+
+func (f *limitedFs) wrapObject(o fs.Object) fs.Object {
+	return &limitedObject{
+		Object:  o,
+		limiter: f.limiter,
+	}
+}
+
+type limitedObject struct {
+	fs.Object
+	limiter *rate.Limiter
+}
+
+func (o *limitedObject) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	rc, err := o.Object.Open(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedReader{
+		ReadCloser: rc,
+		ctx:        ctx,
+		limiter:    o.limiter,
+	}, nil
+}
+
+// UnWrap returns the wrapped object.
+func (o *limitedObject) UnWrap() fs.Object {
+	return o.Object
+}
+
+type limitedReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (r *limitedReader) Read(p []byte) (n int, err error) {
+	if len(p) > limiterBurst {
+		p = p[:limiterBurst]
+	}
+	n, err = r.ReadCloser.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return n, err
+}
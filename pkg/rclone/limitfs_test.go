@@ -0,0 +1,74 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rclone/rclone/fs"
+)
+
+func TestLimitedFs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scylla-manager-limitfs-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	content := []byte("limited content")
+	if err := ioutil.WriteFile(filepath.Join(dir, "file"), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	InitFsConfig()
+	if err := RegisterLocalDirProvider("limitfs", "testing provider", dir); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	f, err := fs.NewFs(ctx, "limitfs:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := NewBandwidthLimiter(1024 * 1024)
+	lf := LimitedFs(ctx, f, l)
+
+	entries, err := lf.List(ctx, "")
+	if err != nil {
+		t.Fatal("List() error", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("List() = %v, expected 1 entry", entries)
+	}
+	if _, ok := entries[0].(*limitedObject); !ok {
+		t.Fatalf("List() returned %T, expected limited object", entries[0])
+	}
+
+	o, err := lf.NewObject(ctx, "file")
+	if err != nil {
+		t.Fatal("NewObject() error", err)
+	}
+	r, err := o.Open(ctx)
+	if err != nil {
+		t.Fatal("Open() error", err)
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal("ReadAll() error", err)
+	}
+	if string(b) != string(content) {
+		t.Fatalf("ReadAll() = %s, expected %s", b, content)
+	}
+
+	if v := BandwidthLimitOf(l); v != 1024*1024 {
+		t.Fatalf("BandwidthLimitOf() = %d, expected %d", v, 1024*1024)
+	}
+	SetBandwidthLimit(l, 0)
+	if v := BandwidthLimitOf(l); v != -1 {
+		t.Fatalf("BandwidthLimitOf() = %d, expected unlimited", v)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2017 ScyllaDB
+
+package multipart
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/pkg/errors"
+	azurebackend "github.com/rclone/rclone/backend/azureblob"
+	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+const azureMaxParts = 50000
+
+// azureUploader stages blocks and commits them as a block blob, upload ID is
+// a random prefix of block IDs. Uncommitted blocks are removed by Azure after
+// a week.
+type azureUploader struct {
+	opt        azurebackend.Options
+	serviceURL azblob.ServiceURL
+}
+
+var _ uploader = &azureUploader{}
+
+func newAzureUploader(ctx context.Context, name string) (*azureUploader, error) {
+	u := &azureUploader{}
+	if err := backendclient.Options(name, &u.opt); err != nil {
+		return nil, err
+	}
+	if sc := rclone.UploadOptionsFromContext(ctx).StorageClass; sc != "" {
+		u.opt.AccessTier = sc
+	}
+
+	su, err := backendclient.Azure(ctx, u.opt, 1) // Parts are retried by the caller
+	if su == nil {
+		return nil, err
+	}
+	u.serviceURL = *su
+
+	return u, nil
+}
+
+func (u *azureUploader) MaxParts() int {
+	return azureMaxParts
+}
+
+func (u *azureUploader) ChunkSize() int64 {
+	return int64(u.opt.ChunkSize)
+}
+
+func (u *azureUploader) blob(s *State) azblob.BlockBlobURL {
+	return u.serviceURL.NewContainerURL(s.Bucket).NewBlockBlobURL(s.Key)
+}
+
+// blockID returns ID of the n-th block, IDs of all blocks of a blob must have
+// the same length.
+func blockID(uploadID string, n int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%06d", uploadID, n)))
+}
+
+// blockNumber returns number of the block or 0 if block is not a part of
+// the upload.
+func blockNumber(uploadID, id string) int {
+	b, err := base64.StdEncoding.DecodeString(id)
+	if err != nil || !strings.HasPrefix(string(b), uploadID+"-") {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(string(b), uploadID+"-"))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func (u *azureUploader) Create(ctx context.Context, s *State) error {
+	s.UploadID = uuid.MustRandom().String()
+	return nil
+}
+
+func (u *azureUploader) Sync(ctx context.Context, s *State) error {
+	bl, err := u.blob(s).GetBlockList(ctx, azblob.BlockListUncommitted, azblob.LeaseAccessConditions{})
+	if err != nil {
+		return azureError(err)
+	}
+	s.Parts = nil
+	for _, b := range bl.UncommittedBlocks {
+		n := blockNumber(s.UploadID, b.Name)
+		if n == 0 {
+			continue
+		}
+		p := Part{Number: n, Offset: int64(n-1) * s.PartSize, Size: int64(b.Size)}
+		if p.end() <= s.Size && (p.Size == s.PartSize || p.end() == s.Size) {
+			s.done(p)
+		}
+	}
+	return nil
+}
+
+func (u *azureUploader) UploadPart(ctx context.Context, s *State, p Part, body []byte) (Part, error) {
+	_, err := u.blob(s).StageBlock(ctx, blockID(s.UploadID, p.Number), bytes.NewReader(body),
+		azblob.LeaseAccessConditions{}, nil, azblob.ClientProvidedKeyOptions{})
+	return p, azureError(err)
+}
+
+func (u *azureUploader) Complete(ctx context.Context, s *State) error {
+	ids := make([]string, len(s.Parts))
+	for i, p := range s.Parts {
+		ids[i] = blockID(s.UploadID, p.Number)
+	}
+	_, err := u.blob(s).CommitBlockList(ctx, ids, azblob.BlobHTTPHeaders{},
+		azblob.Metadata{metaMtime: s.ModTime.Format(metaMtimeFormat)}, azblob.BlobAccessConditions{},
+		azblob.AccessTierType(u.opt.AccessTier), nil, azblob.ClientProvidedKeyOptions{})
+	return azureError(err)
+}
+
+// Abort is a noop, uncommitted blocks are garbage collected by Azure.
+func (u *azureUploader) Abort(ctx context.Context, s *State) error {
+	return nil
+}
+
+func azureError(err error) error {
+	var serr azblob.StorageError
+	if errors.As(err, &serr) && serr.Response() != nil && serr.Response().StatusCode == http.StatusNotFound {
+		return errors.Wrap(errUploadNotFound, string(serr.ServiceCode()))
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+// Copyright (C) 2017 ScyllaDB
+
+package multipart
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	gcsbackend "github.com/rclone/rclone/backend/googlecloudstorage"
+	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+)
+
+const (
+	gcsUploadURL = "https://storage.googleapis.com/upload/storage/v1/b/"
+	// gcsMaxParts is not limited by GCS, resumable upload is a single
+	// session, it limits the size of parts only.
+	gcsMaxParts = 10000
+	// statusResumeIncomplete is returned by GCS if upload is not finished.
+	statusResumeIncomplete = 308
+)
+
+// gcsUploader uses GCS resumable uploads, upload ID is the session URI.
+// Parts must be uploaded in order.
+type gcsUploader struct {
+	opt    gcsbackend.Options
+	client *http.Client
+}
+
+var _ uploader = &gcsUploader{}
+
+func newGCSUploader(ctx context.Context, name string) (*gcsUploader, error) {
+	u := &gcsUploader{}
+	if err := backendclient.Options(name, &u.opt); err != nil {
+		return nil, err
+	}
+	if sc := rclone.UploadOptionsFromContext(ctx).StorageClass; sc != "" {
+		u.opt.StorageClass = sc
+	}
+
+	c, err := backendclient.GCS(ctx, u.opt)
+	if err != nil {
+		return nil, err
+	}
+	u.client = c
+
+	return u, nil
+}
+
+func (u *gcsUploader) MaxParts() int {
+	return gcsMaxParts
+}
+
+func (u *gcsUploader) ChunkSize() int64 {
+	return int64(u.opt.ChunkSize)
+}
+
+func (u *gcsUploader) Create(ctx context.Context, s *State) error {
+	metadata := map[string]string{metaMtime: s.ModTime.Format(metaMtimeFormat)}
+	for k, v := range rclone.UploadOptionsFromContext(ctx).Metadata {
+		metadata[k] = v
+	}
+	meta := map[string]interface{}{
+		"name":     s.Key,
+		"metadata": metadata,
+	}
+	if u.opt.StorageClass != "" {
+		meta["storageClass"] = u.opt.StorageClass
+	}
+	body, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	q := url.Values{}
+	q.Set("uploadType", "resumable")
+	if !u.opt.BucketPolicyOnly && u.opt.ObjectACL != "" {
+		q.Set("predefinedAcl", u.opt.ObjectACL)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		gcsUploadURL+url.PathEscape(s.Bucket)+"/o?"+q.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(s.Size, 10))
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+	if resp.StatusCode != http.StatusOK {
+		return gcsError(resp)
+	}
+	s.UploadID = resp.Header.Get("Location")
+	if s.UploadID == "" {
+		return errors.New("missing upload session URI")
+	}
+	return nil
+}
+
+func (u *gcsUploader) Sync(ctx context.Context, s *State) error {
+	resp, err := u.put(ctx, s, fmt.Sprintf("bytes */%d", s.Size), nil)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+
+	var committed int64
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		committed = s.Size
+	case statusResumeIncomplete:
+		committed = committedBytes(resp)
+	default:
+		return gcsError(resp)
+	}
+
+	s.Parts = nil
+	for _, p := range s.plan() {
+		if p.Offset >= committed {
+			break
+		}
+		if p.end() > committed {
+			p.Size = committed - p.Offset
+		}
+		s.done(p)
+	}
+	return nil
+}
+
+func (u *gcsUploader) UploadPart(ctx context.Context, s *State, p Part, body []byte) (Part, error) {
+	for off := p.Offset; off < p.end(); {
+		chunk := body[off-p.Offset:]
+		resp, err := u.put(ctx, s, fmt.Sprintf("bytes %d-%d/%d", off, p.end()-1, s.Size), chunk)
+		if err != nil {
+			return p, err
+		}
+		switch resp.StatusCode {
+		case http.StatusOK, http.StatusCreated:
+			drain(resp)
+			return p, nil
+		case statusResumeIncomplete:
+			drain(resp)
+			// GCS may commit only a part of the chunk
+			c := committedBytes(resp)
+			if c <= off {
+				return p, errors.Errorf("no progress, %d bytes committed", c)
+			}
+			off = c
+		default:
+			err := gcsError(resp)
+			drain(resp)
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+// Complete is a noop, upload is completed with the last part.
+func (u *gcsUploader) Complete(ctx context.Context, s *State) error {
+	return nil
+}
+
+func (u *gcsUploader) Abort(ctx context.Context, s *State) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.UploadID, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer drain(resp)
+	// GCS returns 499 on successful cancellation
+	if resp.StatusCode == 499 || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return gcsError(resp)
+}
+
+func (u *gcsUploader) put(ctx context.Context, s *State, contentRange string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.UploadID, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Range", contentRange)
+	return u.client.Do(req)
+}
+
+// committedBytes returns number of bytes persisted by GCS based on the Range
+// header i.e. "bytes=0-42".
+func committedBytes(resp *http.Response) int64 {
+	r := resp.Header.Get("Range")
+	i := strings.LastIndex(r, "-")
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.ParseInt(r[i+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n + 1
+}
+
+func gcsError(resp *http.Response) error {
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	err := errors.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return errors.Wrap(errUploadNotFound, err.Error())
+	}
+	return err
+}
+
+func drain(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body) // nolint: errcheck
+	resp.Body.Close()
+}
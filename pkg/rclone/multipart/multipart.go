@@ -0,0 +1,361 @@
+// Copyright (C) 2017 ScyllaDB
+
+// Package multipart implements resumable uploads of large files to S3, GCS
+// and Azure. Files are uploaded in parts and the state of an upload
+// (upload ID and completed parts) is persisted on disk, so that an interrupted
+// upload can be continued by another job i.e. after the agent restart or when
+// backup is continued.
+package multipart
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/pkg/util/retry"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+// Options specify resumable uploads.
+type Options struct {
+	// StateDir is a directory where upload states are kept, resumable uploads
+	// are disabled if empty.
+	StateDir string
+	// MinFileSize is the size of the smallest file uploaded with resumable
+	// upload, smaller files are uploaded by rclone.
+	MinFileSize int64
+	// MaxAge is the time after which not finished uploads are aborted.
+	MaxAge time.Duration
+}
+
+var (
+	optMu sync.Mutex
+	opt   Options
+)
+
+// SetOptions sets resumable upload options, they apply to uploads started
+// afterwards.
+func SetOptions(o Options) {
+	optMu.Lock()
+	opt = o
+	optMu.Unlock()
+}
+
+func getOptions() Options {
+	optMu.Lock()
+	defer optMu.Unlock()
+	return opt
+}
+
+var errUploadNotFound = errors.New("upload not found")
+
+// uploader is implemented by providers supporting multipart uploads.
+type uploader interface {
+	// MaxParts returns the maximal number of parts of an upload.
+	MaxParts() int
+	// ChunkSize returns the configured size of a part.
+	ChunkSize() int64
+	// Create starts a new upload and sets s.UploadID.
+	Create(ctx context.Context, s *State) error
+	// Sync replaces parts of s with the parts known to the provider.
+	// It returns errUploadNotFound if the upload is no longer available.
+	Sync(ctx context.Context, s *State) error
+	// UploadPart uploads content of the part p and returns p with ETag set
+	// if applicable.
+	UploadPart(ctx context.Context, s *State, p Part, body []byte) (Part, error)
+	// Complete creates the object from the uploaded parts.
+	Complete(ctx context.Context, s *State) error
+	// Abort cancels the upload and frees the uploaded parts.
+	Abort(ctx context.Context, s *State) error
+}
+
+// newUploader returns uploader for the remote of f.
+// It returns nil if provider does not support resumable uploads.
+func newUploader(ctx context.Context, f fs.Fs) (uploader, error) {
+	backend, _ := fs.ConfigFileGet(f.Name(), "type")
+	switch backend {
+	case "s3":
+		return newS3Uploader(ctx, f.Name())
+	case "gcs":
+		return newGCSUploader(ctx, f.Name())
+	case "azureblob":
+		u, err := newAzureUploader(ctx, f.Name())
+		if u == nil {
+			return nil, err
+		}
+		return u, nil
+	default:
+		return nil, nil
+	}
+}
+
+// UploadDir uploads files from srcFs/srcRemote to dstFs/dstRemote that are
+// not smaller than MinFileSize using resumable uploads. Files that already
+// exist in the destination and have the same size are skipped.
+// Only files directly in srcRemote are uploaded, rest of the files shall be
+// copied by rclone that treats the uploaded files as equal to the source.
+// Upload progress is reported in the stats group of ctx, bytes uploaded in
+// the previous jobs are reported as uploaded.
+func UploadDir(ctx context.Context, dstFs fs.Fs, dstRemote string, srcFs fs.Fs, srcRemote string) error {
+	o := getOptions()
+	if o.StateDir == "" {
+		return nil
+	}
+
+	u, err := newUploader(ctx, dstFs)
+	if err != nil {
+		return errors.Wrap(err, "init uploader")
+	}
+	if u == nil {
+		return nil
+	}
+	st, err := openStateStore(o.StateDir)
+	if err != nil {
+		// Uploads shall not fail because of the agent local state,
+		// fallback to non-resumable uploads.
+		fs.Errorf(nil, "Resumable uploads disabled: %s", err)
+		return nil
+	}
+	abortExpired(ctx, u, st, dstFs.Name(), o.MaxAge)
+
+	entries, err := srcFs.List(ctx, srcRemote)
+	if err != nil {
+		return err
+	}
+	var objs []fs.Object
+	for _, e := range entries {
+		if obj, ok := e.(fs.Object); ok && obj.Size() >= o.MinFileSize && obj.Size() > 0 {
+			objs = append(objs, obj)
+		}
+	}
+	if len(objs) == 0 {
+		return nil
+	}
+
+	bucket, prefix := backendclient.SplitRoot(dstFs.Root())
+	return parallel.Run(len(objs), fs.GetConfig(ctx).Transfers, func(i int) error {
+		obj := objs[i]
+		remote := path.Join(dstRemote, path.Base(obj.Remote()))
+		if dst, err := dstFs.NewObject(ctx, remote); err == nil && dst.Size() == obj.Size() {
+			return nil
+		}
+		j := job{
+			uploader: u,
+			store:    st,
+			provider: dstFs.Name(),
+			bucket:   bucket,
+			key:      path.Join(prefix, remote),
+			obj:      obj,
+		}
+		return errors.Wrapf(j.run(ctx), "upload %s", obj.Remote())
+	})
+}
+
+// abortExpired aborts uploads to the provider older than maxAge.
+func abortExpired(ctx context.Context, u uploader, st *stateStore, provider string, maxAge time.Duration) {
+	if maxAge <= 0 {
+		return
+	}
+	states, err := st.List()
+	if err != nil {
+		fs.Errorf(nil, "Failed to list upload states: %s", err)
+		return
+	}
+	for _, s := range states {
+		if s.Provider != provider || timeutc.Since(s.CreatedAt) < maxAge {
+			continue
+		}
+		fs.Infof(nil, "Aborting expired upload of %s to %s:%s/%s", s.Source, s.Provider, s.Bucket, s.Key)
+		if err := u.Abort(ctx, s); err != nil && !errors.Is(err, errUploadNotFound) {
+			fs.Errorf(nil, "Failed to abort upload of %s: %s", s.Source, err)
+			continue
+		}
+		if err := st.Delete(s); err != nil {
+			fs.Errorf(nil, "Failed to delete upload state of %s: %s", s.Source, err)
+		}
+	}
+}
+
+// job is upload of a single file.
+type job struct {
+	uploader uploader
+	store    *stateStore
+	provider string
+	bucket   string
+	key      string
+	obj      fs.Object
+}
+
+func (j job) run(ctx context.Context) (err error) {
+	s, err := j.state(ctx)
+	if err != nil {
+		return err
+	}
+
+	tr := accounting.Stats(ctx).NewTransfer(j.obj)
+	defer func() {
+		tr.Done(ctx, err)
+	}()
+	// Bytes are accounted manually as the file is read in ranges,
+	// bytes uploaded in previous jobs are reported as uploaded.
+	acc := tr.Account(ctx, ioutil.NopCloser(bytes.NewReader(nil)))
+	acc.ServerSideCopyEnd(s.uploaded())
+
+	for _, p := range s.remaining() {
+		body, err := j.read(ctx, acc, p)
+		if err != nil {
+			return errors.Wrapf(err, "read part %d", p.Number)
+		}
+		if p, err = j.uploadPart(ctx, s, p, body); err != nil {
+			return errors.Wrapf(err, "upload part %d", p.Number)
+		}
+		s.done(p)
+		if err := j.store.Put(s); err != nil {
+			return errors.Wrap(err, "save upload state")
+		}
+	}
+
+	if err := j.uploader.Complete(ctx, s); err != nil {
+		return errors.Wrap(err, "complete upload")
+	}
+	if err := j.store.Delete(s); err != nil {
+		fs.Errorf(j.obj, "Failed to delete upload state: %s", err)
+	}
+	fs.Debugf(j.obj, "Resumable upload succeeded")
+	return nil
+}
+
+// state returns state of a previous upload of the file if it can be continued,
+// otherwise new upload is created.
+func (j job) state(ctx context.Context) (*State, error) {
+	s, err := j.store.Get(stateID(j.provider, j.bucket, j.key))
+	if err != nil {
+		fs.Errorf(j.obj, "Failed to read upload state: %s", err)
+		s = nil
+	}
+	if s != nil {
+		if s.Size != j.obj.Size() || !s.ModTime.Equal(j.obj.ModTime(ctx)) {
+			fs.Infof(j.obj, "Source changed, aborting previous upload")
+			if err := j.uploader.Abort(ctx, s); err != nil && !errors.Is(err, errUploadNotFound) {
+				fs.Errorf(j.obj, "Failed to abort previous upload: %s", err)
+			}
+			s = nil
+		} else if err := j.uploader.Sync(ctx, s); err != nil {
+			if !errors.Is(err, errUploadNotFound) {
+				return nil, errors.Wrap(err, "get upload parts")
+			}
+			fs.Infof(j.obj, "Previous upload not found, starting from scratch")
+			s = nil
+		} else {
+			fs.Infof(j.obj, "Resuming upload, %s out of %s already uploaded",
+				fs.SizeSuffix(s.uploaded()), fs.SizeSuffix(s.Size))
+		}
+	}
+	if s != nil {
+		return s, nil
+	}
+
+	s = newState(j.provider, j.bucket, j.key, j.obj.Remote(), j.obj.Size(), j.obj.ModTime(ctx),
+		partSize(j.obj.Size(), j.uploader.ChunkSize(), j.uploader.MaxParts()))
+	if err := j.uploader.Create(ctx, s); err != nil {
+		return nil, errors.Wrap(err, "create upload")
+	}
+	if err := j.store.Put(s); err != nil {
+		return nil, errors.Wrap(err, "save upload state")
+	}
+	return s, nil
+}
+
+// partAlignment is the size all parts but the last one are multiple of,
+// it's required by GCS.
+const partAlignment = 256 * 1024
+
+// partSize returns size of parts such that the file fits in maxParts parts.
+func partSize(size, chunkSize int64, maxParts int) int64 {
+	if min := (size + int64(maxParts) - 1) / int64(maxParts); chunkSize < min {
+		chunkSize = min
+	}
+	if r := chunkSize % partAlignment; r != 0 {
+		chunkSize += partAlignment - r
+	}
+	return chunkSize
+}
+
+// read returns the content of the part p, bytes are accounted in acc and
+// limited by the global bandwidth limit.
+func (j job) read(ctx context.Context, acc *accounting.Account, p Part) ([]byte, error) {
+	in, err := j.obj.Open(ctx, &fs.RangeOption{Start: p.Offset, End: p.end() - 1})
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	body := make([]byte, p.Size)
+	if _, err := io.ReadFull(accountedReader{in, acc}, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// uploadPart uploads the part retrying on errors.
+func (j job) uploadPart(ctx context.Context, s *State, p Part, body []byte) (Part, error) {
+	var (
+		out Part
+		b   = retry.WithMaxRetries(retry.NewExponentialBackoff(time.Second, 0, 30*time.Second, 2, 0.1),
+			uint64(fs.GetConfig(ctx).LowLevelRetries))
+	)
+	op := func() (err error) {
+		out, err = j.uploader.UploadPart(ctx, s, p, body)
+		if errors.Is(err, errUploadNotFound) {
+			return retry.Permanent(err)
+		}
+		return err
+	}
+	notify := func(err error, wait time.Duration) {
+		fs.Debugf(j.obj, "Upload part %d failed, retrying in %s: %s", p.Number, wait, err)
+	}
+	return out, retry.WithNotify(ctx, op, b, notify)
+}
+
+// accountedReader reports bytes read in the account, it is needed because
+// the account is not used for reading.
+type accountedReader struct {
+	io.Reader
+	acc *accounting.Account
+}
+
+const maxAccountedRead = 1024 * 1024
+
+func (r accountedReader) Read(p []byte) (int, error) {
+	if len(p) > maxAccountedRead {
+		p = p[:maxAccountedRead]
+	}
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		accounting.TokenBucket.LimitBandwidth(accounting.TokenBucketSlotAccounting, n)
+		r.acc.ServerSideCopyEnd(int64(n))
+	}
+	return n, err
+}
+
+// Modification time is kept in object metadata under metaMtime key the way
+// rclone backends do it, S3 uses a float number of seconds and the other
+// providers use metaMtimeFormat.
+const (
+	metaMtime       = "mtime"
+	metaMtimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+func mtimeFloat(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
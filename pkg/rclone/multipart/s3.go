@@ -0,0 +1,171 @@
+// Copyright (C) 2017 ScyllaDB
+
+package multipart
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	s3backend "github.com/rclone/rclone/backend/s3"
+	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+)
+
+// s3MaxParts is the maximal number of parts of a multipart upload.
+const s3MaxParts = 10000
+
+type s3Uploader struct {
+	opt s3backend.Options
+	c   *s3.S3
+}
+
+var _ uploader = &s3Uploader{}
+
+func newS3Uploader(ctx context.Context, name string) (*s3Uploader, error) {
+	u := &s3Uploader{}
+	if err := backendclient.Options(name, &u.opt); err != nil {
+		return nil, err
+	}
+	if sc := rclone.UploadOptionsFromContext(ctx).StorageClass; sc != "" {
+		u.opt.StorageClass = sc
+	}
+
+	c, err := backendclient.S3(ctx, u.opt, 0) // Parts are retried by the caller
+	if err != nil {
+		return nil, err
+	}
+	u.c = c
+
+	return u, nil
+}
+
+func (u *s3Uploader) MaxParts() int {
+	if u.opt.MaxUploadParts > 0 && u.opt.MaxUploadParts < s3MaxParts {
+		return int(u.opt.MaxUploadParts)
+	}
+	return s3MaxParts
+}
+
+func (u *s3Uploader) ChunkSize() int64 {
+	return int64(u.opt.ChunkSize)
+}
+
+func (u *s3Uploader) Create(ctx context.Context, s *State) error {
+	in := &s3.CreateMultipartUploadInput{
+		Bucket:   aws.String(s.Bucket),
+		Key:      aws.String(s.Key),
+		Metadata: map[string]*string{metaMtime: aws.String(mtimeFloat(s.ModTime))},
+	}
+	if u.opt.ServerSideEncryption != "" {
+		in.ServerSideEncryption = aws.String(u.opt.ServerSideEncryption)
+	}
+	if u.opt.SSEKMSKeyID != "" {
+		in.SSEKMSKeyId = aws.String(u.opt.SSEKMSKeyID)
+	}
+	if u.opt.StorageClass != "" {
+		in.StorageClass = aws.String(u.opt.StorageClass)
+	}
+	if u.opt.ACL != "" {
+		in.ACL = aws.String(u.opt.ACL)
+	}
+	if o := rclone.UploadOptionsFromContext(ctx); len(o.Metadata) > 0 {
+		for k, v := range o.Metadata {
+			in.Metadata[k] = aws.String(v)
+		}
+		in.Tagging = aws.String(o.Tagging())
+	}
+	out, err := u.c.CreateMultipartUploadWithContext(ctx, in)
+	if err != nil {
+		return err
+	}
+	s.UploadID = aws.StringValue(out.UploadId)
+	return nil
+}
+
+func (u *s3Uploader) Sync(ctx context.Context, s *State) error {
+	var parts []Part
+	in := &s3.ListPartsInput{
+		Bucket:   aws.String(s.Bucket),
+		Key:      aws.String(s.Key),
+		UploadId: aws.String(s.UploadID),
+	}
+	err := u.c.ListPartsPagesWithContext(ctx, in, func(out *s3.ListPartsOutput, last bool) bool {
+		for _, p := range out.Parts {
+			n := int(aws.Int64Value(p.PartNumber))
+			parts = append(parts, Part{
+				Number: n,
+				Offset: int64(n-1) * s.PartSize,
+				Size:   aws.Int64Value(p.Size),
+				ETag:   aws.StringValue(p.ETag),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return s3Error(err)
+	}
+	s.Parts = nil
+	for _, p := range parts {
+		// Part of unexpected size would have to be uploaded again anyway
+		if p.Offset+p.Size <= s.Size && (p.Size == s.PartSize || p.end() == s.Size) {
+			s.done(p)
+		}
+	}
+	return nil
+}
+
+func (u *s3Uploader) UploadPart(ctx context.Context, s *State, p Part, body []byte) (Part, error) {
+	out, err := u.c.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(s.Bucket),
+		Key:           aws.String(s.Key),
+		UploadId:      aws.String(s.UploadID),
+		PartNumber:    aws.Int64(int64(p.Number)),
+		Body:          bytes.NewReader(body),
+		ContentLength: aws.Int64(int64(len(body))),
+	})
+	if err != nil {
+		return p, s3Error(err)
+	}
+	p.ETag = aws.StringValue(out.ETag)
+	return p, nil
+}
+
+func (u *s3Uploader) Complete(ctx context.Context, s *State) error {
+	parts := make([]*s3.CompletedPart, len(s.Parts))
+	for i, p := range s.Parts {
+		parts[i] = &s3.CompletedPart{
+			ETag:       aws.String(p.ETag),
+			PartNumber: aws.Int64(int64(p.Number)),
+		}
+	}
+	_, err := u.c.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.Bucket),
+		Key:             aws.String(s.Key),
+		UploadId:        aws.String(s.UploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	return s3Error(err)
+}
+
+func (u *s3Uploader) Abort(ctx context.Context, s *State) error {
+	_, err := u.c.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(s.Bucket),
+		Key:      aws.String(s.Key),
+		UploadId: aws.String(s.UploadID),
+	})
+	return s3Error(err)
+}
+
+// s3Error converts error of a missing upload to errUploadNotFound.
+func s3Error(err error) error {
+	var aerr awserr.RequestFailure
+	if errors.As(err, &aerr) && (aerr.Code() == s3.ErrCodeNoSuchUpload || aerr.StatusCode() == http.StatusNotFound) {
+		return errors.Wrap(errUploadNotFound, aerr.Message())
+	}
+	return err
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2017 ScyllaDB
+
+package multipart
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+// Part is a continuous range of a file uploaded in a single request.
+// Parts are numbered from 1.
+type Part struct {
+	Number int    `json:"number"`
+	Offset int64  `json:"offset"`
+	Size   int64  `json:"size"`
+	ETag   string `json:"etag,omitempty"`
+}
+
+func (p Part) end() int64 {
+	return p.Offset + p.Size
+}
+
+// State of a multipart upload of a file.
+type State struct {
+	Provider  string    `json:"provider"`
+	Bucket    string    `json:"bucket"`
+	Key       string    `json:"key"`
+	Source    string    `json:"source"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"mod_time"`
+	PartSize  int64     `json:"part_size"`
+	UploadID  string    `json:"upload_id"`
+	Parts     []Part    `json:"parts"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ID returns name of the state file.
+func (s *State) ID() string {
+	return stateID(s.Provider, s.Bucket, s.Key)
+}
+
+func stateID(provider, bucket, key string) string {
+	h := sha256.Sum256([]byte(provider + ":" + bucket + "/" + key))
+	return hex.EncodeToString(h[:])
+}
+
+// plan returns all parts of the file.
+func (s *State) plan() []Part {
+	var parts []Part
+	for off, n := int64(0), 1; off < s.Size; off, n = off+s.PartSize, n+1 {
+		size := s.PartSize
+		if off+size > s.Size {
+			size = s.Size - off
+		}
+		parts = append(parts, Part{Number: n, Offset: off, Size: size})
+	}
+	return parts
+}
+
+// remaining returns ranges of the file that need to be uploaded. If a part
+// was partially uploaded only the rest of the part is returned.
+func (s *State) remaining() []Part {
+	done := make(map[int]Part, len(s.Parts))
+	for _, p := range s.Parts {
+		done[p.Number] = p
+	}
+
+	var out []Part
+	for _, p := range s.plan() {
+		d, ok := done[p.Number]
+		switch {
+		case !ok:
+			out = append(out, p)
+		case d.Offset == p.Offset && d.Size < p.Size:
+			out = append(out, Part{Number: p.Number, Offset: d.end(), Size: p.Size - d.Size})
+		}
+	}
+	return out
+}
+
+// uploaded returns number of bytes in completed parts.
+func (s *State) uploaded() int64 {
+	var n int64
+	for _, p := range s.Parts {
+		n += p.Size
+	}
+	return n
+}
+
+// done marks p as uploaded, if p is the rest of a partially uploaded part
+// the ranges are merged.
+func (s *State) done(p Part) {
+	for i := range s.Parts {
+		if s.Parts[i].Number != p.Number {
+			continue
+		}
+		if s.Parts[i].end() == p.Offset {
+			p.Size += p.Offset - s.Parts[i].Offset
+			p.Offset = s.Parts[i].Offset
+		}
+		s.Parts[i] = p
+		return
+	}
+	s.Parts = append(s.Parts, p)
+	sort.Slice(s.Parts, func(i, j int) bool {
+		return s.Parts[i].Number < s.Parts[j].Number
+	})
+}
+
+// stateStore keeps upload states as JSON files in a directory.
+type stateStore struct {
+	dir string
+}
+
+func openStateStore(dir string) (*stateStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "create state dir")
+	}
+	return &stateStore{dir: dir}, nil
+}
+
+func (st *stateStore) path(id string) string {
+	return filepath.Join(st.dir, id+".json")
+}
+
+// Get returns state of upload of a given id or nil if not found.
+func (st *stateStore) Get(id string) (*State, error) {
+	b, err := ioutil.ReadFile(st.path(id))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := new(State)
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", st.path(id))
+	}
+	return s, nil
+}
+
+// Put saves the state, the file is replaced atomically.
+func (st *stateStore) Put(s *State) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(st.dir, s.ID()+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), st.path(s.ID()))
+}
+
+// Delete removes the state if exists.
+func (st *stateStore) Delete(s *State) error {
+	if err := os.Remove(st.path(s.ID())); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// List returns all the states, states that can't be read are skipped.
+func (st *stateStore) List() ([]*State, error) {
+	files, err := ioutil.ReadDir(st.dir)
+	if err != nil {
+		return nil, err
+	}
+	var out []*State
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		s, err := st.Get(strings.TrimSuffix(f.Name(), ".json"))
+		if err != nil || s == nil {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func newState(provider, bucket, key, source string, size int64, modTime time.Time, partSize int64) *State {
+	return &State{
+		Provider:  provider,
+		Bucket:    bucket,
+		Key:       key,
+		Source:    source,
+		Size:      size,
+		ModTime:   modTime,
+		PartSize:  partSize,
+		CreatedAt: timeutc.Now(),
+	}
+}
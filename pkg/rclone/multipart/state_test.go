@@ -0,0 +1,180 @@
+// Copyright (C) 2017 ScyllaDB
+
+package multipart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStateRemaining(t *testing.T) {
+	table := []struct {
+		Name     string
+		Size     int64
+		Parts    []Part
+		Expected []Part
+	}{
+		{
+			Name: "Nothing uploaded",
+			Size: 25,
+			Expected: []Part{
+				{Number: 1, Offset: 0, Size: 10},
+				{Number: 2, Offset: 10, Size: 10},
+				{Number: 3, Offset: 20, Size: 5},
+			},
+		},
+		{
+			Name: "Some parts uploaded",
+			Size: 25,
+			Parts: []Part{
+				{Number: 1, Offset: 0, Size: 10},
+				{Number: 3, Offset: 20, Size: 5},
+			},
+			Expected: []Part{
+				{Number: 2, Offset: 10, Size: 10},
+			},
+		},
+		{
+			Name: "Partially uploaded part",
+			Size: 25,
+			Parts: []Part{
+				{Number: 1, Offset: 0, Size: 10},
+				{Number: 2, Offset: 10, Size: 4},
+			},
+			Expected: []Part{
+				{Number: 2, Offset: 14, Size: 6},
+				{Number: 3, Offset: 20, Size: 5},
+			},
+		},
+		{
+			Name: "All uploaded",
+			Size: 20,
+			Parts: []Part{
+				{Number: 1, Offset: 0, Size: 10},
+				{Number: 2, Offset: 10, Size: 10},
+			},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			s := State{Size: test.Size, PartSize: 10, Parts: test.Parts}
+			if diff := cmp.Diff(test.Expected, s.remaining()); diff != "" {
+				t.Fatal("remaining() diff", diff)
+			}
+		})
+	}
+}
+
+func TestStateDone(t *testing.T) {
+	s := State{Size: 25, PartSize: 10}
+
+	s.done(Part{Number: 2, Offset: 10, Size: 4})
+	s.done(Part{Number: 1, Offset: 0, Size: 10, ETag: "a"})
+	s.done(Part{Number: 2, Offset: 14, Size: 6, ETag: "b"})
+
+	expected := []Part{
+		{Number: 1, Offset: 0, Size: 10, ETag: "a"},
+		{Number: 2, Offset: 10, Size: 10, ETag: "b"},
+	}
+	if diff := cmp.Diff(expected, s.Parts); diff != "" {
+		t.Fatal("Parts diff", diff)
+	}
+	if s.uploaded() != 20 {
+		t.Fatalf("uploaded() = %d, expected 20", s.uploaded())
+	}
+}
+
+func TestPartSize(t *testing.T) {
+	const mib = 1024 * 1024
+
+	table := []struct {
+		Name      string
+		Size      int64
+		ChunkSize int64
+		MaxParts  int
+		Expected  int64
+	}{
+		{
+			Name:      "Chunk size",
+			Size:      100 * mib,
+			ChunkSize: 50 * mib,
+			MaxParts:  10000,
+			Expected:  50 * mib,
+		},
+		{
+			Name:      "Too many parts",
+			Size:      100 * mib,
+			ChunkSize: 5 * mib,
+			MaxParts:  10,
+			Expected:  10 * mib,
+		},
+		{
+			Name:      "Alignment",
+			Size:      100 * mib,
+			ChunkSize: mib + 1,
+			MaxParts:  10000,
+			Expected:  mib + partAlignment,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := partSize(test.Size, test.ChunkSize, test.MaxParts); v != test.Expected {
+				t.Fatalf("partSize() = %d, expected %d", v, test.Expected)
+			}
+		})
+	}
+}
+
+func TestStateStore(t *testing.T) {
+	st, err := openStateStore(t.TempDir())
+	if err != nil {
+		t.Fatal("openStateStore() error", err)
+	}
+
+	s := newState("s3", "bucket", "key", "file", 25, time.Unix(1, 0).UTC(), 10)
+	s.UploadID = "id"
+	s.done(Part{Number: 1, Offset: 0, Size: 10, ETag: "a"})
+
+	if v, err := st.Get(s.ID()); err != nil || v != nil {
+		t.Fatalf("Get() = %v, %v expected nil, nil", v, err)
+	}
+	if err := st.Put(s); err != nil {
+		t.Fatal("Put() error", err)
+	}
+	v, err := st.Get(s.ID())
+	if err != nil {
+		t.Fatal("Get() error", err)
+	}
+	if diff := cmp.Diff(s, v); diff != "" {
+		t.Fatal("Get() diff", diff)
+	}
+	l, err := st.List()
+	if err != nil {
+		t.Fatal("List() error", err)
+	}
+	if len(l) != 1 {
+		t.Fatalf("List() = %v, expected 1 state", l)
+	}
+	if err := st.Delete(s); err != nil {
+		t.Fatal("Delete() error", err)
+	}
+	if v, err := st.Get(s.ID()); err != nil || v != nil {
+		t.Fatalf("Get() = %v, %v expected nil, nil", v, err)
+	}
+}
+
+func TestBlockID(t *testing.T) {
+	const uploadID = "c2b9fd70-1a0a-4bd3-a7ad-52d6e4a59a4f"
+	if n := blockNumber(uploadID, blockID(uploadID, 42)); n != 42 {
+		t.Fatalf("blockNumber() = %d, expected 42", n)
+	}
+	if n := blockNumber("other", blockID(uploadID, 42)); n != 0 {
+		t.Fatalf("blockNumber() = %d, expected 0", n)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2017 ScyllaDB
+
+package objectlock
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	gcsbackend "github.com/rclone/rclone/backend/googlecloudstorage"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+)
+
+const gcsAPIURL = "https://storage.googleapis.com/storage/v1/b/"
+
+// gcsLocker relies on bucket retention policy, every object in the bucket
+// is retained for the retention period of the policy since its creation.
+type gcsLocker struct {
+	bucket string
+	client *http.Client
+}
+
+var _ locker = &gcsLocker{}
+
+func newGCSLocker(ctx context.Context, name, bucket string) (*gcsLocker, error) {
+	var opt gcsbackend.Options
+	if err := backendclient.Options(name, &opt); err != nil {
+		return nil, err
+	}
+	c, err := backendclient.GCS(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsLocker{bucket: bucket, client: c}, nil
+}
+
+func (l *gcsLocker) Check(ctx context.Context) error {
+	var b struct {
+		RetentionPolicy *struct {
+			RetentionPeriod string `json:"retentionPeriod"`
+		} `json:"retentionPolicy"`
+	}
+	if err := l.get(ctx, gcsAPIURL+url.PathEscape(l.bucket)+"?fields=retentionPolicy", &b); err != nil {
+		return err
+	}
+	if b.RetentionPolicy == nil {
+		return errors.Wrapf(ErrNotEnabled, "bucket %s has no retention policy", l.bucket)
+	}
+	return nil
+}
+
+func (l *gcsLocker) Lock(ctx context.Context, key string, r Retention) error {
+	t, err := l.retentionExpirationTime(ctx, key)
+	if err != nil {
+		return err
+	}
+	if t.Before(r.RetainUntil) {
+		return errors.Errorf("retention policy of bucket %s retains object until %s, "+
+			"it must be retained until %s", l.bucket, t.Format(time.RFC3339), r.RetainUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (l *gcsLocker) Locked(ctx context.Context, key string, t time.Time) (bool, error) {
+	exp, err := l.retentionExpirationTime(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	return !exp.Before(t), nil
+}
+
+func (l *gcsLocker) retentionExpirationTime(ctx context.Context, key string) (time.Time, error) {
+	var o struct {
+		RetentionExpirationTime time.Time `json:"retentionExpirationTime"`
+	}
+	u := gcsAPIURL + url.PathEscape(l.bucket) + "/o/" + url.PathEscape(key) + "?fields=retentionExpirationTime"
+	return o.RetentionExpirationTime, l.get(ctx, u, &o)
+}
+
+func (l *gcsLocker) get(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
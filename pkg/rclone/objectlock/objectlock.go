@@ -0,0 +1,126 @@
+// Copyright (C) 2017 ScyllaDB
+
+// Package objectlock makes objects immutable so that they can't be deleted
+// or overwritten before a retention date.
+//
+// S3 objects are locked one by one with object lock retention, the bucket
+// must have object lock enabled. GCS and Azure do not support retention
+// of individual objects, objects are protected by bucket retention policy
+// (GCS) or container immutability policy (Azure) and locking only checks that
+// the policy is in place.
+package objectlock
+
+import (
+	"context"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+)
+
+// Retention modes of S3 object lock, in governance mode users with special
+// permissions can remove the lock, in compliance mode no one can.
+const (
+	ModeGovernance = "GOVERNANCE"
+	ModeCompliance = "COMPLIANCE"
+)
+
+// Retention specifies how long objects are immutable.
+type Retention struct {
+	Mode        string
+	RetainUntil time.Time
+}
+
+// ErrNotEnabled is returned if objects in the bucket can't be locked.
+var ErrNotEnabled = errors.New("object lock is not enabled")
+
+// locker is implemented by providers supporting object lock.
+type locker interface {
+	// Check returns ErrNotEnabled if objects in the bucket can't be locked.
+	Check(ctx context.Context) error
+	// Lock makes the object immutable at least until r.RetainUntil,
+	// retention of the object is never shortened.
+	Lock(ctx context.Context, key string, r Retention) error
+	// Locked returns true if the object is immutable until t.
+	Locked(ctx context.Context, key string, t time.Time) (bool, error)
+}
+
+func newLocker(ctx context.Context, f fs.Fs) (locker, error) {
+	bucket, _ := backendclient.SplitRoot(f.Root())
+	backend, _ := fs.ConfigFileGet(f.Name(), "type")
+	switch backend {
+	case "s3":
+		return newS3Locker(ctx, f.Name(), bucket)
+	case "gcs":
+		return newGCSLocker(ctx, f.Name(), bucket)
+	case "azureblob":
+		return newAzureLocker(ctx, f.Name(), bucket)
+	default:
+		return nil, errors.Errorf("object lock is not supported by %s provider", f.Name())
+	}
+}
+
+// Lock makes files in dir of f immutable until r.RetainUntil. Files locked
+// for a longer time are not changed.
+func Lock(ctx context.Context, f fs.Fs, dir string, files []string, r Retention) error {
+	if r.Mode == "" {
+		r.Mode = ModeGovernance
+	}
+	if r.Mode != ModeGovernance && r.Mode != ModeCompliance {
+		return errors.Errorf("unsupported retention mode %s", r.Mode)
+	}
+
+	l, err := newLocker(ctx, f)
+	if err != nil {
+		return err
+	}
+	if err := l.Check(ctx); err != nil {
+		return errors.Wrap(err, f.Name())
+	}
+
+	_, prefix := backendclient.SplitRoot(f.Root())
+	return parallel.Run(len(files), fs.GetConfig(ctx).Checkers, func(i int) error {
+		key := path.Join(prefix, dir, files[i])
+		return errors.Wrapf(l.Lock(ctx, key, r), "lock %s", files[i])
+	})
+}
+
+// Unlocked returns files in dir of f that can be deleted or overwritten
+// before until.
+func Unlocked(ctx context.Context, f fs.Fs, dir string, files []string, until time.Time) ([]string, error) {
+	l, err := newLocker(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.Check(ctx); err != nil {
+		if errors.Is(err, ErrNotEnabled) {
+			return files, nil
+		}
+		return nil, errors.Wrap(err, f.Name())
+	}
+
+	var (
+		unlocked []string
+		mu       sync.Mutex
+	)
+	_, prefix := backendclient.SplitRoot(f.Root())
+	err = parallel.Run(len(files), fs.GetConfig(ctx).Checkers, func(i int) error {
+		ok, err := l.Locked(ctx, path.Join(prefix, dir, files[i]), until)
+		if err != nil {
+			return errors.Wrapf(err, "check %s", files[i])
+		}
+		if !ok {
+			mu.Lock()
+			unlocked = append(unlocked, files[i])
+			mu.Unlock()
+		}
+		return nil
+	})
+	sort.Strings(unlocked)
+	return unlocked, err
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2017 ScyllaDB
+
+package objectlock
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	s3backend "github.com/rclone/rclone/backend/s3"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+)
+
+// s3ErrCodeNoRetention is returned when getting retention of an object that
+// has no retention set.
+const s3ErrCodeNoRetention = "NoSuchObjectLockConfiguration"
+
+type s3Locker struct {
+	bucket string
+	c      *s3.S3
+}
+
+var _ locker = &s3Locker{}
+
+func newS3Locker(ctx context.Context, name, bucket string) (*s3Locker, error) {
+	var opt s3backend.Options
+	if err := backendclient.Options(name, &opt); err != nil {
+		return nil, err
+	}
+	c, err := backendclient.S3(ctx, opt, 3)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Locker{bucket: bucket, c: c}, nil
+}
+
+func (l *s3Locker) Check(ctx context.Context) error {
+	out, err := l.c.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(l.bucket),
+	})
+	if isAWSErrCode(err, "ObjectLockConfigurationNotFoundError") {
+		return errors.Wrapf(ErrNotEnabled, "bucket %s", l.bucket)
+	}
+	if err != nil {
+		return err
+	}
+	if out.ObjectLockConfiguration == nil || aws.StringValue(out.ObjectLockConfiguration.ObjectLockEnabled) != s3.ObjectLockEnabledEnabled {
+		return errors.Wrapf(ErrNotEnabled, "bucket %s", l.bucket)
+	}
+	return nil
+}
+
+func (l *s3Locker) Lock(ctx context.Context, key string, r Retention) error {
+	cur, err := l.retention(ctx, key)
+	if err != nil {
+		return err
+	}
+	if cur != nil {
+		if !aws.TimeValue(cur.RetainUntilDate).Before(r.RetainUntil) {
+			return nil
+		}
+		// Compliance mode can't be changed to governance mode
+		if aws.StringValue(cur.Mode) == ModeCompliance {
+			r.Mode = ModeCompliance
+		}
+	}
+
+	_, err = l.c.PutObjectRetentionWithContext(ctx, &s3.PutObjectRetentionInput{
+		Bucket: aws.String(l.bucket),
+		Key:    aws.String(key),
+		Retention: &s3.ObjectLockRetention{
+			Mode:            aws.String(r.Mode),
+			RetainUntilDate: aws.Time(r.RetainUntil),
+		},
+	})
+	return err
+}
+
+func (l *s3Locker) Locked(ctx context.Context, key string, t time.Time) (bool, error) {
+	cur, err := l.retention(ctx, key)
+	if err != nil || cur == nil {
+		return false, err
+	}
+	return !aws.TimeValue(cur.RetainUntilDate).Before(t), nil
+}
+
+// retention returns retention of the object or nil if object is not locked.
+func (l *s3Locker) retention(ctx context.Context, key string) (*s3.ObjectLockRetention, error) {
+	out, err := l.c.GetObjectRetentionWithContext(ctx, &s3.GetObjectRetentionInput{
+		Bucket: aws.String(l.bucket),
+		Key:    aws.String(key),
+	})
+	if isAWSErrCode(err, s3ErrCodeNoRetention) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Retention, nil
+}
+
+func isAWSErrCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
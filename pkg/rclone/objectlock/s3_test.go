@@ -0,0 +1,165 @@
+// Copyright (C) 2017 ScyllaDB
+
+package objectlock
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	s3backend "github.com/rclone/rclone/backend/s3"
+	"github.com/scylladb/scylla-manager/pkg/rclone/backendclient"
+)
+
+type s3Retention struct {
+	XMLName         xml.Name  `xml:"Retention"`
+	Mode            string    `xml:"Mode"`
+	RetainUntilDate time.Time `xml:"RetainUntilDate"`
+}
+
+// fakeS3 implements object lock configuration and object retention calls.
+type fakeS3 struct {
+	enabled   bool
+	mu        sync.Mutex
+	retention map[string]s3Retention
+	puts      int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var (
+		key = strings.TrimPrefix(r.URL.Path, "/bucket/")
+		q   = r.URL.Query()
+	)
+	_, lock := q["object-lock"]
+	_, retention := q["retention"]
+
+	switch {
+	case lock:
+		if !f.enabled {
+			writeS3Error(w, http.StatusNotFound, "ObjectLockConfigurationNotFoundError")
+			return
+		}
+		fmt.Fprint(w, "<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>")
+	case retention && r.Method == http.MethodGet:
+		v, ok := f.retention[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, s3ErrCodeNoRetention)
+			return
+		}
+		xml.NewEncoder(w).Encode(v) // nolint: errcheck
+	case retention && r.Method == http.MethodPut:
+		var v s3Retention
+		if err := xml.NewDecoder(r.Body).Decode(&v); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		f.retention[key] = v
+		f.puts++
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func newTestS3Locker(t *testing.T, f *fakeS3) *s3Locker {
+	t.Helper()
+
+	// Custom CA bundle can't be loaded into rclone HTTP client
+	if v, ok := os.LookupEnv("AWS_CA_BUNDLE"); ok {
+		os.Unsetenv("AWS_CA_BUNDLE")
+		t.Cleanup(func() { os.Setenv("AWS_CA_BUNDLE", v) })
+	}
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	opt := s3backend.Options{
+		Endpoint:        srv.URL,
+		ForcePathStyle:  true,
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	}
+	c, err := backendclient.S3(context.Background(), opt, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &s3Locker{bucket: "bucket", c: c}
+}
+
+func TestS3LockerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	l := newTestS3Locker(t, &fakeS3{enabled: false})
+	if err := l.Check(ctx); !errors.Is(err, ErrNotEnabled) {
+		t.Fatalf("Check() error %v, expected %v", err, ErrNotEnabled)
+	}
+
+	l = newTestS3Locker(t, &fakeS3{enabled: true})
+	if err := l.Check(ctx); err != nil {
+		t.Fatalf("Check() error %s", err)
+	}
+}
+
+func TestS3LockerLock(t *testing.T) {
+	var (
+		ctx = context.Background()
+		now = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		day = 24 * time.Hour
+		r   = Retention{Mode: ModeGovernance, RetainUntil: now.Add(10 * day)}
+	)
+
+	f := &fakeS3{
+		enabled: true,
+		retention: map[string]s3Retention{
+			"longer":     {Mode: ModeGovernance, RetainUntilDate: now.Add(20 * day)},
+			"compliance": {Mode: ModeCompliance, RetainUntilDate: now.Add(day)},
+		},
+	}
+	l := newTestS3Locker(t, f)
+
+	for _, key := range []string{"new", "longer", "compliance"} {
+		if err := l.Lock(ctx, key, r); err != nil {
+			t.Fatalf("Lock(%s) error %s", key, err)
+		}
+	}
+
+	if f.puts != 2 {
+		t.Errorf("Retention set %d times, expected 2", f.puts)
+	}
+	golden := map[string]s3Retention{
+		"new":        {Mode: ModeGovernance, RetainUntilDate: now.Add(10 * day)},
+		"longer":     {Mode: ModeGovernance, RetainUntilDate: now.Add(20 * day)},
+		"compliance": {Mode: ModeCompliance, RetainUntilDate: now.Add(10 * day)},
+	}
+	for key, g := range golden {
+		v := f.retention[key]
+		if v.Mode != g.Mode || !v.RetainUntilDate.Equal(g.RetainUntilDate) {
+			t.Errorf("%s retention %+v, expected %+v", key, v, g)
+		}
+	}
+
+	for key, locked := range map[string]bool{"new": true, "longer": true, "missing": false} {
+		ok, err := l.Locked(ctx, key, now.Add(10*day))
+		if err != nil {
+			t.Fatalf("Locked(%s) error %s", key, err)
+		}
+		if ok != locked {
+			t.Errorf("Locked(%s) = %v, expected %v", key, ok, locked)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -117,7 +118,26 @@ func CheckPermissions(ctx context.Context, l fs.Fs) error {
 		if err := operations.Delete(ctx, f); err != nil {
 			return PermissionError{err, "delete"}
 		}
+		// Directories are not removed with files on non bucket based remotes
+		// i.e. SFTP.
+		if !f.Features().BucketBased {
+			if err := operations.Rmdirs(ctx, f, "", false); err != nil {
+				return PermissionError{err, "delete"}
+			}
+		}
 	}
 
 	return nil
 }
+
+// RmdirParents removes dir and its parent directories up to the root of f
+// as long as they are empty. Errors are ignored as non-empty directories can
+// not be removed.
+func RmdirParents(ctx context.Context, f fs.Fs, dir string) {
+	for dir != "" && dir != "." && dir != "/" {
+		if err := f.Rmdir(ctx, dir); err != nil {
+			return
+		}
+		dir = path.Dir(dir)
+	}
+}
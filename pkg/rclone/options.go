@@ -172,3 +172,17 @@ func (o *AzureOptions) AutoFill() {
 		o.UseMsi = _true
 	}
 }
+
+func DefaultSFTPOptions() SFTPOptions {
+	return SFTPOptions{
+		// Agent runs as a service and can't prompt for a password.
+		AskPassword: _false,
+		KeyUseAgent: _false,
+	}
+}
+
+func DefaultWebDAVOptions() WebDAVOptions {
+	return WebDAVOptions{
+		Vendor: "other",
+	}
+}
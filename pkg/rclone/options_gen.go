@@ -526,3 +526,126 @@ type S3Options struct {
 	//
 	DisableHttp2 string `yaml:"disable_http2"`
 }
+
+// SFTPOptions is a clone rclone file system Options designed for inclusion
+// in Scylla Manager Agent config, and YAML parsing.
+type SFTPOptions struct {
+	// SSH host to connect to
+	Host string `yaml:"host"`
+	// SSH username, leave blank for current username, root
+	User string `yaml:"user"`
+	// SSH port, leave blank to use default (22)
+	Port string `yaml:"port"`
+	// SSH password, leave blank to use ssh-agent.
+	Pass string `yaml:"pass"`
+	// Raw PEM-encoded private key, If specified, will override key_file parameter.
+	KeyPem string `yaml:"key_pem"`
+	// Path to PEM-encoded private key file, leave blank or set key-use-agent to use ssh-agent.
+	//
+	// Leading `~` will be expanded in the file name as will environment variables such as `${RCLONE_CONFIG_DIR}`.
+	//
+	KeyFile string `yaml:"key_file"`
+	// The passphrase to decrypt the PEM-encoded private key file.
+	//
+	// Only PEM encrypted key files (old OpenSSH format) are supported. Encrypted keys
+	// in the new OpenSSH format can't be used.
+	KeyFilePass string `yaml:"key_file_pass"`
+	// Optional path to public key file.
+	//
+	// Set this if you have a signed certificate you want to use for authentication.
+	//
+	// Leading `~` will be expanded in the file name as will environment variables such as `${RCLONE_CONFIG_DIR}`.
+	//
+	PubkeyFile string `yaml:"pubkey_file"`
+	// Optional path to known_hosts file.
+	//
+	// Set this value to enable server host key validation.
+	//
+	// Leading `~` will be expanded in the file name as will environment variables such as `${RCLONE_CONFIG_DIR}`.
+	//
+	KnownHostsFile string `yaml:"known_hosts_file"`
+	// When set forces the usage of the ssh-agent.
+	//
+	// When key-file is also set, the ".pub" file of the specified key-file is read and only the associated key is
+	// requested from the ssh-agent. This allows to avoid `Too many authentication failures for *username*` errors
+	// when the ssh-agent contains many keys.
+	KeyUseAgent string `yaml:"key_use_agent"`
+	// Enable the use of insecure ciphers and key exchange methods.
+	//
+	// This enables the use of the following insecure ciphers and key exchange methods:
+	//
+	// - aes128-cbc
+	// - aes192-cbc
+	// - aes256-cbc
+	// - 3des-cbc
+	// - diffie-hellman-group-exchange-sha256
+	// - diffie-hellman-group-exchange-sha1
+	//
+	// Those algorithms are insecure and may allow plaintext data to be recovered by an attacker.
+	UseInsecureCipher string `yaml:"use_insecure_cipher"`
+	// Disable the execution of SSH commands to determine if remote file hashing is available.
+	// Leave blank or set to false to enable hashing (recommended), set to true to disable hashing.
+	DisableHashcheck string `yaml:"disable_hashcheck"`
+	// Allow asking for SFTP password when needed.
+	//
+	// If this is set and no password is supplied then rclone will:
+	// - ask for a password
+	// - not contact the ssh agent
+	//
+	AskPassword string `yaml:"ask_password"`
+	// Override path used by SSH connection.
+	//
+	// This allows checksum calculation when SFTP and SSH paths are
+	// different. This issue affects among others Synology NAS boxes.
+	//
+	// Shared folders can be found in directories representing volumes
+	//
+	//     rclone sync /home/local/directory remote:/directory --ssh-path-override /volume2/directory
+	//
+	// Home directory can be found in a shared folder called "home"
+	//
+	//     rclone sync /home/local/directory remote:/home/directory --ssh-path-override /volume1/homes/USER/directory
+	PathOverride string `yaml:"path_override"`
+	// Set the modified time on the remote if set.
+	SetModtime string `yaml:"set_modtime"`
+	// The command used to read md5 hashes. Leave blank for autodetect.
+	Md5sumCommand string `yaml:"md5sum_command"`
+	// The command used to read sha1 hashes. Leave blank for autodetect.
+	Sha1sumCommand string `yaml:"sha1sum_command"`
+	// Set to skip any symlinks and any other non regular files.
+	SkipLinks string `yaml:"skip_links"`
+	// Specifies the SSH2 subsystem on the remote host.
+	Subsystem string `yaml:"subsystem"`
+	// Specifies the path or command to run a sftp server on the remote host.
+	//
+	// The subsystem option is ignored when server_command is defined.
+	ServerCommand string `yaml:"server_command"`
+	// If set use fstat instead of stat
+	//
+	// Some servers limit the amount of open files and calling Stat after opening
+	// the file will throw an error from the server. Setting this flag will call
+	// Fstat instead of Stat which is called on an already open file handle.
+	//
+	// It has been found that this helps with IBM Sterling SFTP servers which have
+	// "extractability" level set to 1 which means only 1 file can be opened at
+	// any given time.
+	//
+	UseFstat string `yaml:"use_fstat"`
+}
+
+// WebDAVOptions is a clone rclone file system Options designed for inclusion
+// in Scylla Manager Agent config, and YAML parsing.
+type WebDAVOptions struct {
+	// URL of http host to connect to
+	Url string `yaml:"url"`
+	// Name of the Webdav site/service/software you are using
+	Vendor string `yaml:"vendor"`
+	// User name
+	User string `yaml:"user"`
+	// Password.
+	Pass string `yaml:"pass"`
+	// Bearer token instead of user/pass (e.g. a Macaroon)
+	BearerToken string `yaml:"bearer_token"`
+	// Command to run to get a bearer token
+	BearerTokenCommand string `yaml:"bearer_token_command"`
+}
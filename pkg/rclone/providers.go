@@ -9,6 +9,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/cache"
+	"github.com/rclone/rclone/fs/config/obscure"
 	"github.com/scylladb/go-reflectx"
 	"github.com/scylladb/go-set/strset"
 	"github.com/scylladb/scylla-manager/pkg/rclone/backend/localdir"
@@ -41,6 +43,24 @@ func MustRegisterLocalDirProvider(name, description, rootDir string) {
 	}
 }
 
+// RegisterFileProvider must be called before server is started.
+// It allows for adding file provider named file that stores data in rootDir,
+// typically a shared file system like NFS mounted on all the nodes.
+// Provider is not registered if rootDir is empty.
+func RegisterFileProvider(rootDir string) error {
+	const name = "file"
+
+	if rootDir == "" {
+		return nil
+	}
+	if _, err := os.Stat(rootDir); err != nil {
+		return errors.Wrapf(err, "register file provider %s", rootDir)
+	}
+	localdir.InitStrict(name, "Shared file system", rootDir)
+
+	return errors.Wrap(registerProvider(name, name, LocalOptions{}), "register provider")
+}
+
 // RegisterS3Provider must be called before server is started.
 // It allows for adding dynamically adding s3 provider named s3.
 func RegisterS3Provider(opts S3Options) error {
@@ -96,6 +116,59 @@ func RegisterAzureProvider(opts AzureOptions) error {
 	return errors.Wrap(registerProvider(name, backend, opts), "register provider")
 }
 
+// RegisterSFTPProvider must be called before server is started.
+// It allows for adding dynamically adding sftp provider named sftp.
+func RegisterSFTPProvider(opts SFTPOptions) error {
+	const (
+		name    = "sftp"
+		backend = "sftp"
+	)
+
+	if err := obscurePasswords(&opts.Pass, &opts.KeyFilePass); err != nil {
+		return errors.Wrap(err, "register provider")
+	}
+
+	return errors.Wrap(registerProvider(name, backend, opts), "register provider")
+}
+
+// RegisterWebDAVProvider must be called before server is started.
+// It allows for adding dynamically adding webdav provider named webdav.
+func RegisterWebDAVProvider(opts WebDAVOptions) error {
+	const (
+		name    = "webdav"
+		backend = "webdav"
+	)
+
+	if err := obscurePasswords(&opts.Pass); err != nil {
+		return errors.Wrap(err, "register provider")
+	}
+
+	return errors.Wrap(registerProvider(name, backend, opts), "register provider")
+}
+
+// obscurePasswords replaces plain text passwords with the obscured form
+// expected by rclone backends.
+func obscurePasswords(passwords ...*string) error {
+	for _, p := range passwords {
+		if *p == "" {
+			continue
+		}
+		v, err := obscure.Obscure(*p)
+		if err != nil {
+			return errors.Wrap(err, "obscure password")
+		}
+		*p = v
+	}
+	return nil
+}
+
+// ClearFsCache removes the cached remote file systems so that they are
+// created with the current provider configuration on next use. Running
+// operations keep using the file systems they were started with.
+func ClearFsCache() {
+	cache.Clear()
+}
+
 func registerProvider(name, backend string, options interface{}) error {
 	var (
 		m     = reflectx.NewMapper("yaml").FieldMap(reflect.ValueOf(options))
@@ -106,15 +179,18 @@ func registerProvider(name, backend string, options interface{}) error {
 	// Set type
 	errs = multierr.Append(errs, fs.ConfigFileSet(name, "type", backend))
 
-	// Set and log options
+	// Set and log options, empty options are removed as the provider may be
+	// registered again on config reload
 	for key, rval := range m {
-		if s := rval.String(); s != "" {
-			errs = multierr.Append(errs, fs.ConfigFileSet(name, key, s))
-			if strings.Contains(key, "secret") || strings.Contains(key, "key") {
-				extra = append(extra, key+"="+strings.Repeat("*", len(s)))
-			} else {
-				extra = append(extra, key+"="+s)
-			}
+		s := rval.String()
+		errs = multierr.Append(errs, fs.ConfigFileSet(name, key, s))
+		if s == "" {
+			continue
+		}
+		if isSecretOption(key) {
+			extra = append(extra, key+"="+strings.Repeat("*", len(s)))
+		} else {
+			extra = append(extra, key+"="+s)
 		}
 	}
 
@@ -128,3 +204,12 @@ func registerProvider(name, backend string, options interface{}) error {
 
 	return nil
 }
+
+func isSecretOption(key string) bool {
+	for _, s := range []string{"secret", "key", "pass", "token"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/obscure"
 )
 
 func TestRegisterS3ProviderSetsS3Options(t *testing.T) {
@@ -51,3 +52,80 @@ func TestRegisterS3ProviderSetsS3Options(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisterS3ProviderAgainRemovesOptions(t *testing.T) {
+	InitFsConfig()
+
+	opts := S3Options{
+		AccessKeyID:     "a",
+		SecretAccessKey: "b",
+		Region:          "c",
+		Provider:        "Minio",
+	}
+	if err := RegisterS3Provider(opts); err != nil {
+		t.Fatal("RegisterS3Provider() error", err)
+	}
+
+	opts.AccessKeyID = ""
+	opts.SecretAccessKey = ""
+	if err := RegisterS3Provider(opts); err != nil {
+		t.Fatal("RegisterS3Provider() error", err)
+	}
+
+	for _, k := range []string{"access_key_id", "secret_access_key"} {
+		if v, ok := fs.ConfigFileGet("s3", k); ok {
+			t.Errorf("ConfigFileGet(%s) = %s, expected option to be removed", k, v)
+		}
+	}
+	if v, _ := fs.ConfigFileGet("s3", "region"); v != "c" {
+		t.Errorf("ConfigFileGet(region) = %s, expected c", v)
+	}
+}
+
+func TestRegisterSFTPProviderObscuresPasswords(t *testing.T) {
+	InitFsConfig()
+
+	opts := SFTPOptions{
+		Host:        "a",
+		User:        "b",
+		Pass:        "c",
+		KeyFilePass: "d",
+	}
+
+	if err := RegisterSFTPProvider(opts); err != nil {
+		t.Fatal("RegisterSFTPProvider() error", err)
+	}
+
+	if v, _ := fs.ConfigFileGet("sftp", "host"); v != "a" {
+		t.Errorf("ConfigFileGet(host) = %s, expected a", v)
+	}
+	for k, expected := range map[string]string{"pass": "c", "key_file_pass": "d"} {
+		v, _ := fs.ConfigFileGet("sftp", k)
+		if v == expected {
+			t.Errorf("ConfigFileGet(%s) = %s, expected obscured value", k, v)
+		}
+		if r, err := obscure.Reveal(v); err != nil || r != expected {
+			t.Errorf("Reveal(%s) = %s, %v expected %s", k, r, err, expected)
+		}
+	}
+}
+
+func TestRegisterFileProvider(t *testing.T) {
+	InitFsConfig()
+
+	if err := RegisterFileProvider(""); err != nil {
+		t.Fatal("RegisterFileProvider() error", err)
+	}
+	if HasProvider("file") {
+		t.Fatal("HasProvider() = true, expected false for empty root")
+	}
+	if err := RegisterFileProvider(t.TempDir() + "/not-existing"); err == nil {
+		t.Fatal("RegisterFileProvider() expected error")
+	}
+	if err := RegisterFileProvider(t.TempDir()); err != nil {
+		t.Fatal("RegisterFileProvider() error", err)
+	}
+	if !HasProvider("file") {
+		t.Fatal("HasProvider() = false, expected true")
+	}
+}
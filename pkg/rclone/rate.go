@@ -10,10 +10,19 @@ import (
 // SetRateLimit sets the global rate limit to a given amount of MiB per second.
 // Set to 0 to for full throttle.
 func SetRateLimit(mib int) {
+	SetRateLimits(mib, mib)
+}
+
+// SetRateLimits sets the global upload and download rate limits to a given
+// amount of MiB per second.
+// Set to 0 to for full throttle in a given direction.
+func SetRateLimits(uploadMiB, downloadMiB int) {
 	var bw fs.BwPair
-	if mib > 0 {
-		bw.Tx = fs.SizeSuffix(mib) * fs.MebiByte
-		bw.Rx = fs.SizeSuffix(mib) * fs.MebiByte
+	if uploadMiB > 0 {
+		bw.Tx = fs.SizeSuffix(uploadMiB) * fs.MebiByte
+	}
+	if downloadMiB > 0 {
+		bw.Rx = fs.SizeSuffix(downloadMiB) * fs.MebiByte
 	}
 	accounting.TokenBucket.SetBwLimit(bw)
 }
@@ -19,6 +19,8 @@ var RcloneSupportedCalls = strset.New(
 	"operations/deletefile",
 	"operations/fileinfo",
 	"operations/list",
+	"operations/lock",
+	"operations/lock-status",
 	"operations/movefile",
 	"operations/purge",
 	"sync/copydir",
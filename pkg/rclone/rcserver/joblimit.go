@@ -0,0 +1,43 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rcserver
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// jobLimiters holds bandwidth limiters of running jobs indexed by stats group
+// name i.e. "job/<jobid>".
+type jobLimiters struct {
+	mu sync.Mutex
+	m  map[string]*rate.Limiter
+}
+
+func newJobLimiters() *jobLimiters {
+	return &jobLimiters{
+		m: make(map[string]*rate.Limiter),
+	}
+}
+
+func (j *jobLimiters) Add(group string, l *rate.Limiter) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.m[group] = l
+}
+
+func (j *jobLimiters) Remove(group string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.m, group)
+}
+
+func (j *jobLimiters) Get(group string) (*rate.Limiter, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	l, ok := j.m[group]
+	return l, ok
+}
+
+var runningJobLimiters = newJobLimiters()
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"sort"
 	"strings"
 	"time"
@@ -21,10 +22,13 @@ import (
 	"github.com/rclone/rclone/fs/rc/jobs"
 	"github.com/rclone/rclone/fs/sync"
 	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/multipart"
+	"github.com/scylladb/scylla-manager/pkg/rclone/objectlock"
 	"github.com/scylladb/scylla-manager/pkg/rclone/operations"
 	"github.com/scylladb/scylla-manager/pkg/rclone/rcserver/internal"
 	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 	"go.uber.org/multierr"
+	"golang.org/x/time/rate"
 )
 
 // rcJobInfo aggregates core, transferred, and job stats into a single call.
@@ -516,11 +520,125 @@ func init() {
 	})
 }
 
+// objectLockParams returns fs, directory, files and retention based on
+// "fs", "remote", "files", "mode" and "retain_until" parameters.
+func objectLockParams(ctx context.Context, in rc.Params) (fs.Fs, string, []string, objectlock.Retention, error) {
+	var r objectlock.Retention
+
+	f, remote, err := getFsAndRemoteNamed(ctx, in, "fs", "remote")
+	if err != nil {
+		return nil, "", nil, r, err
+	}
+	var files []string
+	if err := in.GetStruct("files", &files); err != nil {
+		return nil, "", nil, r, err
+	}
+	r.Mode, err = in.GetString("mode")
+	if rc.NotErrParamNotFound(err) {
+		return nil, "", nil, r, err
+	}
+	s, err := in.GetString("retain_until")
+	if err != nil {
+		return nil, "", nil, r, err
+	}
+	r.RetainUntil, err = time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, "", nil, r, errParamInvalid{errors.Wrap(err, "bad retain_until")}
+	}
+	return f, remote, files, r, nil
+}
+
+// rcLock makes files immutable until the retention date.
+func rcLock(ctx context.Context, in rc.Params) (out rc.Params, err error) {
+	f, remote, files, r, err := objectLockParams(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := objectlock.Lock(ctx, f, remote, files, r); err != nil {
+		return nil, err
+	}
+	fs.Infof(nil, "Locked %d files in %s until %s", len(files), remote, r.RetainUntil.Format(time.RFC3339))
+	return nil, nil
+}
+
+// rcLockStatus returns files that are not immutable until the retention date.
+func rcLockStatus(ctx context.Context, in rc.Params) (out rc.Params, err error) {
+	f, remote, files, r, err := objectLockParams(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	unlocked, err := objectlock.Unlocked(ctx, f, remote, files, r.RetainUntil)
+	if err != nil {
+		return nil, err
+	}
+	if unlocked == nil {
+		unlocked = []string{}
+	}
+	return rc.Params{"unlocked": unlocked}, nil
+}
+
+func init() {
+	rc.Add(rc.Call{
+		Path:         "operations/lock",
+		AuthRequired: true,
+		Fn:           rcLock,
+		Title:        "Make files immutable until the retention date",
+		Help: `This takes the following parameters:
+
+- fs - a remote name string eg "s3:bucket"
+- remote - a directory path within that remote
+- files - names of files in the directory
+- mode - optional S3 retention mode GOVERNANCE (default) or COMPLIANCE
+- retain_until - time in RFC3339 format`,
+	})
+
+	rc.Add(rc.Call{
+		Path:         "operations/lock-status",
+		AuthRequired: true,
+		Fn:           rcLockStatus,
+		Title:        "Get files that are not immutable until the retention date",
+		Help: `This takes the following parameters:
+
+- fs - a remote name string eg "s3:bucket"
+- remote - a directory path within that remote
+- files - names of files in the directory
+- retain_until - time in RFC3339 format
+
+Returns
+
+- unlocked - names of files that can be deleted before retain_until`,
+	})
+}
+
 func init() {
 	c := rc.Calls.Get("operations/movefile")
 	c.Fn = wrap(c.Fn, sameDir())
 }
 
+// rcDeleteFile wraps operations/deletefile, on non bucket based remotes it
+// also removes directories that become empty.
+func rcDeleteFile(fn rc.Func) rc.Func {
+	return func(ctx context.Context, in rc.Params) (rc.Params, error) {
+		out, err := fn(ctx, in)
+		if err != nil {
+			return out, err
+		}
+		f, remote, err := rc.GetFsAndRemote(ctx, in)
+		if err != nil {
+			return out, err
+		}
+		if !f.Features().BucketBased {
+			operations.RmdirParents(ctx, f, path.Dir(remote))
+		}
+		return out, nil
+	}
+}
+
+func init() {
+	c := rc.Calls.Get("operations/deletefile")
+	c.Fn = rcDeleteFile(c.Fn)
+}
+
 // rcChunkedList supports streaming output of the listing.
 func rcChunkedList(ctx context.Context, in rc.Params) (out rc.Params, err error) {
 	f, remote, err := rc.GetFsAndRemote(ctx, in)
@@ -574,10 +692,81 @@ func rcMoveOrCopyDir(doMove bool) func(ctx context.Context, in rc.Params) (rc.Pa
 			return nil, err
 		}
 
+		// Source fs is wrapped only if job limit is set as the wrapper hides
+		// server side move and copy of the backend
+		l, err := jobBandwidthLimiter(in)
+		if err != nil {
+			return nil, err
+		}
+		if l != nil {
+			if group, ok := accounting.StatsGroupFromContext(ctx); ok {
+				runningJobLimiters.Add(group, l)
+				defer runningJobLimiters.Remove(group)
+			}
+			srcFs = rclone.LimitedFs(ctx, srcFs, l)
+		}
+
+		uo, err := uploadOptions(in)
+		if err != nil {
+			return nil, err
+		}
+		ctx, dstFs, err = uo.Apply(ctx, dstFs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Large files are uploaded first so that upload can be resumed
+		if err := multipart.UploadDir(ctx, dstFs, dstRemote, srcFs, srcRemote); err != nil {
+			return nil, err
+		}
+
 		return nil, sync.CopyDir2(ctx, dstFs, dstRemote, srcFs, srcRemote, doMove)
 	}
 }
 
+// uploadOptions returns upload options based on optional "storage_class"
+// and "metadata" parameters.
+func uploadOptions(in rc.Params) (rclone.UploadOptions, error) {
+	var o rclone.UploadOptions
+
+	sc, err := in.GetString("storage_class")
+	if rc.NotErrParamNotFound(err) {
+		return o, err
+	}
+	o.StorageClass = sc
+
+	if err := in.GetStruct("metadata", &o.Metadata); rc.NotErrParamNotFound(err) {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// jobBandwidthLimiter returns limiter of the job based on optional "bwlimit"
+// parameter, it returns nil if it's not set.
+func jobBandwidthLimiter(in rc.Params) (*rate.Limiter, error) {
+	bwlimit, err := in.GetString("bwlimit")
+	if rc.IsErrParamNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	bw, err := parseJobBandwidth(bwlimit)
+	if err != nil {
+		return nil, err
+	}
+	return rclone.NewBandwidthLimiter(bw), nil
+}
+
+func parseJobBandwidth(s string) (int64, error) {
+	var bw fs.SizeSuffix
+	if err := bw.Set(s); err != nil {
+		return 0, errParamInvalid{errors.Wrap(err, "bad bwlimit")}
+	}
+	return int64(bw), nil
+}
+
 // getFsAndRemoteNamed gets fs and remote path from the params, but it doesn't
 // fail if remote path is not provided.
 // In that case it is assumed that path is empty and root of the fs is used.
@@ -601,7 +790,10 @@ func init() {
 - srcFs - a remote name string eg "drive:" for the source
 - srcRemote - a directory path within that remote for the source
 - dstFs - a remote name string eg "drive2:" for the destination
-- dstRemote - a directory path within that remote for the destination`,
+- dstRemote - a directory path within that remote for the destination
+- bwlimit - optional bandwidth limit of the job eg "10M", it can be changed with core/bwlimit only if it's set, use "0" for no limit
+- storage_class - optional storage class or access tier of uploaded objects eg "STANDARD_IA"
+- metadata - optional map of metadata and tags set on uploaded objects`,
 	})
 
 	rc.Add(rc.Call{
@@ -614,10 +806,57 @@ func init() {
 - srcFs - a remote name string eg "drive:" for the source
 - srcRemote - a directory path within that remote for the source
 - dstFs - a remote name string eg "drive2:" for the destination
-- dstRemote - a directory path within that remote for the destination`,
+- dstRemote - a directory path within that remote for the destination
+- bwlimit - optional bandwidth limit of the job eg "10M", it can be changed with core/bwlimit only if it's set, use "0" for no limit
+- storage_class - optional storage class or access tier of uploaded objects eg "STANDARD_IA"
+- metadata - optional map of metadata and tags set on uploaded objects`,
 	})
 }
 
+// rcBwlimit extends core/bwlimit with optional jobid parameter that allows
+// for setting bandwidth limit of a running copy or move dir job started with
+// bwlimit parameter.
+// If jobid is not set the global limit is set by the original call.
+func rcBwlimit(fn rc.Func) rc.Func {
+	return func(ctx context.Context, in rc.Params) (rc.Params, error) {
+		jobid, err := in.GetInt64("jobid")
+		if rc.IsErrParamNotFound(err) {
+			return fn(ctx, in)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		l, ok := runningJobLimiters.Get(fmt.Sprintf("job/%d", jobid))
+		if !ok {
+			return nil, errJobNotFound
+		}
+		if in["rate"] != nil {
+			r, err := in.GetString("rate")
+			if err != nil {
+				return nil, err
+			}
+			bw, err := parseJobBandwidth(r)
+			if err != nil {
+				return nil, err
+			}
+			rclone.SetBandwidthLimit(l, bw)
+			fs.Infof(nil, "Job %d bandwidth limit set to %s", jobid, r)
+		}
+
+		bytesPerSecond := rclone.BandwidthLimitOf(l)
+		return rc.Params{
+			"rate":           fs.SizeSuffix(bytesPerSecond).String(),
+			"bytesPerSecond": bytesPerSecond,
+		}, nil
+	}
+}
+
+func init() {
+	c := rc.Calls.Get("core/bwlimit")
+	c.Fn = rcBwlimit(c.Fn)
+}
+
 // rcCalls contains the original rc.Calls before filtering with all the added
 // custom calls in this file.
 var rcCalls *rc.Registry
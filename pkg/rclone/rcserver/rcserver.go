@@ -7,6 +7,7 @@ package rcserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,6 +24,7 @@ import (
 	"github.com/rclone/rclone/fs/rc"
 	"github.com/rclone/rclone/fs/rc/jobs"
 	"github.com/scylladb/scylla-manager/pkg/rclone"
+	"github.com/scylladb/scylla-manager/pkg/rclone/objectlock"
 	"github.com/scylladb/scylla-manager/pkg/rclone/operations"
 	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 )
@@ -127,7 +129,8 @@ func isBadRequestErr(err error) bool {
 		cause == fs.ErrorNotAFile ||
 		cause == fs.ErrorDirectoryNotEmpty ||
 		cause == fs.ErrorDirExists ||
-		cause == fs.ErrorListBucketRequired
+		cause == fs.ErrorListBucketRequired ||
+		cause == objectlock.ErrNotEnabled
 }
 
 func isCheckErr(err error) bool {
@@ -251,11 +254,17 @@ func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Config options are not changed while the call is running
+	done := rclone.StartOperation()
 	if isAsync {
-		out, err = jobs.StartAsyncJob(fn, inExt)
+		out, err = jobs.StartAsyncJob(func(ctx context.Context, in rc.Params) (rc.Params, error) {
+			defer done()
+			return fn(ctx, in)
+		}, inExt)
 		jobID = out["jobid"].(int64)
 	} else {
 		out, err = fn(r.Context(), inExt)
+		done()
 	}
 
 	if rc.IsErrParamNotFound(err) || errors.Is(err, ErrNotFound) {
@@ -270,6 +270,67 @@ func TestRCAsyncSuccessJobInfoStatus(t *testing.T) {
 	})
 }
 
+func TestCoreBwlimitJob(t *testing.T) {
+	runningJobLimiters.Add("job/1000", rclone.NewBandwidthLimiter(0))
+	defer runningJobLimiters.Remove("job/1000")
+
+	tests := []httpTest{{
+		Name:        "get",
+		URL:         "core/bwlimit",
+		Method:      "POST",
+		ContentType: "application/json",
+		Body:        `{"jobid":1000}`,
+		Status:      http.StatusOK,
+		Expected: `{"bytesPerSecond":-1,"rate":"off"}
+`,
+	}, {
+		Name:        "set",
+		URL:         "core/bwlimit",
+		Method:      "POST",
+		ContentType: "application/json",
+		Body:        `{"jobid":1000,"rate":"1M"}`,
+		Status:      http.StatusOK,
+		Expected: `{"bytesPerSecond":1048576,"rate":"1M"}
+`,
+	}, {
+		Name:        "bad rate",
+		URL:         "core/bwlimit",
+		Method:      "POST",
+		ContentType: "application/json",
+		Body:        `{"jobid":1000,"rate":"1M:2M"}`,
+		Status:      http.StatusBadRequest,
+	}, {
+		Name:        "job not found",
+		URL:         "core/bwlimit",
+		Method:      "POST",
+		ContentType: "application/json",
+		Body:        `{"jobid":1001,"rate":"1M"}`,
+		Status:      http.StatusNotFound,
+	}}
+
+	testServer(t, tests)
+}
+
+func TestJobBandwidthLimiter(t *testing.T) {
+	l, err := jobBandwidthLimiter(rc.Params{})
+	if err != nil {
+		t.Fatal("jobBandwidthLimiter() error", err)
+	}
+	if l != nil {
+		t.Fatal("jobBandwidthLimiter() expected no limiter if bwlimit is not set")
+	}
+
+	for _, v := range []string{"0", "1M"} {
+		l, err := jobBandwidthLimiter(rc.Params{"bwlimit": v})
+		if err != nil {
+			t.Fatal("jobBandwidthLimiter() error", err)
+		}
+		if l == nil {
+			t.Fatalf("jobBandwidthLimiter() expected limiter for bwlimit %s", v)
+		}
+	}
+}
+
 func TestLongBodyReturnsContentLengthHeader(t *testing.T) {
 	rcServer := New()
 
@@ -0,0 +1,132 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"net/url"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+)
+
+// UploadOptions specify how uploaded objects are stored.
+type UploadOptions struct {
+	// StorageClass is a storage class (S3, GCS) or access tier (Azure) of
+	// the uploaded objects, if empty the provider configuration is used.
+	StorageClass string
+	// Metadata is set as object metadata (S3, GCS) and object tags (S3) so
+	// that bucket lifecycle rules can act on the uploaded objects.
+	Metadata map[string]string
+}
+
+// IsZero returns true if o does not change the way objects are stored.
+func (o UploadOptions) IsZero() bool {
+	return o.StorageClass == "" && len(o.Metadata) == 0
+}
+
+// storageClassOption maps backend type to the name of the option that
+// specifies storage class of the uploaded objects.
+var storageClassOption = map[string]string{
+	"s3":        "storage_class",
+	"gcs":       "storage_class",
+	"azureblob": "access_tier",
+}
+
+// metadataHeaderPrefix maps backend type to the prefix of upload headers
+// that set object metadata.
+var metadataHeaderPrefix = map[string]string{
+	"s3":  "X-Amz-Meta-",
+	"gcs": "X-Goog-Meta-",
+}
+
+// Apply returns f with the storage class set and ctx with upload headers
+// that set metadata on the uploaded objects. The options are also available
+// with UploadOptionsFromContext.
+func (o UploadOptions) Apply(ctx context.Context, f fs.Fs) (context.Context, fs.Fs, error) {
+	if o.IsZero() {
+		return ctx, f, nil
+	}
+	backend, _ := fs.ConfigFileGet(f.Name(), "type")
+
+	if o.StorageClass != "" {
+		opt, ok := storageClassOption[backend]
+		if !ok {
+			return ctx, nil, errors.Errorf("storage class is not supported by %s provider", f.Name())
+		}
+		fsInfo, err := fs.Find(backend)
+		if err != nil {
+			return ctx, nil, err
+		}
+		m := overrideMapper{
+			override: configmap.Simple{opt: o.StorageClass},
+			Mapper:   fs.ConfigMap(fsInfo, f.Name()),
+		}
+		f, err = fsInfo.NewFs(ctx, f.Name(), f.Root(), m)
+		if err != nil {
+			return ctx, nil, errors.Wrapf(err, "set storage class %s", o.StorageClass)
+		}
+	}
+
+	if len(o.Metadata) > 0 {
+		var ci *fs.ConfigInfo
+		ctx, ci = fs.AddConfig(ctx)
+		ci.UploadHeaders = append(ci.UploadHeaders, o.uploadHeaders(backend)...)
+	}
+
+	return context.WithValue(ctx, uploadOptionsKey{}, o), f, nil
+}
+
+func (o UploadOptions) uploadHeaders(backend string) []*fs.HTTPOption {
+	prefix, ok := metadataHeaderPrefix[backend]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(o.Metadata))
+	for k := range o.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var headers []*fs.HTTPOption
+	for _, k := range keys {
+		headers = append(headers, &fs.HTTPOption{Key: prefix + k, Value: o.Metadata[k]})
+	}
+	if backend == "s3" {
+		headers = append(headers, &fs.HTTPOption{Key: "X-Amz-Tagging", Value: o.Tagging()})
+	}
+	return headers
+}
+
+// Tagging returns metadata in S3 object tagging format.
+func (o UploadOptions) Tagging() string {
+	v := url.Values{}
+	for k, m := range o.Metadata {
+		v.Set(k, m)
+	}
+	return v.Encode()
+}
+
+type uploadOptionsKey struct{}
+
+// UploadOptionsFromContext returns upload options applied to ctx.
+func UploadOptionsFromContext(ctx context.Context) UploadOptions {
+	o, _ := ctx.Value(uploadOptionsKey{}).(UploadOptions)
+	return o
+}
+
+// overrideMapper returns values from override before asking the Mapper.
+type overrideMapper struct {
+	override configmap.Simple
+	configmap.Mapper
+}
+
+func (m overrideMapper) Get(key string) (string, bool) {
+	if v, ok := m.override[key]; ok {
+		return v, ok
+	}
+	return m.Mapper.Get(key)
+}
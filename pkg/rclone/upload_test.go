@@ -0,0 +1,52 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rclone
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rclone/rclone/fs"
+)
+
+func TestUploadOptionsUploadHeaders(t *testing.T) {
+	o := UploadOptions{
+		Metadata: map[string]string{
+			"b": "2",
+			"a": "1 2",
+		},
+	}
+
+	table := []struct {
+		Backend  string
+		Expected []*fs.HTTPOption
+	}{
+		{
+			Backend: "s3",
+			Expected: []*fs.HTTPOption{
+				{Key: "X-Amz-Meta-a", Value: "1 2"},
+				{Key: "X-Amz-Meta-b", Value: "2"},
+				{Key: "X-Amz-Tagging", Value: "a=1+2&b=2"},
+			},
+		},
+		{
+			Backend: "gcs",
+			Expected: []*fs.HTTPOption{
+				{Key: "X-Goog-Meta-a", Value: "1 2"},
+				{Key: "X-Goog-Meta-b", Value: "2"},
+			},
+		},
+		{
+			Backend: "azureblob",
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Backend, func(t *testing.T) {
+			if diff := cmp.Diff(test.Expected, o.uploadHeaders(test.Backend)); diff != "" {
+				t.Fatal("uploadHeaders() diff", diff)
+			}
+		})
+	}
+}
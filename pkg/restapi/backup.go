@@ -27,13 +27,17 @@ func newBackupHandler(services Services) *chi.Mux {
 		schedSvc: services.Scheduler,
 	}
 
-	m.Use(
-		h.locationsCtx,
-		h.listFilterCtx,
-	)
-	m.Get("/", h.list)
-	m.Delete("/", h.deleteSnapshot)
-	m.Get("/files", h.listFiles)
+	m.Group(func(r chi.Router) {
+		r.Use(
+			h.locationsCtx,
+			h.listFilterCtx,
+		)
+		r.Get("/", h.list)
+		r.Delete("/", h.deleteSnapshot)
+		r.Get("/files", h.listFiles)
+		r.Get("/diff", h.diff)
+	})
+	m.Put("/rate-limit", h.updateRateLimit)
 
 	return m
 }
@@ -174,6 +178,29 @@ func (h backupHandler) listFiles(w http.ResponseWriter, r *http.Request) {
 	render.Respond(w, r, v)
 }
 
+func (h backupHandler) diff(w http.ResponseWriter, r *http.Request) {
+	snapshotTags := r.Form["snapshot_tags"]
+	if len(snapshotTags) != 2 {
+		respondBadRequest(w, r, errors.New("exactly two snapshot tags are required"))
+		return
+	}
+
+	v, err := h.svc.Diff(
+		r.Context(),
+		mustClusterIDFromCtx(r),
+		h.mustLocationsFromCtx(r),
+		h.mustListFilterFromCtx(r),
+		snapshotTags[0],
+		snapshotTags[1],
+	)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "diff snapshots"))
+		return
+	}
+
+	render.Respond(w, r, v)
+}
+
 func (h backupHandler) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
 	snapshotTags := r.Form["snapshot_tags"]
 	if len(snapshotTags) == 0 {
@@ -194,3 +221,45 @@ func (h backupHandler) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h backupHandler) updateRateLimit(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+
+	var limits []backup.DCLimit
+	for _, v := range r.Form["rate_limit"] {
+		var l backup.DCLimit
+		if err := l.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, err)
+			return
+		}
+		limits = append(limits, l)
+	}
+	var schedule []backup.ScheduledRateLimit
+	for _, v := range r.Form["rate_limit_schedule"] {
+		var l backup.ScheduledRateLimit
+		if err := l.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, err)
+			return
+		}
+		schedule = append(schedule, l)
+	}
+	if len(limits) == 0 && len(schedule) == 0 {
+		respondBadRequest(w, r, errors.New("missing rate_limit or rate_limit_schedule"))
+		return
+	}
+
+	if err := h.svc.SetRateLimit(
+		r.Context(),
+		mustClusterIDFromCtx(r),
+		limits,
+		schedule,
+	); err != nil {
+		respondError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/scylladb/go-log"
@@ -196,3 +197,43 @@ func TestBackupListFiles(t *testing.T) {
 	h.ServeHTTP(w, r)
 	assertJsonBody(t, w, golden)
 }
+
+func TestBackupUpdateRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cm := restapi.NewMockClusterService(ctrl)
+	bm := restapi.NewMockBackupService(ctrl)
+
+	services := restapi.Services{
+		Cluster: cm,
+		Backup:  bm,
+	}
+
+	h := restapi.New(services, log.Logger{})
+
+	var (
+		cluster  = givenCluster()
+		limits   = []backup.DCLimit{{Limit: 100}}
+		schedule = []backup.ScheduledRateLimit{
+			{DC: "dc1", Start: 8 * time.Hour, Limit: 50},
+			{DC: "dc1", Start: 20 * time.Hour, Limit: 0},
+		}
+	)
+
+	cm.EXPECT().GetCluster(gomock.Any(), cluster.ID.String()).Return(cluster, nil)
+	bm.EXPECT().SetRateLimit(gomock.Any(), cluster.ID, limits, schedule).Return(nil)
+
+	q := url.Values{}
+	q.Add("rate_limit", "100")
+	q.Add("rate_limit_schedule", "dc1:08:00,50")
+	q.Add("rate_limit_schedule", "dc1:20:00,0")
+	r := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/cluster/%s/backups/rate-limit?%s", cluster.ID.String(), q.Encode()), nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected to receive %d status code, got %d", http.StatusOK, w.Code)
+	}
+}
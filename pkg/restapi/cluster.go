@@ -62,12 +62,20 @@ func newClusterHandler(svc ClusterService) *chi.Mux {
 		r.Get("/", h.loadCluster)
 		r.Put("/", h.updateCluster)
 		r.Delete("/", h.deleteCluster)
+		r.Put("/auth_token", h.rotateAuthToken)
+		r.Delete("/auth_token", h.cancelAuthTokenRotation)
 	})
 	return m
 }
 
 func (h clusterHandler) listClusters(w http.ResponseWriter, r *http.Request) {
-	ids, err := h.svc.ListClusters(r.Context(), &cluster.Filter{})
+	selector, err := cluster.ParseLabelSelector(r.FormValue("selector"))
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+
+	ids, err := h.svc.ListClusters(r.Context(), &cluster.Filter{Selector: selector})
 	if err != nil {
 		respondError(w, r, errors.Wrap(err, "list clusters"))
 		return
@@ -135,6 +143,7 @@ func (h clusterHandler) deleteCluster(w http.ResponseWriter, r *http.Request) {
 	var (
 		deleteCQLCredentials bool
 		deleteSSLUserCert    bool
+		deleteAgentTLS       bool
 		err                  error
 	)
 
@@ -153,7 +162,15 @@ func (h clusterHandler) deleteCluster(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if !deleteCQLCredentials && !deleteSSLUserCert {
+	if v := r.FormValue("agent_tls"); v != "" {
+		deleteAgentTLS, err = strconv.ParseBool(v)
+		if err != nil {
+			respondBadRequest(w, r, err)
+			return
+		}
+	}
+
+	if !deleteCQLCredentials && !deleteSSLUserCert && !deleteAgentTLS {
 		if err := h.svc.DeleteCluster(r.Context(), c.ID); err != nil {
 			respondError(w, r, errors.Wrapf(err, "delete cluster %q", c.ID))
 			return
@@ -171,4 +188,34 @@ func (h clusterHandler) deleteCluster(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	if deleteAgentTLS {
+		if err := h.svc.DeleteAgentTLSIdentity(r.Context(), c.ID); err != nil {
+			respondError(w, r, errors.Wrapf(err, "delete agent TLS identity for cluster %q", c.ID))
+			return
+		}
+	}
+}
+
+func (h clusterHandler) rotateAuthToken(w http.ResponseWriter, r *http.Request) {
+	c := mustClusterFromCtx(r)
+
+	var token string
+	if err := render.DecodeJSON(r.Body, &token); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+
+	if err := h.svc.RotateAuthToken(r.Context(), c.ID, token); err != nil {
+		respondError(w, r, errors.Wrapf(err, "rotate auth token for cluster %q", c.ID))
+		return
+	}
+}
+
+func (h clusterHandler) cancelAuthTokenRotation(w http.ResponseWriter, r *http.Request) {
+	c := mustClusterFromCtx(r)
+
+	if err := h.svc.CancelAuthTokenRotation(r.Context(), c.ID); err != nil {
+		respondError(w, r, errors.Wrapf(err, "cancel auth token rotation for cluster %q", c.ID))
+		return
+	}
 }
@@ -145,6 +145,32 @@ func TestClusterDeleteSSLUserCert(t *testing.T) {
 	}
 }
 
+func TestClusterRotateAuthToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.MustRandom()
+
+	m := restapi.NewMockClusterService(ctrl)
+	gomock.InOrder(
+		m.EXPECT().GetCluster(gomock.Any(), id.String()).Return(&cluster.Cluster{ID: id}, nil),
+		m.EXPECT().RotateAuthToken(gomock.Any(), id, "token").Return(nil),
+	)
+
+	h := restapi.New(restapi.Services{Cluster: m}, log.Logger{})
+	r := httptest.NewRequest(http.MethodPut, fmt.Sprint("/api/v1/cluster/", id, "/auth_token"), strings.NewReader(`"token"`))
+	r.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected to receive %d status code, got %d", http.StatusOK, w.Code)
+	}
+}
+
 // ClusterMatcher gomock.Matcher interface implementation for cluster.Cluster.
 type ClusterMatcher struct {
 	expected *cluster.Cluster
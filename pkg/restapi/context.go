@@ -20,6 +20,7 @@ const (
 
 	ctxBackupLocations
 	ctxBackupListFilter
+	ctxSnapshotFilter
 )
 
 func mustClusterIDFromCtx(r *http.Request) uuid.UUID {
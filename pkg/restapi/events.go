@@ -0,0 +1,129 @@
+// Copyright (C) 2017 ScyllaDB
+
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// eventsKeepAliveInterval specifies how often a comment is sent to keep idle
+// connections open.
+var eventsKeepAliveInterval = 15 * time.Second
+
+type eventsHandler struct {
+	svc EventsService
+}
+
+func newEventsHandler(svc EventsService) *chi.Mux {
+	m := chi.NewMux()
+	h := eventsHandler{
+		svc: svc,
+	}
+	m.Get("/", h.streamEvents)
+	return m
+}
+
+// streamEvents streams run state changes and progress updates of cluster
+// tasks as Server-Sent Events. The stream can be resumed by passing ID of the
+// last received event in Last-Event-ID header or last_event_id parameter.
+func (h eventsHandler) streamEvents(w http.ResponseWriter, r *http.Request) {
+	f, lastEventID, err := h.parseFilter(r)
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondError(w, r, errors.New("streaming not supported"))
+		return
+	}
+
+	sub := h.svc.Subscribe(f, lastEventID)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	t := time.NewTicker(eventsKeepAliveInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-t.C:
+			if _, err := fmt.Fprint(w, ":\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (h eventsHandler) parseFilter(r *http.Request) (events.Filter, uint64, error) {
+	f := events.Filter{
+		ClusterID: mustClusterIDFromCtx(r),
+	}
+
+	if v := r.FormValue("task_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return f, 0, errors.Wrap(err, "parse task_id")
+		}
+		f.TaskID = id
+	}
+	if v := r.FormValue("run_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return f, 0, errors.Wrap(err, "parse run_id")
+		}
+		f.RunID = id
+	}
+	for _, v := range r.Form["type"] {
+		f.Types = append(f.Types, events.Type(v))
+	}
+
+	v := r.Header.Get("Last-Event-ID")
+	if v == "" {
+		v = r.FormValue("last_event_id")
+	}
+	var lastEventID uint64
+	if v != "" {
+		var err error
+		lastEventID, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return f, 0, errors.Wrap(err, "parse last event ID")
+		}
+	}
+
+	return f, lastEventID, nil
+}
+
+func writeEvent(w http.ResponseWriter, e events.Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, b)
+	return err
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 ScyllaDB
+
+package restapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/restapi"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+func TestEventsStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	b := events.NewBroker(10)
+
+	services := restapi.Services{
+		Cluster: cm,
+		Events:  b,
+	}
+	h := restapi.New(services, log.Logger{})
+
+	var (
+		cluster = givenCluster()
+		other   = uuid.MustRandom()
+		taskID  = uuid.MustRandom()
+	)
+
+	ctx := context.Background()
+	b.Publish(ctx, events.Event{Type: events.RunStatus, ClusterID: cluster.ID, TaskID: taskID})
+	b.Publish(ctx, events.Event{Type: events.RunStatus, ClusterID: other, TaskID: taskID})
+	b.Publish(ctx, events.Event{Type: events.BackupProgress, ClusterID: cluster.ID, TaskID: taskID})
+
+	cm.EXPECT().GetCluster(gomock.Any(), cluster.ID.String()).Return(cluster, nil)
+
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	r := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/cluster/%s/events?task_id=%s", cluster.ID, taskID), nil).WithContext(ctx)
+	r.Header.Set("Last-Event-ID", "1")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Result().StatusCode != http.StatusOK {
+		t.Fatalf("wrong status code, got %d, expected %d", w.Result().StatusCode, http.StatusOK)
+	}
+	if ct := w.Result().Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %s, expected text/event-stream", ct)
+	}
+
+	body := w.Body.String()
+	if c := strings.Count(body, "event: "); c != 2 {
+		t.Fatalf("got %d events, expected 2, body %s", c, body)
+	}
+	if strings.Contains(body, other.String()) {
+		t.Fatalf("unexpected event of other cluster, body %s", body)
+	}
+	if !strings.Contains(body, "event: "+string(events.BackupProgress)) {
+		t.Fatalf("missing backup progress event, body %s", body)
+	}
+}
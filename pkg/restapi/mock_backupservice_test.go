@@ -12,6 +12,7 @@ import (
 	backupspec "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
 	uuid "github.com/scylladb/scylla-manager/pkg/util/uuid"
 	reflect "reflect"
+	time "time"
 )
 
 // MockBackupService is a mock of BackupService interface
@@ -51,6 +52,36 @@ func (mr *MockBackupServiceMockRecorder) DeleteSnapshot(arg0, arg1, arg2, arg3 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockBackupService)(nil).DeleteSnapshot), arg0, arg1, arg2, arg3)
 }
 
+// Diff mocks base method
+func (m *MockBackupService) Diff(arg0 context.Context, arg1 uuid.UUID, arg2 []backupspec.Location, arg3 backup.ListFilter, arg4, arg5 string) (backup.SnapshotDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(backup.SnapshotDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MockBackupServiceMockRecorder) Diff(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockBackupService)(nil).Diff), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Estimate mocks base method
+func (m *MockBackupService) Estimate(arg0 context.Context, arg1 uuid.UUID, arg2 backup.Target, arg3 time.Duration) (backup.Estimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Estimate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(backup.Estimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Estimate indicates an expected call of Estimate
+func (mr *MockBackupServiceMockRecorder) Estimate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Estimate", reflect.TypeOf((*MockBackupService)(nil).Estimate), arg0, arg1, arg2, arg3)
+}
+
 // ExtractLocations mocks base method
 func (m *MockBackupService) ExtractLocations(arg0 context.Context, arg1 []json.RawMessage) []backupspec.Location {
 	m.ctrl.T.Helper()
@@ -169,3 +200,17 @@ func (mr *MockBackupServiceMockRecorder) ListFiles(arg0, arg1, arg2, arg3 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockBackupService)(nil).ListFiles), arg0, arg1, arg2, arg3)
 }
+
+// SetRateLimit mocks base method
+func (m *MockBackupService) SetRateLimit(arg0 context.Context, arg1 uuid.UUID, arg2 []backup.DCLimit, arg3 []backup.ScheduledRateLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRateLimit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRateLimit indicates an expected call of SetRateLimit
+func (mr *MockBackupServiceMockRecorder) SetRateLimit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRateLimit", reflect.TypeOf((*MockBackupService)(nil).SetRateLimit), arg0, arg1, arg2, arg3)
+}
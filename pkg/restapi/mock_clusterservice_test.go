@@ -35,6 +35,34 @@ func (m *MockClusterService) EXPECT() *MockClusterServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelAuthTokenRotation mocks base method
+func (m *MockClusterService) CancelAuthTokenRotation(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAuthTokenRotation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAuthTokenRotation indicates an expected call of CancelAuthTokenRotation
+func (mr *MockClusterServiceMockRecorder) CancelAuthTokenRotation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAuthTokenRotation", reflect.TypeOf((*MockClusterService)(nil).CancelAuthTokenRotation), arg0, arg1)
+}
+
+// DeleteAgentTLSIdentity mocks base method
+func (m *MockClusterService) DeleteAgentTLSIdentity(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAgentTLSIdentity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAgentTLSIdentity indicates an expected call of DeleteAgentTLSIdentity
+func (mr *MockClusterServiceMockRecorder) DeleteAgentTLSIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAgentTLSIdentity", reflect.TypeOf((*MockClusterService)(nil).DeleteAgentTLSIdentity), arg0, arg1)
+}
+
 // DeleteCQLCredentials mocks base method
 func (m *MockClusterService) DeleteCQLCredentials(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -135,3 +163,17 @@ func (mr *MockClusterServiceMockRecorder) PutCluster(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCluster", reflect.TypeOf((*MockClusterService)(nil).PutCluster), arg0, arg1)
 }
+
+// RotateAuthToken mocks base method
+func (m *MockClusterService) RotateAuthToken(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAuthToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateAuthToken indicates an expected call of RotateAuthToken
+func (mr *MockClusterServiceMockRecorder) RotateAuthToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAuthToken", reflect.TypeOf((*MockClusterService)(nil).RotateAuthToken), arg0, arg1, arg2)
+}
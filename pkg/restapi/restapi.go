@@ -47,6 +47,8 @@ func New(services Services, logger log.Logger) http.Handler {
 	r.With(f).Mount("/api/v1/cluster/{cluster_id}/task", newTaskHandler(services))
 	r.With(f).Mount("/api/v1/cluster/{cluster_id}/backups", newBackupHandler(services))
 	r.With(f).Mount("/api/v1/cluster/{cluster_id}/repairs", newRepairHandler(services))
+	r.With(f).Mount("/api/v1/cluster/{cluster_id}/snapshots", newSnapshotHandler(services))
+	r.With(f).Mount("/api/v1/cluster/{cluster_id}/events", newEventsHandler(services.Events))
 
 	// NotFound registered last due to https://github.com/go-chi/chi/issues/297
 	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
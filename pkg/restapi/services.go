@@ -5,23 +5,36 @@ package restapi
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/service/backup"
 	"github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/service/cleanup"
 	"github.com/scylladb/scylla-manager/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/pkg/service/compaction"
 	"github.com/scylladb/scylla-manager/pkg/service/healthcheck"
 	"github.com/scylladb/scylla-manager/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/pkg/service/rollingrestart"
 	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+	"github.com/scylladb/scylla-manager/pkg/service/sstablemaintenance"
 	"github.com/scylladb/scylla-manager/pkg/util/uuid"
 )
 
 // Services contains REST API services.
 type Services struct {
-	Cluster     ClusterService
-	HealthCheck HealthCheckService
-	Repair      RepairService
-	Backup      BackupService
-	Scheduler   SchedService
+	Cluster            ClusterService
+	HealthCheck        HealthCheckService
+	Repair             RepairService
+	Backup             BackupService
+	Cleanup            CleanupService
+	Compaction         CompactionService
+	RollingRestart     RollingRestartService
+	SSTableMaintenance SSTableMaintenanceService
+	Snapshot           SnapshotService
+	Scheduler          SchedService
+	Events             EventsService
 }
 
 // ClusterService service interface for the REST API handlers.
@@ -32,12 +45,17 @@ type ClusterService interface {
 	DeleteCluster(ctx context.Context, id uuid.UUID) error
 	DeleteCQLCredentials(ctx context.Context, id uuid.UUID) error
 	DeleteSSLUserCert(ctx context.Context, id uuid.UUID) error
+	DeleteAgentTLSIdentity(ctx context.Context, id uuid.UUID) error
+	RotateAuthToken(ctx context.Context, id uuid.UUID, token string) error
+	CancelAuthTokenRotation(ctx context.Context, id uuid.UUID) error
 	ListNodes(ctx context.Context, id uuid.UUID) ([]cluster.Node, error)
 }
 
 // HealthCheckService service interface for the REST API handlers.
 type HealthCheckService interface {
 	Status(ctx context.Context, clusterID uuid.UUID) ([]healthcheck.NodeStatus, error)
+	StatusHistory(ctx context.Context, clusterID uuid.UUID, since, until time.Time) (healthcheck.StatusHistory, error)
+	AgentConfig(ctx context.Context, clusterID uuid.UUID) ([]healthcheck.NodeAgentConfig, error)
 }
 
 // RepairService service interface for the REST API handlers.
@@ -53,13 +71,48 @@ type RepairService interface {
 type BackupService interface {
 	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (backup.Target, error)
 	GetTargetSize(ctx context.Context, clusterID uuid.UUID, target backup.Target) (int64, error)
+	Estimate(ctx context.Context, clusterID uuid.UUID, target backup.Target, interval time.Duration) (backup.Estimate, error)
 	ExtractLocations(ctx context.Context, properties []json.RawMessage) []backupspec.Location
 	List(ctx context.Context, clusterID uuid.UUID, locations []backupspec.Location, filter backup.ListFilter) ([]backup.ListItem, error)
 	ListFiles(ctx context.Context, clusterID uuid.UUID, locations []backupspec.Location, filter backup.ListFilter) ([]backupspec.FilesInfo, error)
 	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (backup.Progress, error)
 	DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locations []backupspec.Location, snapshotTags []string) error
+	Diff(ctx context.Context, clusterID uuid.UUID, locations []backupspec.Location, filter backup.ListFilter, from, to string) (backup.SnapshotDiff, error)
 	GetValidationTarget(_ context.Context, clusterID uuid.UUID, properties json.RawMessage) (backup.ValidationTarget, error)
 	GetValidationProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) ([]backup.ValidationHostProgress, error)
+	SetRateLimit(ctx context.Context, clusterID uuid.UUID, limits []backup.DCLimit, schedule []backup.ScheduledRateLimit) error
+}
+
+// CleanupService service interface for the REST API handlers.
+type CleanupService interface {
+	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (cleanup.Target, error)
+	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (cleanup.Progress, error)
+}
+
+// CompactionService service interface for the REST API handlers.
+type CompactionService interface {
+	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (compaction.Target, error)
+	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (compaction.Progress, error)
+}
+
+// RollingRestartService service interface for the REST API handlers.
+type RollingRestartService interface {
+	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (rollingrestart.Target, error)
+	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (rollingrestart.Progress, error)
+}
+
+// SSTableMaintenanceService service interface for the REST API handlers.
+type SSTableMaintenanceService interface {
+	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (sstablemaintenance.Target, error)
+	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (sstablemaintenance.Progress, error)
+}
+
+// SnapshotService service interface for the REST API handlers.
+type SnapshotService interface {
+	List(ctx context.Context, clusterID uuid.UUID, filter snapshot.Filter) ([]snapshot.NodeSnapshots, error)
+	Delete(ctx context.Context, clusterID uuid.UUID, filter snapshot.Filter) ([]snapshot.NodeSnapshots, error)
+	GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (snapshot.Target, error)
+	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (snapshot.Progress, error)
 }
 
 // SchedService service interface for the REST API handlers.
@@ -79,3 +132,8 @@ type SchedService interface {
 	Suspend(ctx context.Context, clusterID uuid.UUID) error
 	Resume(ctx context.Context, clusterID uuid.UUID, startTasks bool) error
 }
+
+// EventsService service interface for the REST API handlers.
+type EventsService interface {
+	Subscribe(f events.Filter, lastEventID uint64) *events.Subscription
+}
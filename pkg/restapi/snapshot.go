@@ -0,0 +1,92 @@
+// Copyright (C) 2017 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+)
+
+type snapshotHandler struct {
+	svc SnapshotService
+}
+
+func newSnapshotHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := snapshotHandler{
+		svc: services.Snapshot,
+	}
+
+	m.Use(h.filterCtx)
+	m.Get("/", h.list)
+	m.Delete("/", h.delete)
+
+	return m
+}
+
+func (h snapshotHandler) filterCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			respondBadRequest(w, r, err)
+			return
+		}
+
+		filter := snapshot.Filter{
+			Host:        r.Form["host"],
+			DC:          r.Form["dc"],
+			SnapshotTag: r.Form["snapshot_tag"],
+		}
+
+		if v := r.FormValue("older_than"); v != "" {
+			if err := filter.OlderThan.UnmarshalText([]byte(v)); err != nil {
+				respondBadRequest(w, r, errors.Wrap(err, "invalid older_than"))
+				return
+			}
+		}
+		if v := r.FormValue("all"); v != "" {
+			var err error
+			if filter.All, err = strconv.ParseBool(v); err != nil {
+				respondBadRequest(w, r, errors.Wrap(err, "invalid all"))
+				return
+			}
+		}
+
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, ctxSnapshotFilter, filter)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h snapshotHandler) mustFilterFromCtx(r *http.Request) snapshot.Filter {
+	v, ok := r.Context().Value(ctxSnapshotFilter).(snapshot.Filter)
+	if !ok {
+		panic("missing filter in context")
+	}
+	return v
+}
+
+func (h snapshotHandler) list(w http.ResponseWriter, r *http.Request) {
+	v, err := h.svc.List(r.Context(), mustClusterIDFromCtx(r), h.mustFilterFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "list snapshots"))
+		return
+	}
+
+	render.Respond(w, r, v)
+}
+
+func (h snapshotHandler) delete(w http.ResponseWriter, r *http.Request) {
+	v, err := h.svc.Delete(r.Context(), mustClusterIDFromCtx(r), h.mustFilterFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "delete snapshots"))
+		return
+	}
+
+	render.Respond(w, r, v)
+}
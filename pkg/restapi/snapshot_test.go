@@ -0,0 +1,120 @@
+// Copyright (C) 2017 ScyllaDB
+
+package restapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/pkg/restapi"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+//go:generate mockgen -destination mock_snapshotservice_test.go -mock_names SnapshotService=MockSnapshotService -package restapi github.com/scylladb/scylla-manager/pkg/restapi SnapshotService
+
+func snapshotsRequest(method string, clusterID uuid.UUID, form url.Values) *http.Request {
+	return httptest.NewRequest(method, fmt.Sprintf("/api/v1/cluster/%s/snapshots?%s", clusterID.String(), form.Encode()), nil)
+}
+
+func TestSnapshotList(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cm := restapi.NewMockClusterService(ctrl)
+	sm := restapi.NewMockSnapshotService(ctrl)
+
+	services := restapi.Services{
+		Cluster:  cm,
+		Snapshot: sm,
+	}
+
+	h := restapi.New(services, log.Logger{})
+
+	var (
+		cluster = givenCluster()
+
+		filter = snapshot.Filter{
+			DC:          []string{"dc1"},
+			SnapshotTag: []string{"tag1", "tag2"},
+			OlderThan:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		golden = []snapshot.NodeSnapshots{
+			{
+				Host:      "192.168.100.11",
+				DC:        "dc1",
+				Snapshots: []snapshot.Snapshot{{Tag: "tag1", Keyspaces: []string{"ks"}, Size: 10, TrueSize: 5}},
+				TrueSize:  5,
+			},
+			{
+				Host:  "192.168.100.12",
+				DC:    "dc1",
+				Error: "node is down",
+			},
+		}
+	)
+
+	cm.EXPECT().GetCluster(gomock.Any(), cluster.ID.String()).Return(cluster, nil)
+	sm.EXPECT().List(gomock.Any(), cluster.ID, filter).Return(golden, nil)
+
+	form := url.Values{
+		"dc":           filter.DC,
+		"snapshot_tag": filter.SnapshotTag,
+		"older_than":   []string{"2021-01-01T00:00:00Z"},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, snapshotsRequest(http.MethodGet, cluster.ID, form))
+	assertJsonBody(t, w, golden)
+}
+
+func TestSnapshotDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cm := restapi.NewMockClusterService(ctrl)
+	sm := restapi.NewMockSnapshotService(ctrl)
+
+	services := restapi.Services{
+		Cluster:  cm,
+		Snapshot: sm,
+	}
+
+	h := restapi.New(services, log.Logger{})
+
+	var (
+		cluster = givenCluster()
+
+		filter = snapshot.Filter{
+			Host: []string{"192.168.100.11"},
+			All:  true,
+		}
+
+		golden = []snapshot.NodeSnapshots{
+			{
+				Host:      "192.168.100.11",
+				DC:        "dc1",
+				Snapshots: []snapshot.Snapshot{{Tag: "tag1", Keyspaces: []string{"ks"}, Size: 10, TrueSize: 5}},
+			},
+		}
+	)
+
+	cm.EXPECT().GetCluster(gomock.Any(), cluster.ID.String()).Return(cluster, nil)
+	sm.EXPECT().Delete(gomock.Any(), cluster.ID, filter).Return(golden, nil)
+
+	form := url.Values{
+		"host": filter.Host,
+		"all":  []string{"true"},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, snapshotsRequest(http.MethodDelete, cluster.ID, form))
+	assertJsonBody(t, w, golden)
+}
@@ -4,12 +4,18 @@ package restapi
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 )
 
+// defaultStatusHistoryWindow specifies status history time window if since
+// parameter is not set.
+const defaultStatusHistoryWindow = 24 * time.Hour
+
 type statusHandler struct {
 	clusterFilter
 	service HealthCheckService
@@ -26,6 +32,8 @@ func newStatusHandler(svc ClusterService, healthCheckService HealthCheckService)
 	m.Route("/", func(r chi.Router) {
 		r.Use(h.clusterCtx)
 		r.Get("/", h.getStatus)
+		r.Get("/history", h.getStatusHistory)
+		r.Get("/agent_config", h.getAgentConfig)
 	})
 	return m
 }
@@ -40,3 +48,44 @@ func (h *statusHandler) getStatus(w http.ResponseWriter, r *http.Request) {
 	}
 	render.Respond(w, r, status)
 }
+
+func (h *statusHandler) getStatusHistory(w http.ResponseWriter, r *http.Request) {
+	c := mustClusterFromCtx(r)
+
+	until := timeutc.Now()
+	if v := r.FormValue("until"); v != "" {
+		if err := until.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid until"))
+			return
+		}
+	}
+	since := until.Add(-defaultStatusHistoryWindow)
+	if v := r.FormValue("since"); v != "" {
+		if err := since.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid since"))
+			return
+		}
+	}
+	if !since.Before(until) {
+		respondBadRequest(w, r, errors.New("since must be before until"))
+		return
+	}
+
+	history, err := h.service.StatusHistory(r.Context(), c.ID, since.UTC(), until.UTC())
+	if err != nil {
+		respondError(w, r, errors.Wrapf(err, "get cluster %q status history", c.ID))
+		return
+	}
+	render.Respond(w, r, history)
+}
+
+func (h *statusHandler) getAgentConfig(w http.ResponseWriter, r *http.Request) {
+	c := mustClusterFromCtx(r)
+
+	config, err := h.service.AgentConfig(r.Context(), c.ID)
+	if err != nil {
+		respondError(w, r, errors.Wrapf(err, "get cluster %q agent config", c.ID))
+		return
+	}
+	render.Respond(w, r, config)
+}
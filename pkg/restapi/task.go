@@ -14,8 +14,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/service"
 	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/cleanup"
+	"github.com/scylladb/scylla-manager/pkg/service/compaction"
 	"github.com/scylladb/scylla-manager/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/pkg/service/rollingrestart"
 	"github.com/scylladb/scylla-manager/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+	"github.com/scylladb/scylla-manager/pkg/service/sstablemaintenance"
 	"github.com/scylladb/scylla-manager/pkg/util/uuid"
 )
 
@@ -30,6 +35,7 @@ func newTasksHandler(services Services) *chi.Mux {
 	m.Get("/", h.listTasks)
 	m.Post("/", h.createTask)
 	m.Get("/{task_type}/target", h.getTarget)
+	m.Get("/backup/estimate", h.getBackupEstimate)
 
 	return m
 }
@@ -175,11 +181,36 @@ func (h *taskHandler) getTarget(w http.ResponseWriter, r *http.Request) {
 			Target: bt,
 			Size:   size,
 		}
+	case scheduler.CleanupTask:
+		if t, err = h.Cleanup.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "get cleanup target"))
+			return
+		}
+	case scheduler.CompactionTask:
+		if t, err = h.Compaction.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "get compaction target"))
+			return
+		}
 	case scheduler.RepairTask:
 		if t, err = h.Repair.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
 			respondError(w, r, errors.Wrap(err, "get repair target"))
 			return
 		}
+	case scheduler.RollingRestartTask:
+		if t, err = h.RollingRestart.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "get rolling restart target"))
+			return
+		}
+	case scheduler.SSTableMaintenanceTask:
+		if t, err = h.SSTableMaintenance.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "get sstable maintenance target"))
+			return
+		}
+	case scheduler.SnapshotTask:
+		if t, err = h.Snapshot.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "get snapshot target"))
+			return
+		}
 	default:
 		respondBadRequest(w, r, errors.Errorf("invalid task type %q", newTask.Type))
 		return
@@ -188,6 +219,38 @@ func (h *taskHandler) getTarget(w http.ResponseWriter, r *http.Request) {
 	render.Respond(w, r, t)
 }
 
+func (h *taskHandler) getBackupEstimate(w http.ResponseWriter, r *http.Request) {
+	newTask, err := h.parseTask(r)
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	newTask.Type = scheduler.BackupTask
+
+	d := h.Services.Scheduler.PropertiesDecorator(newTask.Type)
+	p := newTask.Properties
+	if d != nil {
+		p, err = d(r.Context(), newTask.ClusterID, newTask.ID, newTask.Properties)
+		if err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "evaluate properties"))
+			return
+		}
+	}
+
+	bt, err := h.Backup.GetTarget(r.Context(), newTask.ClusterID, p)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "get backup target"))
+		return
+	}
+	e, err := h.Backup.Estimate(r.Context(), newTask.ClusterID, bt, newTask.Sched.Interval.Duration())
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "estimate backup"))
+		return
+	}
+
+	render.Respond(w, r, e)
+}
+
 func (h *taskHandler) createTask(w http.ResponseWriter, r *http.Request) {
 	newTask, err := h.parseTask(r)
 	if err != nil {
@@ -214,11 +277,36 @@ func (h *taskHandler) createTask(w http.ResponseWriter, r *http.Request) {
 			respondError(w, r, errors.Wrap(err, "create backup target"))
 			return
 		}
+	case scheduler.CleanupTask:
+		if _, err := h.Cleanup.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "create cleanup target"))
+			return
+		}
+	case scheduler.CompactionTask:
+		if _, err := h.Compaction.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "create compaction target"))
+			return
+		}
 	case scheduler.RepairTask:
 		if _, err := h.Repair.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
 			respondError(w, r, errors.Wrap(err, "create repair target"))
 			return
 		}
+	case scheduler.RollingRestartTask:
+		if _, err := h.RollingRestart.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "create rolling restart target"))
+			return
+		}
+	case scheduler.SSTableMaintenanceTask:
+		if _, err := h.SSTableMaintenance.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "create sstable maintenance target"))
+			return
+		}
+	case scheduler.SnapshotTask:
+		if _, err := h.Snapshot.GetTarget(r.Context(), newTask.ClusterID, p); err != nil {
+			respondError(w, r, errors.Wrap(err, "create snapshot target"))
+			return
+		}
 	case scheduler.ValidateBackupTask:
 		if _, err := h.Backup.GetValidationTarget(r.Context(), newTask.ClusterID, p); err != nil {
 			respondError(w, r, errors.Wrap(err, "create validate backup target"))
@@ -384,6 +472,16 @@ func (h *taskHandler) taskRunProgress(w http.ResponseWriter, r *http.Request) {
 				prog.Progress = repair.Progress{}
 			case scheduler.BackupTask:
 				prog.Progress = backup.Progress{}
+			case scheduler.CleanupTask:
+				prog.Progress = cleanup.Progress{}
+			case scheduler.CompactionTask:
+				prog.Progress = compaction.Progress{}
+			case scheduler.RollingRestartTask:
+				prog.Progress = rollingrestart.Progress{}
+			case scheduler.SSTableMaintenanceTask:
+				prog.Progress = sstablemaintenance.Progress{}
+			case scheduler.SnapshotTask:
+				prog.Progress = snapshot.Progress{}
 			}
 			render.Respond(w, r, prog)
 			return
@@ -411,6 +509,16 @@ func (h *taskHandler) taskRunProgress(w http.ResponseWriter, r *http.Request) {
 		pr, err = h.Repair.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
 	case scheduler.BackupTask:
 		pr, err = h.Backup.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+	case scheduler.CleanupTask:
+		pr, err = h.Cleanup.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+	case scheduler.CompactionTask:
+		pr, err = h.Compaction.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+	case scheduler.RollingRestartTask:
+		pr, err = h.RollingRestart.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+	case scheduler.SSTableMaintenanceTask:
+		pr, err = h.SSTableMaintenance.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+	case scheduler.SnapshotTask:
+		pr, err = h.Snapshot.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
 	case scheduler.ValidateBackupTask:
 		pr, err = h.Backup.GetValidationProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
 	default:
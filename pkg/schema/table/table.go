@@ -18,6 +18,7 @@ var (
 			"prev_id",
 			"snapshot_tag",
 			"stage",
+			"stagger_dc",
 			"start_time",
 			"units",
 		},
@@ -30,6 +31,32 @@ var (
 		},
 	})
 
+	BackupRunHookProgress = table.New(table.Metadata{
+		Name: "backup_run_hook_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"stage",
+			"completed_at",
+			"error",
+			"exit_code",
+			"name",
+			"output",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+			"stage",
+		},
+	})
+
 	BackupRunProgress = table.New(table.Metadata{
 		Name: "backup_run_progress",
 		Columns: []string{
@@ -60,12 +87,103 @@ var (
 		},
 	})
 
+	CleanupRun = table.New(table.Metadata{
+		Name: "cleanup_run",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"id",
+			"dc",
+			"prev_id",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
+	CleanupRunProgress = table.New(table.Metadata{
+		Name: "cleanup_run_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"keyspace_name",
+			"completed_at",
+			"dc",
+			"error",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+			"keyspace_name",
+		},
+	})
+
+	CompactionRun = table.New(table.Metadata{
+		Name: "compaction_run",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"id",
+			"dc",
+			"prev_id",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
+	CompactionRunProgress = table.New(table.Metadata{
+		Name: "compaction_run_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"keyspace_name",
+			"table_name",
+			"completed_at",
+			"dc",
+			"error",
+			"size_after",
+			"size_before",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+			"keyspace_name",
+			"table_name",
+		},
+	})
+
 	Cluster = table.New(table.Metadata{
 		Name: "cluster",
 		Columns: []string{
 			"id",
 			"auth_token",
 			"known_hosts",
+			"labels",
 			"name",
 			"port",
 		},
@@ -105,6 +223,29 @@ var (
 		SortKey: []string{},
 	})
 
+	HealthcheckHistory = table.New(table.Metadata{
+		Name: "healthcheck_history",
+		Columns: []string{
+			"cluster_id",
+			"day",
+			"ts",
+			"host",
+			"ping_type",
+			"dc",
+			"rtt_ms",
+			"up",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"day",
+		},
+		SortKey: []string{
+			"ts",
+			"host",
+			"ping_type",
+		},
+	})
+
 	RepairRun = table.New(table.Metadata{
 		Name: "repair_run",
 		Columns: []string{
@@ -175,6 +316,95 @@ var (
 		},
 	})
 
+	RollingRestartRun = table.New(table.Metadata{
+		Name: "rolling_restart_run",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"id",
+			"dc",
+			"prev_id",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
+	RollingRestartRunProgress = table.New(table.Metadata{
+		Name: "rolling_restart_run_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"completed_at",
+			"dc",
+			"error",
+			"restart_order",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+		},
+	})
+
+	SSTableMaintenanceRun = table.New(table.Metadata{
+		Name: "sstable_maintenance_run",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"id",
+			"dc",
+			"mode",
+			"prev_id",
+			"scrub_mode",
+			"snapshot_tag",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
+	SSTableMaintenanceRunProgress = table.New(table.Metadata{
+		Name: "sstable_maintenance_run_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"keyspace_name",
+			"completed_at",
+			"dc",
+			"error",
+			"snapshot_tag",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+			"keyspace_name",
+		},
+	})
+
 	SchedulerTask = table.New(table.Metadata{
 		Name: "scheduler_task",
 		Columns: []string{
@@ -234,6 +464,48 @@ var (
 		},
 	})
 
+	SnapshotRun = table.New(table.Metadata{
+		Name: "snapshot_run",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"id",
+			"dc",
+			"snapshot_tag",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
+	SnapshotRunProgress = table.New(table.Metadata{
+		Name: "snapshot_run_progress",
+		Columns: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+			"host",
+			"completed_at",
+			"dc",
+			"error",
+			"size",
+			"started_at",
+		},
+		PartKey: []string{
+			"cluster_id",
+			"task_id",
+			"run_id",
+		},
+		SortKey: []string{
+			"host",
+		},
+	})
+
 	ValidateBackupRunProgress = table.New(table.Metadata{
 		Name: "validate_backup_run_progress",
 		Columns: []string{
@@ -252,6 +524,8 @@ var (
 			"orphaned_files",
 			"scanned_files",
 			"started_at",
+			"unlocked_files",
+			"unlocked_snapshots",
 		},
 		PartKey: []string{
 			"cluster_id",
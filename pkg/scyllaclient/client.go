@@ -6,6 +6,7 @@ import (
 	"crypto/tls"
 	"net"
 	"net/http"
+	"net/url"
 	"runtime"
 	"sync"
 	"time"
@@ -98,6 +99,7 @@ func NewClient(config Config, logger log.Logger) (*Client, error) {
 	transport = hostPool(transport, pool, config.Port)
 	transport = auth.AddToken(transport, config.AuthToken)
 	transport = fixContentType(transport)
+	transport = queryParams(transport)
 
 	c := &http.Client{Transport: transport}
 
@@ -140,6 +142,24 @@ func (c *Client) Close() error {
 
 // fixContentType adjusts Scylla REST API response so that it can be consumed
 // by Open API.
+// queryParams adds query parameters set with withQueryParams to the request.
+func queryParams(next http.RoundTripper) http.RoundTripper {
+	return httpx.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		v, ok := req.Context().Value(ctxQueryParams).(url.Values)
+		if !ok {
+			return next.RoundTrip(req)
+		}
+
+		r := httpx.CloneRequest(req)
+		q := r.URL.Query()
+		for k, vs := range v {
+			q[k] = vs
+		}
+		r.URL.RawQuery = q.Encode()
+		return next.RoundTrip(r)
+	})
+}
+
 func fixContentType(next http.RoundTripper) http.RoundTripper {
 	return httpx.RoundTripperFunc(func(req *http.Request) (resp *http.Response, err error) {
 		defer func() {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 	scyllaversion "github.com/scylladb/scylla-manager/pkg/util/version"
 	"github.com/scylladb/scylla-manager/swagger/gen/agent/client/operations"
 	"github.com/scylladb/scylla-manager/swagger/gen/agent/models"
@@ -165,6 +166,30 @@ func (ni NodeInfo) AlternatorTLSEnabled() (tlsEnabled, certAuth bool) {
 	return ni.AlternatorEncryptionEnabled(), certAuth
 }
 
+// ClockOffset returns difference between the node clock and the local clock.
+// The RTT of the request is taken into account by comparing the node time
+// with the midpoint of the request.
+func (c *Client) ClockOffset(ctx context.Context, host string) (time.Duration, error) {
+	p := operations.NodeTimeParams{
+		Context: forceHost(ctx, host),
+	}
+	t0 := timeutc.Now()
+	resp, err := c.agentOps.NodeTime(&p)
+	if err != nil {
+		return 0, errors.Wrap(err, "node time")
+	}
+	t1 := timeutc.Now()
+
+	mid := t0.Add(t1.Sub(t0) / 2)
+	return time.Time(resp.Payload.Time).Sub(mid), nil
+}
+
+// AgentAddr returns address of the agent running on host and reports if TLS
+// is used to communicate with it.
+func (c *Client) AgentAddr(host string) (addr string, tls bool) {
+	return net.JoinHostPort(host, c.config.Port), c.config.Scheme == "https"
+}
+
 // FreeOSMemory calls debug.FreeOSMemory on the agent to return memory to OS.
 func (c *Client) FreeOSMemory(ctx context.Context, host string) error {
 	p := operations.FreeOSMemoryParams{
@@ -173,3 +198,96 @@ func (c *Client) FreeOSMemory(ctx context.Context, host string) error {
 	_, err := c.agentOps.FreeOSMemory(&p)
 	return errors.Wrap(err, "free OS memory")
 }
+
+// RestartScylla asks the agent to restart the Scylla service on the host.
+// The call returns once the restart is queued, it does not wait for Scylla
+// to start. The operation is not retried to avoid restarting the node twice.
+func (c *Client) RestartScylla(ctx context.Context, host string) error {
+	p := operations.RestartScyllaParams{
+		Context: forceHost(noRetry(ctx), host),
+	}
+	_, err := c.agentOps.RestartScylla(&p)
+	return errors.Wrap(err, "restart scylla")
+}
+
+// HookResult is the result of a hook run by the agent.
+type HookResult models.HookResult
+
+// hookTimeout is the upper bound of a hook run, the agent kills hooks after
+// the timeout set in the agent configuration.
+const hookTimeout = time.Hour
+
+// RunHook asks the agent to run the named executable from the hooks directory
+// on the host. Non-zero exit code of the hook is not an error, it's reported
+// in the result. The operation is not retried to avoid running the hook twice.
+func (c *Client) RunHook(ctx context.Context, host, name, stage, snapshotTag string) (*HookResult, error) {
+	p := operations.RunHookParams{
+		Context: customTimeout(forceHost(noRetry(ctx), host), hookTimeout),
+		Hook: &models.Hook{
+			Name:        name,
+			Stage:       stage,
+			SnapshotTag: snapshotTag,
+		},
+	}
+	resp, err := c.agentOps.RunHook(&p)
+	if err != nil {
+		return nil, errors.Wrapf(err, "run hook %s", name)
+	}
+	return (*HookResult)(resp.Payload), nil
+}
+
+// upgradeTimeout is the upper bound of downloading and verifying the agent
+// binary.
+const upgradeTimeout = 10 * time.Minute
+
+// UpgradeAgent asks the agent to download the named binary from the upgrade
+// location configured in the agent, verify it against the sha256 checksum
+// and replace the agent binary with it. The agent keeps running the old
+// version until it is terminated. It returns version of the new binary.
+func (c *Client) UpgradeAgent(ctx context.Context, host, name, sha256 string) (string, error) {
+	p := operations.UpgradeParams{
+		Context: customTimeout(forceHost(noRetry(ctx), host), upgradeTimeout),
+		Upgrade: &models.Upgrade{
+			Name:   name,
+			Sha256: sha256,
+		},
+	}
+	resp, err := c.agentOps.Upgrade(&p)
+	if err != nil {
+		return "", errors.Wrapf(err, "upgrade agent to %s", name)
+	}
+	return resp.Payload.Version, nil
+}
+
+// TerminateAgent asks the agent on the host to terminate. If the agent binary
+// was upgraded the agent executes the new binary.
+func (c *Client) TerminateAgent(ctx context.Context, host string) error {
+	p := operations.TerminateParams{
+		Context: forceHost(noRetry(ctx), host),
+	}
+	_, err := c.agentOps.Terminate(&p)
+	return errors.Wrap(err, "terminate agent")
+}
+
+// ReloadAgentConfig asks the agent on the host to reload its configuration
+// files. Options that require agent restart are not changed.
+func (c *Client) ReloadAgentConfig(ctx context.Context, host string) error {
+	p := operations.ReloadParams{
+		Context: forceHost(ctx, host),
+	}
+	_, err := c.agentOps.Reload(&p)
+	return errors.Wrap(err, "reload agent config")
+}
+
+// AgentConfig returns the current configuration of the agent on the host
+// in YAML format with secrets redacted.
+func (c *Client) AgentConfig(ctx context.Context, host string) (string, error) {
+	p := operations.AgentConfigParams{
+		Context: forceHost(ctx, host),
+	}
+	resp, err := c.agentOps.AgentConfig(&p)
+	if err != nil {
+		return "", errors.Wrap(err, "agent config")
+	}
+	return resp.Payload.Config, nil
+}
@@ -10,7 +10,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/rclone/rcserver"
 	"github.com/scylladb/scylla-manager/pkg/util/pointer"
@@ -21,12 +23,32 @@ import (
 
 // RcloneSetBandwidthLimit sets bandwidth limit of all the current and future
 // transfers performed under current client session.
+// If jobID is not 0 the limit is set only for the running job started with
+// a limit by RcloneMoveDirWithOptions or RcloneCopyDirWithOptions, the job
+// limit applies on top of the global limit.
 // Limit is expressed in MiB per second.
 // To turn off limitation set it to 0.
-func (c *Client) RcloneSetBandwidthLimit(ctx context.Context, host string, limit int) error {
+func (c *Client) RcloneSetBandwidthLimit(ctx context.Context, host string, jobID int64, limit int) error {
+	p := operations.CoreBwlimitParams{
+		Context: forceHost(ctx, host),
+		BandwidthRate: &models.Bandwidth{
+			Jobid: jobID,
+			Rate:  fmt.Sprintf("%dM", limit),
+		},
+	}
+	_, err := c.agentOps.CoreBwlimit(&p) // nolint: errcheck
+	return err
+}
+
+// RcloneSetBandwidthLimits sets separate upload and download bandwidth limits
+// of all the current and future transfers performed under current client
+// session.
+// Limits are expressed in MiB per second.
+// To turn off limitation in a given direction set it to 0.
+func (c *Client) RcloneSetBandwidthLimits(ctx context.Context, host string, upload, download int) error {
 	p := operations.CoreBwlimitParams{
 		Context:       forceHost(ctx, host),
-		BandwidthRate: &models.Bandwidth{Rate: fmt.Sprintf("%dM", limit)},
+		BandwidthRate: &models.Bandwidth{Rate: fmt.Sprintf("%dM:%dM", upload, download)},
 	}
 	_, err := c.agentOps.CoreBwlimit(&p) // nolint: errcheck
 	return err
@@ -175,7 +197,25 @@ func (c *Client) RcloneMoveFile(ctx context.Context, host, dstRemotePath, srcRem
 // Returns ID of the asynchronous job.
 // Remote path format is "name:bucket/path".
 func (c *Client) RcloneMoveDir(ctx context.Context, host, dstRemotePath, srcRemotePath string) (int64, error) {
-	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, true)
+	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, RcloneUploadOptions{}, true)
+}
+
+// RcloneUploadOptions specifies storage class and metadata of the objects
+// uploaded by move or copy dir jobs, and bandwidth limit of the job.
+// Empty values are not set.
+type RcloneUploadOptions struct {
+	StorageClass string
+	Metadata     map[string]string
+	// Limit is bandwidth limit of the job in MiB per second, if it's set
+	// the limit can be changed with RcloneSetBandwidthLimit.
+	Limit int
+}
+
+// RcloneMoveDirWithOptions works like RcloneMoveDir but the uploaded objects
+// are stored with the given storage class and metadata, and the job bandwidth
+// is limited if limit is set.
+func (c *Client) RcloneMoveDirWithOptions(ctx context.Context, host, dstRemotePath, srcRemotePath string, opts RcloneUploadOptions) (int64, error) {
+	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, opts, true)
 }
 
 // RcloneCopyDir copies contents of the directory pointed by srcRemotePath to
@@ -184,10 +224,17 @@ func (c *Client) RcloneMoveDir(ctx context.Context, host, dstRemotePath, srcRemo
 // Returns ID of the asynchronous job.
 // Remote path format is "name:bucket/path".
 func (c *Client) RcloneCopyDir(ctx context.Context, host, dstRemotePath, srcRemotePath string) (int64, error) {
-	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, false)
+	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, RcloneUploadOptions{}, false)
+}
+
+// RcloneCopyDirWithOptions works like RcloneCopyDir but the uploaded objects
+// are stored with the given storage class and metadata, and the job bandwidth
+// is limited if limit is set.
+func (c *Client) RcloneCopyDirWithOptions(ctx context.Context, host, dstRemotePath, srcRemotePath string, opts RcloneUploadOptions) (int64, error) {
+	return c.rcloneMoveOrCopyDir(ctx, host, dstRemotePath, srcRemotePath, opts, false)
 }
 
-func (c *Client) rcloneMoveOrCopyDir(ctx context.Context, host, dstRemotePath, srcRemotePath string, doMove bool) (int64, error) {
+func (c *Client) rcloneMoveOrCopyDir(ctx context.Context, host, dstRemotePath, srcRemotePath string, opts RcloneUploadOptions, doMove bool) (int64, error) {
 	dstFs, dstRemote, err := rcloneSplitRemotePath(dstRemotePath)
 	if err != nil {
 		return 0, err
@@ -201,6 +248,12 @@ func (c *Client) rcloneMoveOrCopyDir(ctx context.Context, host, dstRemotePath, s
 		DstRemote: dstRemote,
 		SrcFs:     srcFs,
 		SrcRemote: srcRemote,
+
+		StorageClass: opts.StorageClass,
+		Metadata:     opts.Metadata,
+	}
+	if opts.Limit > 0 {
+		m.Bwlimit = fmt.Sprintf("%dM", opts.Limit)
 	}
 
 	var jobID int64
@@ -270,6 +323,56 @@ func (c *Client) RcloneDeleteFile(ctx context.Context, host, remotePath string)
 	return err
 }
 
+// objectLockTimeout is the maximal time of locking or checking lock of files
+// in a directory, files are processed one by one.
+const objectLockTimeout = time.Hour
+
+// RcloneLock makes files in the remote directory immutable until retainUntil.
+// Mode is the S3 retention mode, if empty GOVERNANCE mode is used.
+// Remote path format is "name:bucket/path".
+func (c *Client) RcloneLock(ctx context.Context, host, remotePath string, files []string, mode string, retainUntil time.Time) error {
+	fs, remote, err := rcloneSplitRemotePath(remotePath)
+	if err != nil {
+		return err
+	}
+	p := operations.OperationsLockParams{
+		Context: customTimeout(forceHost(ctx, host), objectLockTimeout),
+		ObjectLockOptions: &models.ObjectLockOptions{
+			Fs:          fs,
+			Remote:      remote,
+			Files:       files,
+			Mode:        mode,
+			RetainUntil: strfmt.DateTime(retainUntil),
+		},
+	}
+	_, err = c.agentOps.OperationsLock(&p)
+	return err
+}
+
+// RcloneUnlocked returns files in the remote directory that are not immutable
+// until retainUntil.
+// Remote path format is "name:bucket/path".
+func (c *Client) RcloneUnlocked(ctx context.Context, host, remotePath string, files []string, retainUntil time.Time) ([]string, error) {
+	fs, remote, err := rcloneSplitRemotePath(remotePath)
+	if err != nil {
+		return nil, err
+	}
+	p := operations.OperationsLockStatusParams{
+		Context: customTimeout(forceHost(ctx, host), objectLockTimeout),
+		ObjectLockOptions: &models.ObjectLockOptions{
+			Fs:          fs,
+			Remote:      remote,
+			Files:       files,
+			RetainUntil: strfmt.DateTime(retainUntil),
+		},
+	}
+	resp, err := c.agentOps.OperationsLockStatus(&p)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload.Unlocked, nil
+}
+
 // RcloneDiskUsage get disk space usage.
 // Remote path format is "name:bucket/path".
 func (c *Client) RcloneDiskUsage(ctx context.Context, host, remotePath string) (*models.FileSystemDetails, error) {
@@ -116,11 +116,11 @@ func TestRcloneStoppingTransferIntegration(t *testing.T) {
 
 	ctx := context.Background()
 
-	if err := client.RcloneSetBandwidthLimit(ctx, testHost, 1); err != nil {
+	if err := client.RcloneSetBandwidthLimit(ctx, testHost, 0, 1); err != nil {
 		t.Fatal(err)
 	}
 	defer func() {
-		if err := client.RcloneSetBandwidthLimit(ctx, testHost, 0); err != nil {
+		if err := client.RcloneSetBandwidthLimit(ctx, testHost, 0, 0); err != nil {
 			t.Fatal(err)
 		}
 	}()
@@ -168,6 +168,24 @@ func TestRcloneStoppingTransferIntegration(t *testing.T) {
 	}
 }
 
+func TestRcloneCheckPermissionsSFTPAndWebDAVAgentIntegration(t *testing.T) {
+	client, err := scyllaclient.NewClient(scyllaclient.TestConfig(ManagedClusterHosts(), AgentAuthToken()), log.NewDevelopment())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testHost := ManagedClusterHost()
+	ctx := context.Background()
+
+	for _, l := range []string{"sftp:backup", "webdav:backup"} {
+		t.Run(l, func(t *testing.T) {
+			if err := client.RcloneCheckPermissions(ctx, testHost, l); err != nil {
+				t.Fatal("RcloneCheckPermissions() error", err)
+			}
+		})
+	}
+}
+
 const scyllaDataDir = "/var/lib/scylla/data"
 
 func injectDataDir(cmd string) string {
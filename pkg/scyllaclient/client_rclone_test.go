@@ -5,7 +5,9 @@ package scyllaclient_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -495,3 +497,67 @@ func TestRclonePut(t *testing.T) {
 		t.Fatalf("put/a = %s, expected %s", string(content), "hello")
 	}
 }
+
+func TestRcloneJobBandwidthLimit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		paths  []string
+		bodies []map[string]interface{}
+	)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error("Decode() error", err)
+		}
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/copydir") {
+			io.WriteString(w, `{"jobid": 7}`)
+		} else {
+			io.WriteString(w, `{"rate": "1M"}`)
+		}
+	})
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerWithHandler(t, h)
+	defer closeServer()
+
+	ctx := context.Background()
+
+	if _, err := client.RcloneCopyDir(ctx, scyllaclienttest.TestHost, "s3:a", "data:b"); err != nil {
+		t.Fatal("RcloneCopyDir() error", err)
+	}
+	jobID, err := client.RcloneCopyDirWithOptions(ctx, scyllaclienttest.TestHost, "s3:a", "data:b", scyllaclient.RcloneUploadOptions{Limit: 10})
+	if err != nil {
+		t.Fatal("RcloneCopyDirWithOptions() error", err)
+	}
+	if err := client.RcloneSetBandwidthLimit(ctx, scyllaclienttest.TestHost, jobID, 1); err != nil {
+		t.Fatal("RcloneSetBandwidthLimit() error", err)
+	}
+	if err := client.RcloneSetBandwidthLimit(ctx, scyllaclienttest.TestHost, 0, 1); err != nil {
+		t.Fatal("RcloneSetBandwidthLimit() error", err)
+	}
+
+	golden := []struct {
+		Path  string
+		Key   string
+		Value interface{}
+	}{
+		{Path: "/agent/rclone/sync/copydir", Key: "bwlimit", Value: nil},
+		{Path: "/agent/rclone/sync/copydir", Key: "bwlimit", Value: "10M"},
+		{Path: "/agent/rclone/core/bwlimit", Key: "jobid", Value: float64(7)},
+		{Path: "/agent/rclone/core/bwlimit", Key: "jobid", Value: nil},
+	}
+	if len(paths) != len(golden) {
+		t.Fatalf("Got %d requests, expected %d", len(paths), len(golden))
+	}
+	for i, g := range golden {
+		if paths[i] != g.Path {
+			t.Errorf("Request %d path %s, expected %s", i, paths[i], g.Path)
+		}
+		if v := bodies[i][g.Key]; v != g.Value {
+			t.Errorf("Request %d %s=%v, expected %v", i, g.Key, v, g.Value)
+		}
+	}
+}
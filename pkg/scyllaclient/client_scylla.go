@@ -4,7 +4,10 @@ package scyllaclient
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
 	"runtime"
 	"sort"
@@ -177,6 +180,32 @@ func (c *Client) HostIDs(ctx context.Context) (map[string]string, error) {
 	return v, nil
 }
 
+// SchemaVersions returns a mapping from schema version to hosts using that
+// version. Unreachable hosts are reported under "UNREACHABLE" version.
+func (c *Client) SchemaVersions(ctx context.Context) (map[string][]string, error) {
+	resp, err := c.scyllaOps.StorageProxySchemaVersionsGet(&operations.StorageProxySchemaVersionsGetParams{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	v := make(map[string][]string, len(resp.Payload))
+	for i := 0; i < len(resp.Payload); i++ {
+		v[resp.Payload[i].Key] = resp.Payload[i].Value
+	}
+	return v, nil
+}
+
+// GossiperEndpointDown returns a list of endpoints that host considers down.
+func (c *Client) GossiperEndpointDown(ctx context.Context, host string) ([]string, error) {
+	resp, err := c.scyllaOps.GossiperEndpointDownGet(&operations.GossiperEndpointDownGetParams{
+		Context: forceHost(ctx, host),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
 // CheckHostsChanged returns true iff a host was added or removed from cluster.
 // In such a case the client should be discarded.
 func (c *Client) CheckHostsChanged(ctx context.Context) (bool, error) {
@@ -603,6 +632,79 @@ func (c *Client) SnapshotDetails(ctx context.Context, host, tag string) ([]Unit,
 	return s, nil
 }
 
+// SnapshotInfo describes a snapshot present on a host.
+type SnapshotInfo struct {
+	Tag       string
+	Keyspaces []string
+	// Size is the total size of the snapshot files.
+	Size int64
+	// TrueSize is the size of the snapshot files that are not shared with
+	// the live SSTables, it's the disk space freed when the snapshot is
+	// deleted.
+	TrueSize int64
+}
+
+// SnapshotsInfo lists snapshots available on the host with keyspaces they
+// contain and their sizes, snapshots are sorted by tag.
+func (c *Client) SnapshotsInfo(ctx context.Context, host string) ([]SnapshotInfo, error) {
+	ctx = customTimeout(ctx, snapshotTimeout)
+
+	resp, err := c.scyllaOps.StorageServiceSnapshotsGet(&operations.StorageServiceSnapshotsGetParams{
+		Context: forceHost(ctx, host),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []SnapshotInfo
+	for _, p := range resp.Payload {
+		si := SnapshotInfo{
+			Tag: p.Key,
+		}
+		keyspaces := strset.New()
+		for _, v := range p.Value {
+			keyspaces.Add(v.Ks)
+			si.Size += sizeOf(v.Total)
+			si.TrueSize += sizeOf(v.Live)
+		}
+		si.Keyspaces = keyspaces.List()
+		sort.Strings(si.Keyspaces)
+		out = append(out, si)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Tag < out[j].Tag
+	})
+
+	return out, nil
+}
+
+// SnapshotsTrueSize returns the disk space taken by all the snapshots on
+// the host that would be freed if the snapshots were deleted.
+func (c *Client) SnapshotsTrueSize(ctx context.Context, host string) (int64, error) {
+	ctx = customTimeout(ctx, snapshotTimeout)
+
+	resp, err := c.scyllaOps.StorageServiceSnapshotsSizeTrueGet(&operations.StorageServiceSnapshotsSizeTrueGetParams{
+		Context: forceHost(ctx, host),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sizeOf(resp.Payload), nil
+}
+
+// sizeOf converts a size decoded from an untyped JSON number to int64.
+func sizeOf(v interface{}) int64 {
+	switch n := v.(type) {
+	case json.Number:
+		i, _ := n.Int64() // nolint: errcheck
+		return i
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
 // TakeSnapshot flushes and takes a snapshot of a keyspace.
 // Multiple keyspaces may have the same tag.
 // Flush is taken care of by Scylla, see table::snapshot for details.
@@ -671,6 +773,176 @@ func (c *Client) DeleteTableSnapshot(ctx context.Context, host, tag, keyspace, t
 	return err
 }
 
+// cleanupTimeout is the maximal time to wait for keyspace cleanup to finish,
+// cleanup is a synchronous operation that rewrites all the SSTables of
+// a keyspace on a node.
+const cleanupTimeout = 24 * time.Hour
+
+// KeyspaceCleanup removes data that no longer belongs to the host from
+// keyspace SSTables. If tables are specified cleanup is restricted to the
+// tables. The call blocks until cleanup is done. The operation is not retried
+// to avoid running cleanup again, retries are left to the caller.
+func (c *Client) KeyspaceCleanup(ctx context.Context, host, keyspace string, tables ...string) error {
+	ctx = customTimeout(ctx, cleanupTimeout)
+	ctx = noRetry(ctx)
+
+	var cf *string
+	if len(tables) > 0 {
+		cf = pointer.StringPtr(strings.Join(tables, ","))
+	}
+
+	_, err := c.scyllaOps.StorageServiceKeyspaceCleanupByKeyspacePost(&operations.StorageServiceKeyspaceCleanupByKeyspacePostParams{ // nolint: errcheck
+		Context:  forceHost(ctx, host),
+		Keyspace: keyspace,
+		Cf:       cf,
+	})
+	return err
+}
+
+// compactionTimeout is the maximal time to wait for major compaction of
+// a table to finish, compaction is a synchronous operation that rewrites
+// all the SSTables of a table on a node.
+const compactionTimeout = 24 * time.Hour
+
+// TableMajorCompaction runs major compaction of the table on the host,
+// all the SSTables of the table are merged into one. The call blocks until
+// compaction is done. The operation is not retried to avoid running
+// compaction again, retries are left to the caller.
+func (c *Client) TableMajorCompaction(ctx context.Context, host, keyspace, table string) error {
+	ctx = customTimeout(ctx, compactionTimeout)
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaOps.ColumnFamilyMajorCompactionByNamePost(&operations.ColumnFamilyMajorCompactionByNamePostParams{ // nolint: errcheck
+		Context: forceHost(ctx, host),
+		Name:    keyspace + ":" + table,
+	})
+	return err
+}
+
+// scrubTimeout is the maximal time to wait for keyspace scrub or SSTables
+// upgrade to finish, both are synchronous operations that rewrite or read
+// all the SSTables of a keyspace on a node.
+const scrubTimeout = 24 * time.Hour
+
+// ScrubMode specifies how scrub handles corrupted data.
+type ScrubMode string
+
+// ScrubMode enumeration.
+const (
+	// ScrubAbort aborts scrub on the first corrupted SSTable.
+	ScrubAbort ScrubMode = "ABORT"
+	// ScrubSkip skips corrupted data, it's removed from the SSTables.
+	ScrubSkip ScrubMode = "SKIP"
+	// ScrubSegregate splits out of order data into separate SSTables.
+	ScrubSegregate ScrubMode = "SEGREGATE"
+	// ScrubValidate reads SSTables and reports corruption without
+	// rewriting them.
+	ScrubValidate ScrubMode = "VALIDATE"
+)
+
+// ScrubStatus is the outcome of a scrub reported by Scylla.
+type ScrubStatus int32
+
+// ScrubStatus enumeration.
+const (
+	ScrubSuccessful       ScrubStatus = 0
+	ScrubAborted          ScrubStatus = 1
+	ScrubUnableToCancel   ScrubStatus = 2
+	ScrubValidationErrors ScrubStatus = 3
+)
+
+func (s ScrubStatus) String() string {
+	switch s {
+	case ScrubSuccessful:
+		return "successful"
+	case ScrubAborted:
+		return "aborted"
+	case ScrubUnableToCancel:
+		return "unable to cancel"
+	case ScrubValidationErrors:
+		return "validation errors"
+	default:
+		return fmt.Sprintf("unknown status %d", int32(s))
+	}
+}
+
+// KeyspaceScrub scrubs keyspace SSTables on the host. If tables are specified
+// scrub is restricted to the tables. Scylla snapshot before scrub is disabled,
+// callers are expected to take a snapshot if needed. The call blocks until
+// scrub is done. The operation is not retried to avoid running scrub again,
+// retries are left to the caller.
+func (c *Client) KeyspaceScrub(ctx context.Context, host, keyspace string, mode ScrubMode, tables ...string) (ScrubStatus, error) {
+	ctx = customTimeout(ctx, scrubTimeout)
+	ctx = noRetry(ctx)
+	// The scrub_mode parameter is not in the API spec, Scylla versions that
+	// do not support it ignore it and use skip_corrupted.
+	ctx = withQueryParams(ctx, url.Values{"scrub_mode": []string{string(mode)}})
+
+	var cf *string
+	if len(tables) > 0 {
+		cf = pointer.StringPtr(strings.Join(tables, ","))
+	}
+
+	resp, err := c.scyllaOps.StorageServiceKeyspaceScrubByKeyspaceGet(&operations.StorageServiceKeyspaceScrubByKeyspaceGetParams{
+		Context:         forceHost(ctx, host),
+		Keyspace:        keyspace,
+		Cf:              cf,
+		SkipCorrupted:   pointer.BoolPtr(mode == ScrubSkip),
+		DisableSnapshot: pointer.BoolPtr(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return ScrubStatus(resp.Payload), nil
+}
+
+// KeyspaceUpgradeSSTables rewrites keyspace SSTables on the host to the current
+// SSTable format. If excludeCurrentVersion is set SSTables already in the
+// current format are not rewritten. If tables are specified upgrade is
+// restricted to the tables. The call blocks until upgrade is done. The
+// operation is not retried to avoid running upgrade again, retries are left
+// to the caller.
+func (c *Client) KeyspaceUpgradeSSTables(ctx context.Context, host, keyspace string, excludeCurrentVersion bool, tables ...string) error {
+	ctx = customTimeout(ctx, scrubTimeout)
+	ctx = noRetry(ctx)
+
+	var cf *string
+	if len(tables) > 0 {
+		cf = pointer.StringPtr(strings.Join(tables, ","))
+	}
+
+	_, err := c.scyllaOps.StorageServiceKeyspaceUpgradeSstablesByKeyspaceGet(&operations.StorageServiceKeyspaceUpgradeSstablesByKeyspaceGetParams{ // nolint: errcheck
+		Context:               forceHost(ctx, host),
+		Keyspace:              keyspace,
+		Cf:                    cf,
+		ExcludeCurrentVersion: pointer.BoolPtr(excludeCurrentVersion),
+	})
+	return err
+}
+
+// CompactionType specifies type of compaction that can be stopped.
+type CompactionType string
+
+// CompactionType enumeration.
+const (
+	MajorCompaction   CompactionType = "COMPACTION"
+	CleanupCompaction CompactionType = "CLEANUP"
+	ScrubCompaction   CompactionType = "SCRUB"
+	UpgradeCompaction CompactionType = "UPGRADE"
+)
+
+// StopCompaction stops all compactions of a given type running on a host,
+// the operation is not retried to avoid side effects of a deferred stop.
+func (c *Client) StopCompaction(ctx context.Context, host string, compactionType CompactionType) error {
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaOps.CompactionManagerStopCompactionPost(&operations.CompactionManagerStopCompactionPostParams{ // nolint: errcheck
+		Context: forceHost(ctx, host),
+		Type:    string(compactionType),
+	})
+	return err
+}
+
 // TableDiskSize returns total on disk size of the table in bytes.
 func (c *Client) TableDiskSize(ctx context.Context, host, keyspace, table string) (int64, error) {
 	resp, err := c.scyllaOps.ColumnFamilyMetricsTotalDiskSpaceUsedByNameGet(&operations.ColumnFamilyMetricsTotalDiskSpaceUsedByNameGetParams{
@@ -683,6 +955,36 @@ func (c *Client) TableDiskSize(ctx context.Context, host, keyspace, table string
 	return resp.Payload, nil
 }
 
+// TableSSTableCount returns number of live SSTables of the table.
+func (c *Client) TableSSTableCount(ctx context.Context, host, keyspace, table string) (int64, error) {
+	resp, err := c.scyllaOps.ColumnFamilyMetricsLiveSsTableCountByNameGet(&operations.ColumnFamilyMetricsLiveSsTableCountByNameGetParams{
+		Context: forceHost(ctx, host),
+		Name:    keyspace + ":" + table,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(resp.Payload), nil
+}
+
+// drainTimeout is the maximal time to wait for drain to finish, drain flushes
+// all the memtables to disk.
+const drainTimeout = 10 * time.Minute
+
+// Drain flushes memtables and stops accepting client and inter-node
+// connections on the host, it's done before the node is restarted.
+// The operation is not retried as a drained node does not respond to
+// the repeated call.
+func (c *Client) Drain(ctx context.Context, host string) error {
+	ctx = customTimeout(ctx, drainTimeout)
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaOps.StorageServiceDrainPost(&operations.StorageServiceDrainPostParams{ // nolint: errcheck
+		Context: forceHost(ctx, host),
+	})
+	return err
+}
+
 // TableNotExistsRegex matches error messages returned by Scylla when there is no such table.
 var TableNotExistsRegex = regexp.MustCompile("^No column family|^Column family .* not found$|^Keyspace .* Does not exist")
 
@@ -788,6 +1090,16 @@ func (t HostKeyspaceTables) Hosts() []string {
 
 // TableDiskSizeReport returns total on disk size of tables in bytes.
 func (c *Client) TableDiskSizeReport(ctx context.Context, hostKeyspaceTables HostKeyspaceTables) ([]int64, error) {
+	return c.tableReport(ctx, hostKeyspaceTables, "Table disk size", c.TableDiskSize)
+}
+
+// TableSSTableCountReport returns number of live SSTables of tables.
+func (c *Client) TableSSTableCountReport(ctx context.Context, hostKeyspaceTables HostKeyspaceTables) ([]int64, error) {
+	return c.tableReport(ctx, hostKeyspaceTables, "Table SSTable count", c.TableSSTableCount)
+}
+
+func (c *Client) tableReport(ctx context.Context, hostKeyspaceTables HostKeyspaceTables, msg string,
+	f func(ctx context.Context, host, keyspace, table string) (int64, error)) ([]int64, error) {
 	// Get shard count of a first node to estimate parallelism limit
 	shards, err := c.ShardCount(ctx, "")
 	if err != nil {
@@ -802,18 +1114,18 @@ func (c *Client) TableDiskSizeReport(ctx context.Context, hostKeyspaceTables Hos
 	err = parallel.Run(len(hostKeyspaceTables), limit, func(i int) error {
 		v := hostKeyspaceTables[i]
 
-		size, err := c.TableDiskSize(ctx, v.Host, v.Keyspace, v.Table)
+		value, err := f(ctx, v.Host, v.Keyspace, v.Table)
 		if err != nil {
 			return parallel.Abort(errors.Wrapf(err, v.Host))
 		}
-		c.logger.Debug(ctx, "Table disk size",
+		c.logger.Debug(ctx, msg,
 			"host", v.Host,
 			"keyspace", v.Keyspace,
 			"table", v.Table,
-			"size", size,
+			"value", value,
 		)
 
-		report[i] = size
+		report[i] = value
 		return nil
 	})
 
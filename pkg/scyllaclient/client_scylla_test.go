@@ -127,6 +127,27 @@ func TestHostIDs(t *testing.T) {
 	}
 }
 
+func TestClientSchemaVersions(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServer(t, "testdata/scylla_api/storage_proxy_schema_versions.json")
+	defer closeServer()
+
+	golden := map[string][]string{
+		"1b9a9d1b-5a4f-3a0e-9c43-7dd8f0d0a6a1": {"192.168.100.11", "192.168.100.12"},
+		"UNREACHABLE":                          {"192.168.100.13"},
+	}
+
+	v, err := client.SchemaVersions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(v, golden); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
 func TestCheckHostsChanged(t *testing.T) {
 	t.Parallel()
 
@@ -396,6 +417,140 @@ func TestClientKillAllRepairs(t *testing.T) {
 	}
 }
 
+func TestClientKeyspaceCleanup(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerRequestChecker(t, "testdata/scylla_api/storage_service_keyspace_cleanup.json", func(t *testing.T, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %s, expected POST", r.Method)
+		}
+		if r.URL.Path != "/storage_service/keyspace_cleanup/ks" {
+			t.Errorf("Path = %s", r.URL.Path)
+		}
+		if cf := r.Form.Get("cf"); cf != "t1,t2" {
+			t.Errorf("cf = %s, expected t1,t2", cf)
+		}
+	})
+	defer closeServer()
+
+	if err := client.KeyspaceCleanup(context.Background(), scyllaclienttest.TestHost, "ks", "t1", "t2"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientTableMajorCompaction(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerRequestChecker(t, "testdata/scylla_api/column_family_major_compaction.json", func(t *testing.T, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %s, expected POST", r.Method)
+		}
+		if r.URL.Path != "/column_family/major_compaction/ks:t1" {
+			t.Errorf("Path = %s", r.URL.Path)
+		}
+	})
+	defer closeServer()
+
+	if err := client.TableMajorCompaction(context.Background(), scyllaclienttest.TestHost, "ks", "t1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientKeyspaceScrub(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Mode          scyllaclient.ScrubMode
+		SkipCorrupted string
+	}{
+		{
+			Mode:          scyllaclient.ScrubValidate,
+			SkipCorrupted: "false",
+		},
+		{
+			Mode:          scyllaclient.ScrubSkip,
+			SkipCorrupted: "true",
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(string(test.Mode), func(t *testing.T) {
+			t.Parallel()
+
+			client, closeServer := scyllaclienttest.NewFakeScyllaServerRequestChecker(t, "testdata/scylla_api/storage_service_keyspace_scrub.json", func(t *testing.T, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Method = %s, expected GET", r.Method)
+				}
+				if r.URL.Path != "/storage_service/keyspace_scrub/ks" {
+					t.Errorf("Path = %s", r.URL.Path)
+				}
+				if cf := r.Form.Get("cf"); cf != "t1,t2" {
+					t.Errorf("cf = %s, expected t1,t2", cf)
+				}
+				if m := r.Form.Get("scrub_mode"); m != string(test.Mode) {
+					t.Errorf("scrub_mode = %s, expected %s", m, test.Mode)
+				}
+				if s := r.Form.Get("skip_corrupted"); s != test.SkipCorrupted {
+					t.Errorf("skip_corrupted = %s, expected %s", s, test.SkipCorrupted)
+				}
+				if d := r.Form.Get("disable_snapshot"); d != "true" {
+					t.Errorf("disable_snapshot = %s, expected true", d)
+				}
+			})
+			defer closeServer()
+
+			status, err := client.KeyspaceScrub(context.Background(), scyllaclienttest.TestHost, "ks", test.Mode, "t1", "t2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status != scyllaclient.ScrubSuccessful {
+				t.Fatalf("KeyspaceScrub() = %s, expected %s", status, scyllaclient.ScrubSuccessful)
+			}
+		})
+	}
+}
+
+func TestClientKeyspaceUpgradeSSTables(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerRequestChecker(t, "testdata/scylla_api/storage_service_keyspace_upgrade_sstables.json", func(t *testing.T, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Method = %s, expected GET", r.Method)
+		}
+		if r.URL.Path != "/storage_service/keyspace_upgrade_sstables/ks" {
+			t.Errorf("Path = %s", r.URL.Path)
+		}
+		if e := r.Form.Get("exclude_current_version"); e != "true" {
+			t.Errorf("exclude_current_version = %s, expected true", e)
+		}
+	})
+	defer closeServer()
+
+	if err := client.KeyspaceUpgradeSSTables(context.Background(), scyllaclienttest.TestHost, "ks", true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientDrain(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerRequestChecker(t, "testdata/scylla_api/storage_service_drain.json", func(t *testing.T, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %s, expected POST", r.Method)
+		}
+		if r.URL.Path != "/storage_service/drain" {
+			t.Errorf("Path = %s", r.URL.Path)
+		}
+	})
+	defer closeServer()
+
+	if err := client.Drain(context.Background(), scyllaclienttest.TestHost); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestClientSnapshotDetails(t *testing.T) {
 	t.Parallel()
 
@@ -423,6 +578,51 @@ func TestClientSnapshotDetails(t *testing.T) {
 	}
 }
 
+func TestClientSnapshotsInfo(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServer(t, "testdata/scylla_api/storage_service_snapshots.json")
+	defer closeServer()
+
+	v, err := client.SnapshotsInfo(context.Background(), scyllaclienttest.TestHost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tags []string
+	for _, si := range v {
+		tags = append(tags, si.Tag)
+	}
+	goldenTags := []string{"1566311471831", "1566311486006", "aa", "sm_4d043260-c352-11e9-a72e-c85b76f42222"}
+	if diff := cmp.Diff(tags, goldenTags); diff != "" {
+		t.Fatal(diff)
+	}
+
+	golden := scyllaclient.SnapshotInfo{
+		Tag:       "1566311486006",
+		Keyspaces: []string{"system_auth"},
+		Size:      4871,
+	}
+	if diff := cmp.Diff(v[1], golden); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestClientSnapshotsTrueSize(t *testing.T) {
+	t.Parallel()
+
+	client, closeServer := scyllaclienttest.NewFakeScyllaServer(t, "testdata/scylla_api/storage_service_snapshots_size_true.json")
+	defer closeServer()
+
+	v, err := client.SnapshotsTrueSize(context.Background(), scyllaclienttest.TestHost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 1024 {
+		t.Fatalf("SnapshotsTrueSize() = %d, expected 1024", v)
+	}
+}
+
 func TestClientTableDiskSize(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ package scyllaclient
 
 import (
 	"context"
+	"net/url"
 	"time"
 )
 
@@ -17,6 +18,7 @@ const (
 	ctxNoRetry
 	ctxCustomTimeout
 	ctxShouldRetryHandler
+	ctxQueryParams
 )
 
 // Interactive context means that it should be processed fast without too much
@@ -74,3 +76,9 @@ func shouldRetryHandler(ctx context.Context) shouldRetryHandlerFunc {
 	f, _ := ctx.Value(ctxShouldRetryHandler).(shouldRetryHandlerFunc)
 	return f
 }
+
+// withQueryParams makes queryParams middleware add the query parameters to
+// the request, it allows for sending parameters not present in the API spec.
+func withQueryParams(ctx context.Context, q url.Values) context.Context {
+	return context.WithValue(ctx, ctxQueryParams, q)
+}
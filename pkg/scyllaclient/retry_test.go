@@ -128,6 +128,55 @@ func TestNoRetry(t *testing.T) {
 	}
 }
 
+func TestNoRetryLongRunningOperations(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name string
+		F    func(client *scyllaclient.Client, host string) error
+	}{
+		{
+			Name: "KeyspaceCleanup",
+			F: func(client *scyllaclient.Client, host string) error {
+				return client.KeyspaceCleanup(context.Background(), host, "ks")
+			},
+		},
+		{
+			Name: "TableMajorCompaction",
+			F: func(client *scyllaclient.Client, host string) error {
+				return client.TableMajorCompaction(context.Background(), host, "ks", "t1")
+			},
+		},
+		{
+			Name: "KeyspaceScrub",
+			F: func(client *scyllaclient.Client, host string) error {
+				_, err := client.KeyspaceScrub(context.Background(), host, "ks", scyllaclient.ScrubValidate)
+				return err
+			},
+		},
+		{
+			Name: "KeyspaceUpgradeSSTables",
+			F: func(client *scyllaclient.Client, host string) error {
+				return client.KeyspaceUpgradeSSTables(context.Background(), host, "ks", true)
+			},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			host, port, closeServer := scyllaclienttest.MakeServer(t, scyllaclienttest.RespondStatus(t, 999, 200))
+			defer closeServer()
+			client := scyllaclienttest.MakeClient(t, host, port, fastRetry)
+
+			if err := test.F(client, host); err == nil {
+				t.Fatalf("%s() expected error", test.Name)
+			}
+		})
+	}
+}
+
 func TestRetryCancelContext(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,37 @@
+// Copyright (C) 2017 ScyllaDB
+
+package secrets
+
+import (
+	"encoding/json"
+
+	"github.com/scylladb/scylla-manager/pkg/store"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// AgentTLSIdentity defines TLS credentials used to connect to Scylla Manager
+// Agents of a cluster. Cert and PrivateKey are the client certificate
+// presented to agents, CA is used to verify agent certificates.
+type AgentTLSIdentity struct {
+	ClusterID  uuid.UUID `json:"-"`
+	Cert       []byte    `json:"cert,omitempty"`
+	PrivateKey []byte    `json:"private_key,omitempty"`
+	CA         []byte    `json:"ca,omitempty"`
+}
+
+var _ store.Entry = &AgentTLSIdentity{}
+
+func (v *AgentTLSIdentity) Key() (clusterID uuid.UUID, key string) {
+	return v.ClusterID, "agent_tls_identity"
+}
+
+func (v *AgentTLSIdentity) MarshalBinary() (data []byte, err error) {
+	if v.Cert == nil && v.PrivateKey == nil && v.CA == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func (v *AgentTLSIdentity) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, v)
+}
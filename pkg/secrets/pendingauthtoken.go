@@ -0,0 +1,34 @@
+// Copyright (C) 2017 ScyllaDB
+
+package secrets
+
+import (
+	"github.com/scylladb/scylla-manager/pkg/store"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// PendingAuthToken is a Scylla Manager Agent auth token that the cluster is
+// being switched to, it replaces the cluster auth token once all agents
+// accept it.
+type PendingAuthToken struct {
+	ClusterID uuid.UUID
+	Token     string
+}
+
+var _ store.Entry = &PendingAuthToken{}
+
+func (v *PendingAuthToken) Key() (clusterID uuid.UUID, key string) {
+	return v.ClusterID, "pending_auth_token"
+}
+
+func (v *PendingAuthToken) MarshalBinary() (data []byte, err error) {
+	if v.Token == "" {
+		return nil, nil
+	}
+	return []byte(v.Token), nil
+}
+
+func (v *PendingAuthToken) UnmarshalBinary(data []byte) error {
+	v.Token = string(data)
+	return nil
+}
@@ -56,7 +56,69 @@ func checkAllDCsCovered(locations []Location, dcs []string) error {
 	return nil
 }
 
-func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rateLimits []DCLimit) ([]hostInfo, error) {
+// storageClassProviders lists providers that support setting storage class.
+var storageClassProviders = []Provider{S3, GCS, Azure}
+
+// checkStorageClassSupported checks if storage classes set for the DCs are
+// supported by providers of locations of the DCs.
+func checkStorageClassSupported(locations []Location, classes []DCStorageClass, dcs []string) (err error) {
+	dcl := map[string]Location{}
+	for _, l := range locations {
+		dcl[l.DC] = l
+	}
+	dcc := map[string]DCStorageClass{}
+	for _, c := range classes {
+		dcc[c.DC] = c
+	}
+
+	check := func(dc string) {
+		c, ok := dcc[dc]
+		if !ok {
+			c, ok = dcc[""]
+		}
+		if !ok {
+			return
+		}
+		l, ok := dcl[dc]
+		if !ok {
+			l, ok = dcl[""]
+		}
+		if !ok {
+			return
+		}
+		for _, p := range storageClassProviders {
+			if l.Provider == p {
+				return
+			}
+		}
+		err = multierr.Append(err, errors.Errorf("%q not supported by location %s", c, l))
+	}
+	for _, dc := range dcs {
+		check(dc)
+	}
+	return
+}
+
+// objectLockProviders lists providers that support object lock.
+var objectLockProviders = []Provider{S3, GCS, Azure}
+
+// checkObjectLockSupported checks if locations support object lock.
+func checkObjectLockSupported(locations []Location) (err error) {
+	for _, l := range locations {
+		ok := false
+		for _, p := range objectLockProviders {
+			if l.Provider == p {
+				ok = true
+			}
+		}
+		if !ok {
+			err = multierr.Append(err, errors.Errorf("object lock not supported by location %s", l))
+		}
+	}
+	return
+}
+
+func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rateLimits []DCLimit, storageClasses []DCStorageClass) ([]hostInfo, error) {
 	// DC location index
 	dcl := map[string]Location{}
 	for _, l := range locations {
@@ -69,6 +131,12 @@ func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rat
 		dcr[r.DC] = r
 	}
 
+	// DC storage class index
+	dcc := map[string]DCStorageClass{}
+	for _, c := range storageClasses {
+		dcc[c.DC] = c
+	}
+
 	var (
 		hi   = make([]hostInfo, len(nodes))
 		errs error
@@ -90,6 +158,11 @@ func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rat
 		if !ok {
 			hi[i].RateLimit = dcr[""] // no rate limit is ok, fallback to 0 - no limit
 		}
+		c, ok := dcc[h.Datacenter]
+		if !ok {
+			c = dcc[""] // no storage class is ok, fallback to provider default
+		}
+		hi[i].StorageClass = c.Class
 	}
 
 	return hi, errs
@@ -108,6 +181,28 @@ func filterDCLocations(locations []Location, dcs []string) []Location {
 	return filtered
 }
 
+// filterScheduledRateLimits takes list of ScheduledRateLimits and returns only
+// limits that belong to the provided list of datacenters.
+func filterScheduledRateLimits(limits []ScheduledRateLimit, dcs []string) []ScheduledRateLimit {
+	var filtered []ScheduledRateLimit
+	for _, l := range limits {
+		if l.DC == "" || slice.ContainsString(dcs, l.DC) {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
+func filterDCStorageClasses(classes []DCStorageClass, dcs []string) []DCStorageClass {
+	var filtered []DCStorageClass
+	for _, c := range classes {
+		if c.DC == "" || slice.ContainsString(dcs, c.DC) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
 // filterDCLimits takes list of DCLimits and returns only locations that belong
 // to the provided list of datacenters.
 func filterDCLimits(limits []DCLimit, dcs []string) []DCLimit {
@@ -120,3 +215,86 @@ func filterDCLimits(limits []DCLimit, dcs []string) []DCLimit {
 	}
 	return filtered
 }
+
+// singleReplicaNodes returns nodes holding at least one replica of every
+// token range of the units keyspaces that has a replica among the nodes.
+// Nodes holding replicas of most of the not yet covered token ranges are
+// chosen first. The result preserves order of nodes.
+func singleReplicaNodes(nodes scyllaclient.NodeStatusInfoSlice, units []Unit, rings map[string]scyllaclient.Ring) scyllaclient.NodeStatusInfoSlice {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	// Index token ranges held by every node
+	var (
+		hostRanges = make(map[string][]int, len(nodes))
+		uncovered  = make(map[int]struct{})
+		hosts      = strset.New(nodes.Hosts()...)
+		n          int
+	)
+	for _, u := range units {
+		r, ok := rings[u.Keyspace]
+		if !ok || r.Replication == scyllaclient.LocalStrategy {
+			continue
+		}
+		for _, tr := range r.Tokens {
+			for _, h := range tr.Replicas {
+				if hosts.Has(h) {
+					hostRanges[h] = append(hostRanges[h], n)
+					uncovered[n] = struct{}{}
+				}
+			}
+			n++
+		}
+	}
+
+	chosen := strset.New()
+	for len(uncovered) > 0 {
+		var (
+			best      string
+			bestCount int
+		)
+		for _, h := range nodes.Hosts() {
+			if chosen.Has(h) {
+				continue
+			}
+			c := 0
+			for _, i := range hostRanges[h] {
+				if _, ok := uncovered[i]; ok {
+					c++
+				}
+			}
+			if c > bestCount {
+				best, bestCount = h, c
+			}
+		}
+		chosen.Add(best)
+		for _, i := range hostRanges[best] {
+			delete(uncovered, i)
+		}
+	}
+
+	// Keyspaces with local replication only, take any node
+	if chosen.IsEmpty() {
+		return nodes[:1]
+	}
+
+	var out scyllaclient.NodeStatusInfoSlice
+	for _, node := range nodes {
+		if chosen.Has(node.Addr) {
+			out = append(out, node)
+		}
+	}
+	return out
+}
+
+// hostsInDC returns hosts in the given datacenter.
+func hostsInDC(hosts []hostInfo, dc string) []hostInfo {
+	var out []hostInfo
+	for _, h := range hosts {
+		if h.DC == dc {
+			out = append(out, h)
+		}
+	}
+	return out
+}
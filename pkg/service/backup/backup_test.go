@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
 )
 
@@ -140,3 +141,147 @@ func TestFilterDCLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckStorageClassSupported(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name      string
+		Locations []Location
+		Classes   []DCStorageClass
+		Error     bool
+	}{
+		{
+			Name:      "no storage class",
+			Locations: []Location{{Provider: File, Path: "backup"}},
+		},
+		{
+			Name:      "supported provider",
+			Locations: []Location{{Provider: S3, Path: "backup"}},
+			Classes:   []DCStorageClass{{Class: "STANDARD_IA"}},
+		},
+		{
+			Name:      "not supported provider",
+			Locations: []Location{{Provider: File, Path: "backup"}},
+			Classes:   []DCStorageClass{{Class: "STANDARD_IA"}},
+			Error:     true,
+		},
+		{
+			Name:      "storage class for dc with supported provider",
+			Locations: []Location{{DC: "dc1", Provider: GCS, Path: "backup"}, {DC: "dc2", Provider: File, Path: "backup"}},
+			Classes:   []DCStorageClass{{DC: "dc1", Class: "NEARLINE"}},
+		},
+		{
+			Name:      "default storage class with not supported provider in dc",
+			Locations: []Location{{DC: "dc1", Provider: GCS, Path: "backup"}, {DC: "dc2", Provider: File, Path: "backup"}},
+			Classes:   []DCStorageClass{{Class: "NEARLINE"}},
+			Error:     true,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkStorageClassSupported(test.Locations, test.Classes, []string{"dc1", "dc2"})
+			if test.Error && err == nil {
+				t.Fatal("checkStorageClassSupported() expected error")
+			}
+			if !test.Error && err != nil {
+				t.Fatal("checkStorageClassSupported() error", err)
+			}
+		})
+	}
+}
+
+func TestCheckObjectLockSupported(t *testing.T) {
+	t.Parallel()
+
+	if err := checkObjectLockSupported([]Location{{Provider: S3, Path: "backup"}, {Provider: Azure, Path: "backup"}}); err != nil {
+		t.Fatal("checkObjectLockSupported() error", err)
+	}
+	if err := checkObjectLockSupported([]Location{{Provider: GCS, Path: "backup"}, {Provider: File, Path: "backup"}}); err == nil {
+		t.Fatal("checkObjectLockSupported() expected error")
+	}
+}
+
+func TestSingleReplicaNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := scyllaclient.NodeStatusInfoSlice{
+		{Datacenter: "dc1", Addr: "a"},
+		{Datacenter: "dc1", Addr: "b"},
+		{Datacenter: "dc1", Addr: "c"},
+		{Datacenter: "dc1", Addr: "d"},
+	}
+
+	table := []struct {
+		Name   string
+		Units  []Unit
+		Rings  map[string]scyllaclient.Ring
+		Expect []string
+	}{
+		{
+			Name:  "rf2",
+			Units: []Unit{{Keyspace: "ks"}},
+			Rings: map[string]scyllaclient.Ring{
+				"ks": {
+					Replication: scyllaclient.SimpleStrategy,
+					Tokens: []scyllaclient.TokenRange{
+						{Replicas: []string{"a", "b"}},
+						{Replicas: []string{"b", "c"}},
+						{Replicas: []string{"c", "d"}},
+						{Replicas: []string{"d", "a"}},
+					},
+				},
+			},
+			Expect: []string{"a", "c"},
+		},
+		{
+			Name:  "keyspaces with different replicas",
+			Units: []Unit{{Keyspace: "ks1"}, {Keyspace: "ks2"}},
+			Rings: map[string]scyllaclient.Ring{
+				"ks1": {
+					Replication: scyllaclient.SimpleStrategy,
+					Tokens: []scyllaclient.TokenRange{
+						{Replicas: []string{"a", "b"}},
+						{Replicas: []string{"b", "c"}},
+					},
+				},
+				"ks2": {
+					Replication: scyllaclient.NetworkTopologyStrategy,
+					Tokens: []scyllaclient.TokenRange{
+						{Replicas: []string{"d", "x"}},
+						{Replicas: []string{"x"}},
+					},
+				},
+			},
+			Expect: []string{"b", "d"},
+		},
+		{
+			Name:  "local keyspace only",
+			Units: []Unit{{Keyspace: "system_schema"}},
+			Rings: map[string]scyllaclient.Ring{
+				"system_schema": {
+					Replication: scyllaclient.LocalStrategy,
+				},
+			},
+			Expect: []string{"a"},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			v := singleReplicaNodes(nodes, test.Units, test.Rings)
+			if diff := cmp.Diff(test.Expect, v.Hosts()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
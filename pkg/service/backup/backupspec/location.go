@@ -16,12 +16,15 @@ type Provider string
 
 // Provider enumeration.
 const (
-	S3    = Provider("s3")
-	GCS   = Provider("gcs")
-	Azure = Provider("azure")
+	S3     = Provider("s3")
+	GCS    = Provider("gcs")
+	Azure  = Provider("azure")
+	SFTP   = Provider("sftp")
+	WebDAV = Provider("webdav")
+	File   = Provider("file")
 )
 
-var providers = []Provider{S3, GCS, Azure}
+var providers = []Provider{S3, GCS, Azure, SFTP, WebDAV, File}
 
 // Providers returns a list of all supported providers as a list of strings.
 func Providers() []string {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/util/pathparser"
@@ -112,6 +113,17 @@ type ManifestContent struct {
 	Size        int64       `json:"size"`
 	Tokens      []int64     `json:"tokens"`
 	Schema      string      `json:"schema"`
+	// RetainUntil is set if files of the backup are immutable until the
+	// given time, such backup can't be purged before.
+	RetainUntil *time.Time `json:"retain_until,omitempty"`
+	// Partial is set if the snapshot was taken only on nodes holding a single
+	// replica of every token range, there are no manifests of other nodes.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// Locked returns true if files of the backup can't be deleted at t.
+func (m *ManifestContent) Locked(t time.Time) bool {
+	return m.RetainUntil != nil && m.RetainUntil.After(t)
 }
 
 func (m *ManifestContent) Read(r io.Reader) error {
@@ -166,6 +178,12 @@ type FilesMeta struct {
 	Version  string   `json:"version"`
 	Files    []string `json:"files"`
 	Size     int64    `json:"size"`
+	// FileSizes holds sizes of Files in the same order. Size is the total
+	// size of the table files, sizes of files are needed to tell how many
+	// bytes were added or removed between snapshots. It's not set in
+	// manifests created by older versions, for them sizes of files are
+	// estimated as the average file size of a table.
+	FileSizes []int64 `json:"file_sizes,omitempty"`
 
 	Path string `json:"path,omitempty"`
 }
@@ -15,6 +15,7 @@ const (
 	StageSchema       Stage = "SCHEMA"
 	StageUpload       Stage = "UPLOAD"
 	StageMoveManifest Stage = "MOVE_MANIFEST"
+	StageLock         Stage = "LOCK"
 	StageMigrate      Stage = "MIGRATE"
 	StagePurge        Stage = "PURGE"
 	StageDone         Stage = "DONE"
@@ -29,6 +30,7 @@ var stageOrder = []Stage{
 	StageSchema,
 	StageUpload,
 	StageMoveManifest,
+	StageLock,
 	StageMigrate,
 	StagePurge,
 	StageDone,
@@ -42,7 +44,7 @@ func StageOrder() []Stage {
 // Resumable run can be continued.
 func (s Stage) Resumable() bool {
 	switch s {
-	case StageIndex, StageManifest, StageUpload, StageMoveManifest, StageMigrate, StagePurge:
+	case StageIndex, StageManifest, StageUpload, StageMoveManifest, StageLock, StageMigrate, StagePurge:
 		return true
 	default:
 		return false
@@ -69,6 +71,7 @@ var stageName = map[Stage]string{
 	StageSchema:       "uploading schema",
 	StageUpload:       "uploading data",
 	StageMoveManifest: "moving manifests",
+	StageLock:         "locking files",
 	StageMigrate:      "migrating legacy metadata",
 	StagePurge:        "retention",
 	StageDone:         "",
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/service"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
 	"go.uber.org/multierr"
 )
 
@@ -15,6 +16,27 @@ type Config struct {
 	DiskSpaceFreeMinPercent   int           `yaml:"disk_space_free_min_percent"`
 	LongPollingTimeoutSeconds int           `yaml:"long_polling_timeout_seconds"`
 	AgeMax                    time.Duration `yaml:"age_max"`
+	// Prices are used to estimate cost of backups, there is no default.
+	Prices map[Provider]Price `yaml:"prices"`
+}
+
+// Price specifies storage and request prices of a provider in any currency.
+type Price struct {
+	// StorageGiBMonth is a price of storing 1GiB for a month in the default
+	// storage class of the provider.
+	StorageGiBMonth float64 `yaml:"storage_gib_month"`
+	// StorageClassGiBMonth overrides StorageGiBMonth for storage classes.
+	StorageClassGiBMonth map[string]float64 `yaml:"storage_class_gib_month"`
+	// Put1000Requests is a price of 1000 PUT, COPY or POST requests.
+	Put1000Requests float64 `yaml:"put_1000_requests"`
+}
+
+// Storage returns price of storing 1GiB for a month in the storage class.
+func (p Price) Storage(class string) float64 {
+	if v, ok := p.StorageClassGiBMonth[class]; ok {
+		return v
+	}
+	return p.StorageGiBMonth
 }
 
 func DefaultConfig() Config {
@@ -37,6 +59,16 @@ func (c *Config) Validate() error {
 	if c.AgeMax < 0 {
 		err = multierr.Append(err, errors.New("invalid age_max, must be >= 0"))
 	}
+	for provider, p := range c.Prices {
+		if p.StorageGiBMonth < 0 || p.Put1000Requests < 0 {
+			err = multierr.Append(err, errors.Errorf("invalid prices for %s, must be >= 0", provider))
+		}
+		for class, v := range p.StorageClassGiBMonth {
+			if v < 0 {
+				err = multierr.Append(err, errors.Errorf("invalid %s storage class price for %s, must be >= 0", class, provider))
+			}
+		}
+	}
 
 	return err
 }
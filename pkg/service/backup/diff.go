@@ -0,0 +1,316 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// highChurnFactor specifies how many times table churn must exceed churn of
+// all the tables for the table to be reported as having high churn.
+const highChurnFactor = 2
+
+// DiffStats holds numbers of SSTables and bytes that were added, removed or
+// are shared between two snapshots. AddedBytes is the size of incremental
+// upload of the newer snapshot.
+type DiffStats struct {
+	AddedSSTables   int   `json:"added_sstables"`
+	AddedBytes      int64 `json:"added_bytes"`
+	RemovedSSTables int   `json:"removed_sstables"`
+	RemovedBytes    int64 `json:"removed_bytes"`
+	SharedSSTables  int   `json:"shared_sstables"`
+	SharedBytes     int64 `json:"shared_bytes"`
+}
+
+// Churn returns the part of bytes of the newer snapshot that were added.
+func (s DiffStats) Churn() float64 {
+	if s.AddedBytes == 0 {
+		return 0
+	}
+	return float64(s.AddedBytes) / float64(s.AddedBytes+s.SharedBytes)
+}
+
+func (s *DiffStats) add(o DiffStats) {
+	s.AddedSSTables += o.AddedSSTables
+	s.AddedBytes += o.AddedBytes
+	s.RemovedSSTables += o.RemovedSSTables
+	s.RemovedBytes += o.RemovedBytes
+	s.SharedSSTables += o.SharedSSTables
+	s.SharedBytes += o.SharedBytes
+}
+
+// TableDiff is a comparison of a table in two snapshots.
+type TableDiff struct {
+	Keyspace string `json:"keyspace"`
+	Table    string `json:"table"`
+	DiffStats
+	Churn     float64 `json:"churn"`
+	HighChurn bool    `json:"high_churn"`
+}
+
+// NodeDiff is a comparison of tables of a node in two snapshots.
+type NodeDiff struct {
+	DC     string      `json:"dc"`
+	NodeID string      `json:"node_id"`
+	IP     string      `json:"ip"`
+	Tables []TableDiff `json:"tables"`
+	DiffStats
+}
+
+// SnapshotDiff is a comparison of two snapshots based on manifests. If some
+// manifests do not have sizes of files, sizes are estimated as average file
+// size of a table and Estimated is set.
+type SnapshotDiff struct {
+	From      string      `json:"from"`
+	To        string      `json:"to"`
+	Estimated bool        `json:"estimated"`
+	Nodes     []NodeDiff  `json:"nodes"`
+	Tables    []TableDiff `json:"tables"`
+	DiffStats
+	Churn float64 `json:"churn"`
+}
+
+// Diff compares files of snapshots with tags from and to. Files of a node
+// table are shared if they are present in both snapshots, added if only in
+// to snapshot and removed if only in from snapshot. It only reads manifests.
+func (s *Service) Diff(ctx context.Context, clusterID uuid.UUID, locations []Location, filter ListFilter, from, to string) (SnapshotDiff, error) {
+	s.logger.Info(ctx, "Comparing snapshots",
+		"cluster_id", clusterID,
+		"locations", locations,
+		"filter", filter,
+		"from", from,
+		"to", to,
+	)
+
+	var d SnapshotDiff
+	if from == to {
+		return d, service.ErrValidate(errors.New("snapshot tags must differ"))
+	}
+	if filter.ClusterID == uuid.Nil {
+		filter.ClusterID = clusterID
+	}
+
+	fromIdx, err := s.diffIndex(ctx, clusterID, locations, filter, from)
+	if err != nil {
+		return d, err
+	}
+	toIdx, err := s.diffIndex(ctx, clusterID, locations, filter, to)
+	if err != nil {
+		return d, err
+	}
+
+	return makeSnapshotDiff(from, to, fromIdx, toIdx), nil
+}
+
+func makeSnapshotDiff(from, to string, fromIdx, toIdx map[string]diffNodeIndex) SnapshotDiff {
+	d := SnapshotDiff{
+		From: from,
+		To:   to,
+	}
+	d.Nodes, d.Estimated = diffNodes(fromIdx, toIdx)
+	d.Tables = diffTables(d.Nodes)
+	for _, n := range d.Nodes {
+		d.add(n.DiffStats)
+	}
+	d.Churn = d.DiffStats.Churn()
+	for i := range d.Tables {
+		t := &d.Tables[i]
+		t.HighChurn = t.Churn > 0 && t.Churn >= highChurnFactor*d.Churn
+	}
+	return d
+}
+
+// diffNodeIndex holds manifest information of a node needed for comparison.
+type diffNodeIndex struct {
+	DC    string
+	IP    string
+	Index []FilesMeta
+}
+
+func (s *Service) diffIndex(ctx context.Context, clusterID uuid.UUID, locations []Location, filter ListFilter, snapshotTag string) (map[string]diffNodeIndex, error) {
+	filter.SnapshotTag = snapshotTag
+
+	idx := make(map[string]diffNodeIndex)
+	if err := s.forEachManifest(ctx, clusterID, locations, filter, func(m ManifestInfoWithContent) {
+		idx[m.NodeID] = diffNodeIndex{
+			DC:    m.DC,
+			IP:    m.IP,
+			Index: m.Index,
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if len(idx) == 0 {
+		return nil, errors.Wrapf(service.ErrNotFound, "snapshot %s", snapshotTag)
+	}
+	return idx, nil
+}
+
+func diffNodes(from, to map[string]diffNodeIndex) (nodes []NodeDiff, estimated bool) {
+	ids := make(map[string]struct{})
+	for id := range from {
+		ids[id] = struct{}{}
+	}
+	for id := range to {
+		ids[id] = struct{}{}
+	}
+
+	for id := range ids {
+		f, t := from[id], to[id]
+		n := NodeDiff{
+			DC:     t.DC,
+			NodeID: id,
+			IP:     t.IP,
+		}
+		if n.DC == "" {
+			n.DC, n.IP = f.DC, f.IP
+		}
+
+		fromFiles, e0 := indexFiles(f.Index)
+		toFiles, e1 := indexFiles(t.Index)
+		estimated = estimated || e0 || e1
+
+		tables := make(map[[2]string]*TableDiff)
+		table := func(k tableFile) *TableDiff {
+			key := [2]string{k.Keyspace, k.Table}
+			td, ok := tables[key]
+			if !ok {
+				td = &TableDiff{Keyspace: k.Keyspace, Table: k.Table}
+				tables[key] = td
+			}
+			return td
+		}
+		sstables := make(map[tableFile]int)
+		const (
+			inFrom = 1 << iota
+			inTo
+		)
+
+		for k, size := range fromFiles {
+			sstables[k.sstable()] |= inFrom
+			if _, ok := toFiles[k]; ok {
+				table(k).SharedBytes += size
+			} else {
+				table(k).RemovedBytes += size
+			}
+		}
+		for k, size := range toFiles {
+			sstables[k.sstable()] |= inTo
+			if _, ok := fromFiles[k]; !ok {
+				table(k).AddedBytes += size
+			}
+		}
+		for k, v := range sstables {
+			td := table(k)
+			switch v {
+			case inFrom:
+				td.RemovedSSTables++
+			case inTo:
+				td.AddedSSTables++
+			default:
+				td.SharedSSTables++
+			}
+		}
+
+		for _, td := range tables {
+			td.Churn = td.DiffStats.Churn()
+			n.Tables = append(n.Tables, *td)
+			n.add(td.DiffStats)
+		}
+		sortTableDiffs(n.Tables)
+		nodes = append(nodes, n)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].DC != nodes[j].DC {
+			return nodes[i].DC < nodes[j].DC
+		}
+		return nodes[i].NodeID < nodes[j].NodeID
+	})
+	return nodes, estimated
+}
+
+// diffTables aggregates table diffs of all nodes.
+func diffTables(nodes []NodeDiff) []TableDiff {
+	tables := make(map[[2]string]*TableDiff)
+	for _, n := range nodes {
+		for _, t := range n.Tables {
+			key := [2]string{t.Keyspace, t.Table}
+			td, ok := tables[key]
+			if !ok {
+				td = &TableDiff{Keyspace: t.Keyspace, Table: t.Table}
+				tables[key] = td
+			}
+			td.add(t.DiffStats)
+		}
+	}
+
+	out := make([]TableDiff, 0, len(tables))
+	for _, td := range tables {
+		td.Churn = td.DiffStats.Churn()
+		out = append(out, *td)
+	}
+	sortTableDiffs(out)
+	return out
+}
+
+func sortTableDiffs(v []TableDiff) {
+	sort.Slice(v, func(i, j int) bool {
+		if v[i].Keyspace != v[j].Keyspace {
+			return v[i].Keyspace < v[j].Keyspace
+		}
+		return v[i].Table < v[j].Table
+	})
+}
+
+// tableFile identifies a file of a table version within a node.
+type tableFile struct {
+	Keyspace string
+	Table    string
+	Version  string
+	Name     string
+}
+
+// sstable returns key of the SSTable the file is a component of, the
+// component name follows the last dash in the file name,
+// i.e. md-1-big-Data.db is a component of md-1-big SSTable.
+func (f tableFile) sstable() tableFile {
+	if i := strings.LastIndex(f.Name, "-"); i > 0 {
+		f.Name = f.Name[:i]
+	}
+	return f
+}
+
+// indexFiles returns sizes of files in the index, if sizes of files of
+// a table are not known they are estimated as average file size.
+func indexFiles(index []FilesMeta) (files map[tableFile]int64, estimated bool) {
+	files = make(map[tableFile]int64)
+	for _, fm := range index {
+		exact := len(fm.FileSizes) == len(fm.Files)
+		if !exact && len(fm.Files) > 0 {
+			estimated = true
+		}
+		for i, name := range fm.Files {
+			k := tableFile{
+				Keyspace: fm.Keyspace,
+				Table:    fm.Table,
+				Version:  fm.Version,
+				Name:     path.Base(name),
+			}
+			if exact {
+				files[k] = fm.FileSizes[i]
+			} else {
+				files[k] = fm.Size / int64(len(fm.Files))
+			}
+		}
+	}
+	return files, estimated
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+)
+
+func TestMakeSnapshotDiff(t *testing.T) {
+	t.Parallel()
+
+	from := map[string]diffNodeIndex{
+		"n1": {
+			DC: "dc1",
+			IP: "192.168.100.11",
+			Index: []FilesMeta{
+				{
+					Keyspace:  "ks",
+					Table:     "hot",
+					Version:   "v",
+					Files:     []string{"md-1-big-Data.db", "md-1-big-Index.db", "md-2-big-Data.db", "md-2-big-Index.db"},
+					FileSizes: []int64{100, 10, 200, 20},
+					Size:      330,
+				},
+				{
+					Keyspace: "ks",
+					Table:    "cold",
+					Version:  "v",
+					Files:    []string{"md-1-big-Data.db", "md-1-big-Index.db"},
+					Size:     1000,
+				},
+			},
+		},
+	}
+	to := map[string]diffNodeIndex{
+		"n1": {
+			DC: "dc1",
+			IP: "192.168.100.11",
+			Index: []FilesMeta{
+				{
+					Keyspace:  "ks",
+					Table:     "hot",
+					Version:   "v",
+					Files:     []string{"md-2-big-Data.db", "md-2-big-Index.db", "md-3-big-Data.db", "md-3-big-Index.db"},
+					FileSizes: []int64{200, 20, 400, 40},
+					Size:      660,
+				},
+				{
+					Keyspace:  "ks",
+					Table:     "cold",
+					Version:   "v",
+					Files:     []string{"md-1-big-Data.db", "md-1-big-Index.db"},
+					FileSizes: []int64{900, 100},
+					Size:      1000,
+				},
+			},
+		},
+	}
+
+	d := makeSnapshotDiff("a", "b", from, to)
+
+	if !d.Estimated {
+		t.Error("Estimated = false, expected true")
+	}
+	golden := DiffStats{
+		AddedSSTables:   1,
+		AddedBytes:      440,
+		RemovedSSTables: 1,
+		RemovedBytes:    110,
+		SharedSSTables:  2,
+		SharedBytes:     1220,
+	}
+	if diff := cmp.Diff(d.DiffStats, golden); diff != "" {
+		t.Fatal("DiffStats", diff)
+	}
+	if len(d.Nodes) != 1 || d.Nodes[0].DiffStats != golden {
+		t.Fatalf("Nodes = %+v, expected one node with stats %+v", d.Nodes, golden)
+	}
+
+	tables := []TableDiff{
+		{
+			Keyspace:  "ks",
+			Table:     "cold",
+			DiffStats: DiffStats{SharedSSTables: 1, SharedBytes: 1000},
+		},
+		{
+			Keyspace:  "ks",
+			Table:     "hot",
+			DiffStats: DiffStats{AddedSSTables: 1, AddedBytes: 440, RemovedSSTables: 1, RemovedBytes: 110, SharedSSTables: 1, SharedBytes: 220},
+			Churn:     440.0 / 660.0,
+			HighChurn: true,
+		},
+	}
+	if diff := cmp.Diff(d.Tables, tables, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Fatal("Tables", diff)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+const (
+	// estimateDefaultInterval is assumed if backup task has no interval.
+	estimateDefaultInterval = 24 * time.Hour
+	// estimateRunsPerTask is the number of the most recent runs of every
+	// backup task used to calculate the upload ratio.
+	estimateRunsPerTask = 10
+	// sstableComponents is the number of files of mc/md format SSTable.
+	sstableComponents = 9
+	// metaFilesPerNode is the number of requests needed to upload manifest
+	// and schema of a node.
+	metaFilesPerNode = 3
+
+	month = 30 * 24 * time.Hour
+	gib   = 1024 * 1024 * 1024
+)
+
+// Estimate is a forecast of storage usage, requests and cost of backups of
+// a target.
+type Estimate struct {
+	Size      int64             `json:"size"`
+	SSTables  int64             `json:"sstables"`
+	Retention int               `json:"retention"`
+	Interval  duration.Duration `json:"interval"`
+	// UploadRatio is the part of snapshot bytes uploaded by a backup when
+	// the previous backup is present, it's based on Runs previous runs.
+	UploadRatio float64 `json:"upload_ratio"`
+	Runs        int     `json:"runs"`
+
+	Locations []LocationEstimate `json:"locations"`
+}
+
+// LocationEstimate is a forecast for a location and storage class.
+type LocationEstimate struct {
+	Location     Location `json:"location"`
+	StorageClass string   `json:"storage_class,omitempty"`
+	Nodes        int      `json:"nodes"`
+	Size         int64    `json:"size"`
+	// Growth is the size of stored backups after each of the first retention
+	// backups, the last value is the size stored after that.
+	Growth            []int64 `json:"growth"`
+	RequestsPerBackup int64   `json:"requests_per_backup"`
+	RequestsPerMonth  int64   `json:"requests_per_month"`
+	// Costs are set only if prices of the location provider are known.
+	StorageCostPerMonth  *float64 `json:"storage_cost_per_month,omitempty"`
+	RequestsCostPerMonth *float64 `json:"requests_cost_per_month,omitempty"`
+	CostPerMonth         *float64 `json:"cost_per_month,omitempty"`
+}
+
+// Estimate returns a forecast of storage usage, requests and monthly cost of
+// backups of the target in every location. Backups are assumed to run every
+// interval, if interval is zero once a day.
+func (s *Service) Estimate(ctx context.Context, clusterID uuid.UUID, target Target, interval time.Duration) (Estimate, error) {
+	s.logger.Info(ctx, "Estimating backup cost",
+		"cluster_id", clusterID,
+		"interval", interval,
+	)
+
+	if interval <= 0 {
+		interval = estimateDefaultInterval
+	}
+	e := Estimate{
+		Retention: target.Retention,
+		Interval:  duration.Duration(interval),
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return e, errors.Wrapf(err, "get client")
+	}
+
+	nodes := target.liveNodes.Datacenter(target.DC)
+	hosts, err := makeHostInfo(nodes, target.Location, nil, target.StorageClass)
+	if err != nil {
+		return e, err
+	}
+
+	var idx scyllaclient.HostKeyspaceTables
+	for _, v := range target.Units {
+		for _, t := range v.Tables {
+			for _, h := range nodes.Hosts() {
+				idx = append(idx, scyllaclient.HostKeyspaceTable{Host: h, Keyspace: v.Keyspace, Table: t})
+			}
+		}
+	}
+	size, err := client.TableDiskSizeReport(ctx, idx)
+	if err != nil {
+		return e, errors.Wrap(err, "table disk size report")
+	}
+	sstables, err := client.TableSSTableCountReport(ctx, idx)
+	if err != nil {
+		return e, errors.Wrap(err, "table sstable count report")
+	}
+
+	hostSize := make(map[string]int64)
+	hostSSTables := make(map[string]int64)
+	for i, v := range idx {
+		hostSize[v.Host] += size[i]
+		hostSSTables[v.Host] += sstables[i]
+		e.Size += size[i]
+		e.SSTables += sstables[i]
+	}
+
+	var taskIDs []uuid.UUID
+	for id := range target.RetentionMap {
+		taskIDs = append(taskIDs, id)
+	}
+	e.UploadRatio, e.Runs, err = s.uploadRatio(ctx, clusterID, taskIDs)
+	if err != nil {
+		return e, errors.Wrap(err, "calculate upload ratio")
+	}
+
+	type key struct {
+		Location string
+		Class    string
+	}
+	locations := make(map[key]*LocationEstimate)
+	files := make(map[key]int64)
+	for _, h := range hosts {
+		k := key{Location: h.Location.String(), Class: h.StorageClass}
+		l, ok := locations[k]
+		if !ok {
+			l = &LocationEstimate{
+				Location:     h.Location,
+				StorageClass: h.StorageClass,
+			}
+			locations[k] = l
+		}
+		l.Nodes++
+		l.Size += hostSize[h.IP]
+		files[k] += hostSSTables[h.IP] * sstableComponents
+	}
+
+	for k, l := range locations {
+		estimateLocation(l, files[k], e.UploadRatio, target.Retention, interval)
+		if p, ok := s.config.Prices[l.Location.Provider]; ok {
+			estimateLocationCost(l, p)
+		}
+		e.Locations = append(e.Locations, *l)
+	}
+	sort.Slice(e.Locations, func(i, j int) bool {
+		a, b := e.Locations[i], e.Locations[j]
+		if a.Location.String() != b.Location.String() {
+			return a.Location.String() < b.Location.String()
+		}
+		return a.StorageClass < b.StorageClass
+	})
+
+	return e, nil
+}
+
+// estimateLocation calculates growth and requests of the location. The first
+// backup uploads all the files, every following backup uploads ratio of the
+// files, the oldest backup is purged after retention backups are stored.
+func estimateLocation(l *LocationEstimate, files int64, ratio float64, retention int, interval time.Duration) {
+	if retention < 1 {
+		retention = 1
+	}
+	l.Growth = make([]int64, retention)
+	for i := range l.Growth {
+		l.Growth[i] = l.Size + int64(float64(i)*ratio*float64(l.Size))
+	}
+	l.RequestsPerBackup = int64(ratio*float64(files)) + int64(l.Nodes*metaFilesPerNode)
+	l.RequestsPerMonth = int64(float64(l.RequestsPerBackup) * float64(month) / float64(interval))
+}
+
+func estimateLocationCost(l *LocationEstimate, p Price) {
+	var (
+		storage  = float64(l.Growth[len(l.Growth)-1]) / gib * p.Storage(l.StorageClass)
+		requests = float64(l.RequestsPerMonth) / 1000 * p.Put1000Requests
+		total    = storage + requests
+	)
+	l.StorageCostPerMonth = &storage
+	l.RequestsCostPerMonth = &requests
+	l.CostPerMonth = &total
+}
+
+// uploadRatio returns the part of snapshot bytes uploaded by recent backups
+// of the tasks. Runs that skipped nothing are full backups, they are not
+// taken into account. If there are no runs it returns 1.
+func (s *Service) uploadRatio(ctx context.Context, clusterID uuid.UUID, taskIDs []uuid.UUID) (float64, int, error) {
+	var (
+		uploaded, skipped int64
+		runs              int
+	)
+
+	for _, taskID := range taskIDs {
+		q := qb.Select(table.BackupRun.Name()).Where(
+			qb.Eq("cluster_id"),
+			qb.Eq("task_id"),
+		).Limit(estimateRunsPerTask).Query(s.session).BindMap(qb.M{
+			"cluster_id": clusterID,
+			"task_id":    taskID,
+		})
+
+		var rs []*Run
+		if err := q.SelectRelease(&rs); err != nil {
+			return 0, 0, err
+		}
+		for _, r := range rs {
+			if r.Stage != StageDone {
+				continue
+			}
+			var u, sk int64
+			if err := NewProgressVisitor(r, s.session).ForEach(func(p *RunProgress) error {
+				u += p.Uploaded
+				sk += p.Skipped
+				return nil
+			}); err != nil {
+				return 0, 0, errors.Wrapf(err, "run %s", r.ID)
+			}
+			if sk == 0 {
+				continue
+			}
+			uploaded += u
+			skipped += sk
+			runs++
+		}
+	}
+
+	s.logger.Debug(ctx, "Upload ratio",
+		"cluster_id", clusterID,
+		"runs", runs,
+		"uploaded", uploaded,
+		"skipped", skipped,
+	)
+
+	if runs == 0 {
+		return 1, 0, nil
+	}
+	return float64(uploaded) / float64(uploaded+skipped), runs, nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEstimateLocation(t *testing.T) {
+	t.Parallel()
+
+	l := LocationEstimate{
+		Nodes: 2,
+		Size:  100 * gib,
+	}
+	estimateLocation(&l, 1000, 0.1, 3, 12*time.Hour)
+
+	if diff := cmp.Diff(l.Growth, []int64{100 * gib, 110 * gib, 120 * gib}); diff != "" {
+		t.Fatal("Growth", diff)
+	}
+	if l.RequestsPerBackup != 106 {
+		t.Fatalf("RequestsPerBackup = %d, expected 106", l.RequestsPerBackup)
+	}
+	if l.RequestsPerMonth != 60*106 {
+		t.Fatalf("RequestsPerMonth = %d, expected %d", l.RequestsPerMonth, 60*106)
+	}
+
+	l.StorageClass = "STANDARD_IA"
+	estimateLocationCost(&l, Price{
+		StorageGiBMonth:      0.02,
+		StorageClassGiBMonth: map[string]float64{"STANDARD_IA": 0.01},
+		Put1000Requests:      0.005,
+	})
+	if v := *l.StorageCostPerMonth; math.Abs(v-1.2) > 1e-9 {
+		t.Fatalf("StorageCostPerMonth = %f, expected 1.2", v)
+	}
+	if v := *l.CostPerMonth; math.Abs(v-(1.2+6.36*0.005)) > 1e-9 {
+		t.Fatalf("CostPerMonth = %f, expected %f", v, 1.2+6.36*0.005)
+	}
+}
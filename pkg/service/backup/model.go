@@ -16,6 +16,7 @@ import (
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/util/duration"
 	"github.com/scylladb/scylla-manager/pkg/util/uuid"
 	"go.uber.org/multierr"
 )
@@ -25,6 +26,7 @@ type SnapshotInfo struct {
 	SnapshotTag string `json:"snapshot_tag"`
 	Nodes       int    `json:"nodes"`
 	Size        int64  `json:"size"`
+	Partial     bool   `json:"partial,omitempty"`
 }
 
 // ListItem represents contents of a snapshot within list boundaries.
@@ -39,21 +41,83 @@ type ListItem struct {
 
 // Target specifies what should be backed up and where.
 type Target struct {
-	Units            []Unit            `json:"units,omitempty"`
-	DC               []string          `json:"dc,omitempty"`
-	Location         []Location        `json:"location"`
-	Retention        int               `json:"retention"`
-	RetentionMap     map[uuid.UUID]int `json:"-"` // policy for all tasks, injected in runtime
-	RateLimit        []DCLimit         `json:"rate_limit,omitempty"`
-	SnapshotParallel []DCLimit         `json:"snapshot_parallel,omitempty"`
-	UploadParallel   []DCLimit         `json:"upload_parallel,omitempty"`
-	Continue         bool              `json:"continue,omitempty"`
-	PurgeOnly        bool              `json:"purge_only,omitempty"`
+	Units             []Unit               `json:"units,omitempty"`
+	DC                []string             `json:"dc,omitempty"`
+	Location          []Location           `json:"location"`
+	Retention         int                  `json:"retention"`
+	RetentionMap      map[uuid.UUID]int    `json:"-"` // policy for all tasks, injected in runtime
+	RateLimit         []DCLimit            `json:"rate_limit,omitempty"`
+	RateLimitSchedule []ScheduledRateLimit `json:"rate_limit_schedule,omitempty"`
+	SnapshotParallel  []DCLimit            `json:"snapshot_parallel,omitempty"`
+	UploadParallel    []DCLimit            `json:"upload_parallel,omitempty"`
+	StaggerDC         bool                 `json:"stagger_dc,omitempty"`
+	SingleReplica     bool                 `json:"single_replica,omitempty"`
+	StorageClass      []DCStorageClass     `json:"storage_class,omitempty"`
+	ObjectTags        bool                 `json:"object_tags,omitempty"`
+	LockPeriod        duration.Duration    `json:"lock_period,omitempty"`
+	LockMode          LockMode             `json:"lock_mode,omitempty"`
+	Continue          bool                 `json:"continue,omitempty"`
+	PurgeOnly         bool                 `json:"purge_only,omitempty"`
+	Hooks             *Hooks               `json:"hooks,omitempty"`
 
 	// LiveNodes caches node status for GetTarget GetTargetSize calls.
 	liveNodes scyllaclient.NodeStatusInfoSlice `json:"-"`
 }
 
+// Hook stages.
+const (
+	HookPreSnapshot  = "pre_snapshot"
+	HookPostSnapshot = "post_snapshot"
+	HookPostUpload   = "post_upload"
+)
+
+// HookFailurePolicy specifies what happens to the backup when a hook fails.
+type HookFailurePolicy string
+
+// HookFailurePolicy enumeration.
+const (
+	HookFailureAbort    HookFailurePolicy = "abort"
+	HookFailureContinue HookFailurePolicy = "continue"
+)
+
+var hookNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+
+// Hooks specifies names of executables in the agent hooks directory that are
+// run on every node at the given backup stages.
+type Hooks struct {
+	PreSnapshot  string            `json:"pre_snapshot,omitempty"`
+	PostSnapshot string            `json:"post_snapshot,omitempty"`
+	PostUpload   string            `json:"post_upload,omitempty"`
+	OnFailure    HookFailurePolicy `json:"on_failure,omitempty"`
+}
+
+// IsZero returns true if no hook is set.
+func (h Hooks) IsZero() bool {
+	return h.PreSnapshot == "" && h.PostSnapshot == "" && h.PostUpload == ""
+}
+
+func (h Hooks) validate() (errs error) {
+	names := []struct {
+		Stage string
+		Name  string
+	}{
+		{HookPreSnapshot, h.PreSnapshot},
+		{HookPostSnapshot, h.PostSnapshot},
+		{HookPostUpload, h.PostUpload},
+	}
+	for _, n := range names {
+		if n.Name != "" && !hookNameRegexp.MatchString(n.Name) {
+			errs = multierr.Append(errs, errors.Errorf("%s: invalid name %q, it must be a file name in the agent hooks directory", n.Stage, n.Name))
+		}
+	}
+	switch h.OnFailure {
+	case "", HookFailureAbort, HookFailureContinue:
+	default:
+		errs = multierr.Append(errs, errors.Errorf("on_failure: unsupported policy %q, use %s or %s", h.OnFailure, HookFailureAbort, HookFailureContinue))
+	}
+	return
+}
+
 // Unit represents keyspace and its tables.
 type Unit struct {
 	Keyspace  string   `json:"keyspace" db:"keyspace_name"`
@@ -89,6 +153,9 @@ type Run struct {
 	Location    []Location
 	StartTime   time.Time
 	Stage       Stage
+	// StaggerDC is the DC being backed up when data centers are backed up
+	// one after another.
+	StaggerDC string
 }
 
 type fileInfo struct {
@@ -100,10 +167,11 @@ type fileInfo struct {
 //
 // Each RunProgress either has Uploaded or Skipped fields set to respective
 // amount of bytes. Failed shows amount of bytes that is assumed to have
-// failed. Since current implementation doesn't support resume at file level
-// this value will always be the same as Uploaded as file needs to be uploaded
-// again. In summary Failed is supposed to mean, out of uploaded bytes how much
-// bytes have to be uploaded again.
+// failed. Small files need to be uploaded again so this value will be the same
+// as Uploaded. Large files are uploaded in parts by the agent and upload is
+// resumed from the last uploaded part, parts uploaded in previous runs are
+// reported as Uploaded. In summary Failed is supposed to mean, out of uploaded
+// bytes how much bytes may have to be uploaded again.
 type RunProgress struct {
 	ClusterID uuid.UUID
 	TaskID    uuid.UUID
@@ -138,6 +206,22 @@ func (p *RunProgress) IsUploaded() bool {
 	return p.Size == p.TotalUploaded()
 }
 
+// HookProgress describes result of a hook run on a host.
+type HookProgress struct {
+	ClusterID uuid.UUID `json:"-"`
+	TaskID    uuid.UUID `json:"-"`
+	RunID     uuid.UUID `json:"-"`
+
+	Host        string     `json:"host"`
+	Stage       string     `json:"stage"`
+	Name        string     `json:"name"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	ExitCode    int        `json:"exit_code"`
+	Output      string     `json:"output,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
 type progress struct {
 	Size        int64      `json:"size"`
 	Uploaded    int64      `json:"uploaded"`
@@ -153,7 +237,13 @@ type Progress struct {
 	SnapshotTag string         `json:"snapshot_tag"`
 	DC          []string       `json:"dcs,omitempty"`
 	Hosts       []HostProgress `json:"hosts,omitempty"`
+	Hooks       []HookProgress `json:"hooks,omitempty"`
 	Stage       Stage          `json:"stage"`
+
+	// Rate limits of a running backup, RateLimit holds limits that are
+	// currently in effect for every DC.
+	RateLimit         []DCLimit            `json:"rate_limit,omitempty"`
+	RateLimitSchedule []ScheduledRateLimit `json:"rate_limit_schedule,omitempty"`
 }
 
 // HostProgress groups uploading progress for keyspaces belonging to this host.
@@ -181,6 +271,14 @@ type TableProgress struct {
 	Error string `json:"error,omitempty"`
 }
 
+// HostTableProgress defines progress for the table on a host.
+// It's published as backup progress event.
+type HostTableProgress struct {
+	Host     string `json:"host"`
+	Keyspace string `json:"keyspace"`
+	TableProgress
+}
+
 // DCLimit specifies a rate limit for a DC.
 type DCLimit struct {
 	DC    string `json:"dc"`
@@ -224,18 +322,143 @@ func dcLimitDCAtPos(s []DCLimit) func(int) (string, string) {
 	}
 }
 
+// ScheduledRateLimit specifies a rate limit for a DC that applies from
+// the given time of day (UTC) until the next scheduled rate limit for the DC.
+type ScheduledRateLimit struct {
+	DC    string
+	Start time.Duration
+	Limit int
+}
+
+func (l ScheduledRateLimit) String() string {
+	p := fmt.Sprintf("%02d:%02d,%d", int(l.Start/time.Hour), int(l.Start%time.Hour/time.Minute), l.Limit)
+	if l.DC != "" {
+		p = l.DC + ":" + p
+	}
+	return p
+}
+
+func (l ScheduledRateLimit) MarshalText() (text []byte, err error) {
+	return []byte(l.String()), nil
+}
+
+func (l *ScheduledRateLimit) UnmarshalText(text []byte) error {
+	pattern := regexp.MustCompile(`^(([a-zA-Z0-9\-\_\.]+):)?([0-9]{1,2}):([0-9]{2}),([0-9]+)$`)
+
+	m := pattern.FindSubmatch(text)
+	if m == nil {
+		return errors.Errorf("invalid scheduled limit %q, the format is [dc:]<hh:mm>,<number>", string(text))
+	}
+
+	hour, _ := strconv.Atoi(string(m[3])) // nolint: errcheck
+	minute, _ := strconv.Atoi(string(m[4]))
+	if hour > 23 || minute > 59 {
+		return errors.Errorf("invalid scheduled limit %q, invalid time of day", string(text))
+	}
+	limit, err := strconv.ParseInt(string(m[5]), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid limit value")
+	}
+
+	l.DC = string(m[2])
+	l.Start = time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute
+	l.Limit = int(limit)
+
+	return nil
+}
+
+func scheduledRateLimitDCAtPos(s []ScheduledRateLimit) func(int) (string, string) {
+	return func(i int) (string, string) {
+		return s[i].DC, s[i].String()
+	}
+}
+
+// DCStorageClass specifies storage class (S3, GCS) or access tier (Azure) of
+// SSTables uploaded to the location of a DC. Manifests and schema are always
+// uploaded with the default storage class of the provider.
+type DCStorageClass struct {
+	DC    string
+	Class string
+}
+
+func (c DCStorageClass) String() string {
+	p := c.Class
+	if c.DC != "" {
+		p = c.DC + ":" + p
+	}
+	return p
+}
+
+func (c DCStorageClass) MarshalText() (text []byte, err error) {
+	return []byte(c.String()), nil
+}
+
+func (c *DCStorageClass) UnmarshalText(text []byte) error {
+	pattern := regexp.MustCompile(`^(([a-zA-Z0-9\-\_\.]+):)?([a-zA-Z0-9\-\_]+)$`)
+
+	m := pattern.FindSubmatch(text)
+	if m == nil {
+		return errors.Errorf("invalid storage class %q, the format is [dc:]<class>", string(text))
+	}
+
+	c.DC = string(m[2])
+	c.Class = string(m[3])
+
+	return nil
+}
+
+func dcStorageClassDCAtPos(s []DCStorageClass) func(int) (string, string) {
+	return func(i int) (string, string) {
+		return s[i].DC, s[i].String()
+	}
+}
+
+// LockMode specifies who can delete backup files before the lock period
+// passes, in governance mode users with special permissions can, in compliance
+// mode no one can. It applies to S3 only, GCS and Azure use bucket and
+// container policies.
+type LockMode string
+
+// LockMode enumeration.
+const (
+	LockModeGovernance LockMode = "governance"
+	LockModeCompliance LockMode = "compliance"
+)
+
+func (m LockMode) MarshalText() (text []byte, err error) {
+	return []byte(m), nil
+}
+
+func (m *LockMode) UnmarshalText(text []byte) error {
+	switch v := LockMode(text); v {
+	case "", LockModeGovernance, LockModeCompliance:
+		*m = v
+		return nil
+	default:
+		return errors.Errorf("invalid lock mode %q, supported modes are %s and %s", v, LockModeGovernance, LockModeCompliance)
+	}
+}
+
 // taskProperties is the main data structure of the runner.Properties blob.
 type taskProperties struct {
-	Keyspace         []string          `json:"keyspace"`
-	DC               []string          `json:"dc"`
-	Location         []Location        `json:"location"`
-	Retention        int               `json:"retention"`
-	RetentionMap     map[uuid.UUID]int `json:"retention_map"`
-	RateLimit        []DCLimit         `json:"rate_limit"`
-	SnapshotParallel []DCLimit         `json:"snapshot_parallel"`
-	UploadParallel   []DCLimit         `json:"upload_parallel"`
-	Continue         bool              `json:"continue"`
-	PurgeOnly        bool              `json:"purge_only"`
+	Keyspace          []string             `json:"keyspace"`
+	DC                []string             `json:"dc"`
+	Location          []Location           `json:"location"`
+	Retention         int                  `json:"retention"`
+	RetentionMap      map[uuid.UUID]int    `json:"retention_map"`
+	RateLimit         []DCLimit            `json:"rate_limit"`
+	RateLimitSchedule []ScheduledRateLimit `json:"rate_limit_schedule"`
+	SnapshotParallel  []DCLimit            `json:"snapshot_parallel"`
+	UploadParallel    []DCLimit            `json:"upload_parallel"`
+	StaggerDC         bool                 `json:"stagger_dc"`
+	SingleReplica     bool                 `json:"single_replica"`
+	StorageClass      []DCStorageClass     `json:"storage_class"`
+	ObjectTags        bool                 `json:"object_tags"`
+	LockPeriod        duration.Duration    `json:"lock_period"`
+	LockMode          LockMode             `json:"lock_mode"`
+	Continue          bool                 `json:"continue"`
+	PurgeOnly         bool                 `json:"purge_only"`
+	Hooks             Hooks                `json:"hooks"`
 }
 
 func defaultTaskProperties() taskProperties {
@@ -5,6 +5,7 @@ package backup
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
@@ -54,6 +55,131 @@ func TestDCLimitMarshalUnmarshalText(t *testing.T) {
 	}
 }
 
+func TestScheduledRateLimitMarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name  string
+		Text  string
+		Limit ScheduledRateLimit
+	}{
+		{
+			Name: "with dc",
+			Text: "dc1:08:00,50",
+			Limit: ScheduledRateLimit{
+				DC:    "dc1",
+				Start: 8 * time.Hour,
+				Limit: 50,
+			},
+		},
+		{
+			Name: "without dc",
+			Text: "20:30,0",
+			Limit: ScheduledRateLimit{
+				Start: 20*time.Hour + 30*time.Minute,
+			},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var r ScheduledRateLimit
+			if err := r.UnmarshalText([]byte(test.Text)); err != nil {
+				t.Fatal(err)
+			}
+			if r != test.Limit {
+				t.Fatalf("Got %s, expected %s", r, test.Limit)
+			}
+			b, err := r.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != test.Text {
+				t.Fatalf("MarshalText() = %s, expected %s", b, test.Text)
+			}
+		})
+	}
+}
+
+func TestScheduledRateLimitUnmarshalTextError(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"", "dc1:50", "08:00", "24:00,1", "08:60,1", "dc1:8:0,1"} {
+		var r ScheduledRateLimit
+		if err := r.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) expected error", text)
+		}
+	}
+}
+
+func TestDCStorageClassMarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name  string
+		Text  string
+		Class DCStorageClass
+	}{
+		{
+			Name:  "with dc",
+			Text:  "dc1:STANDARD_IA",
+			Class: DCStorageClass{DC: "dc1", Class: "STANDARD_IA"},
+		},
+		{
+			Name:  "without dc",
+			Text:  "Cool",
+			Class: DCStorageClass{Class: "Cool"},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var c DCStorageClass
+			if err := c.UnmarshalText([]byte(test.Text)); err != nil {
+				t.Fatal(err)
+			}
+			if c != test.Class {
+				t.Fatalf("Got %s, expected %s", c, test.Class)
+			}
+			b, err := c.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != test.Text {
+				t.Fatalf("MarshalText() = %s, expected %s", b, test.Text)
+			}
+		})
+	}
+}
+
+func TestLockModeUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"", "governance", "compliance"} {
+		var m LockMode
+		if err := m.UnmarshalText([]byte(text)); err != nil {
+			t.Errorf("UnmarshalText(%q) error %s", text, err)
+		}
+		if string(m) != text {
+			t.Errorf("UnmarshalText(%q) = %s", text, m)
+		}
+	}
+	for _, text := range []string{"GOVERNANCE", "legal_hold"} {
+		var m LockMode
+		if err := m.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) expected error", text)
+		}
+	}
+}
+
 func TestExtractLocations(t *testing.T) {
 	t.Parallel()
 
@@ -96,3 +222,71 @@ func TestExtractLocations(t *testing.T) {
 		})
 	}
 }
+
+func TestHooksValidate(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name  string
+		Hooks Hooks
+		Error bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name: "valid",
+			Hooks: Hooks{
+				PreSnapshot:  "pause_ingest.sh",
+				PostSnapshot: "resume_ingest.sh",
+				PostUpload:   "notify",
+				OnFailure:    HookFailureContinue,
+			},
+		},
+		{
+			Name: "path",
+			Hooks: Hooks{
+				PreSnapshot: "../bin/sh",
+			},
+			Error: true,
+		},
+		{
+			Name: "absolute path",
+			Hooks: Hooks{
+				PostUpload: "/bin/sh",
+			},
+			Error: true,
+		},
+		{
+			Name: "hidden file",
+			Hooks: Hooks{
+				PostSnapshot: ".hook",
+			},
+			Error: true,
+		},
+		{
+			Name: "invalid policy",
+			Hooks: Hooks{
+				PreSnapshot: "pause_ingest.sh",
+				OnFailure:   "retry",
+			},
+			Error: true,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.Hooks.validate()
+			if test.Error && err == nil {
+				t.Error("Expected error")
+			}
+			if !test.Error && err != nil {
+				t.Errorf("Unexpected error %s", err)
+			}
+		})
+	}
+}
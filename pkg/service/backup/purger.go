@@ -63,6 +63,9 @@ type purger struct {
 	notifyEach int
 	OnScan     func(scanned, orphaned int, orphanedBytes int64)
 	OnDelete   func(total, success int)
+	// OnLocked is called for manifests that can't be purged because files of
+	// the snapshot are locked.
+	OnLocked func(snapshotTag string, retainUntil time.Time)
 }
 
 func newPurger(client *scyllaclient.Client, host string, logger log.Logger) purger {
@@ -82,23 +85,38 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 	}
 
 	var (
-		files = make(fileSet)
-		stale = 0
+		files  = make(fileSet)
+		stale  = 0
+		locked = strset.New()
+		now    = timeutc.Now()
 
 		c ManifestContent
 	)
 
 	for _, m := range manifests {
 		if tags.Has(m.SnapshotTag) {
+			if err := p.loadManifestContentInto(ctx, m, &c); err != nil {
+				return 0, errors.Wrapf(err, "load manifest %s", m.Path())
+			}
+			// Locked snapshots are kept until the end of retention
+			if c.Locked(now) {
+				p.logger.Info(ctx, "Found locked manifest, skipping",
+					"task", m.TaskID,
+					"snapshot_tag", m.SnapshotTag,
+					"retain_until", *c.RetainUntil,
+				)
+				locked.Add(m.Path())
+				if p.OnLocked != nil {
+					p.OnLocked(m.SnapshotTag, *c.RetainUntil)
+				}
+				continue
+			}
 			stale++
 			p.logger.Info(ctx, "Found manifest to remove",
 				"task", m.TaskID,
 				"snapshot_tag", m.SnapshotTag,
 				"temporary", m.Temporary,
 			)
-			if err := p.loadManifestContentInto(ctx, m, &c); err != nil {
-				return 0, errors.Wrapf(err, "load manifest %s", m.Path())
-			}
 			p.forEachDir(m, &c, files.AddFiles)
 		}
 	}
@@ -106,7 +124,7 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 		return 0, nil
 	}
 	for _, m := range manifests {
-		if !tags.Has(m.SnapshotTag) {
+		if !tags.Has(m.SnapshotTag) || locked.Has(m.Path()) {
 			if err := p.loadManifestContentInto(ctx, m, &c); err != nil {
 				return 0, errors.Wrapf(err, "load manifest %s", m.Path())
 			}
@@ -118,7 +136,7 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 	}
 	deletedManifests := 0
 	for _, m := range manifests {
-		if tags.Has(m.SnapshotTag) {
+		if tags.Has(m.SnapshotTag) && !locked.Has(m.Path()) {
 			if _, err := p.deleteFile(ctx, m.Location.RemotePath(m.SchemaPath())); err != nil {
 				p.logger.Info(ctx, "Failed to remove schema file", "path", m.SchemaPath(), "error", err)
 			}
@@ -140,6 +158,10 @@ type ValidationResult struct {
 	OrphanedFiles   int      `json:"orphaned_files"`
 	OrphanedBytes   int64    `json:"orphaned_bytes"`
 	DeletedFiles    int      `json:"deleted_files"`
+	// UnlockedFiles is the number of files of locked snapshots that can be
+	// deleted before the end of retention.
+	UnlockedFiles     int      `json:"unlocked_files"`
+	UnlockedSnapshots []string `json:"unlocked_snapshots"`
 }
 
 func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteOrphanedFiles bool) (ValidationResult, error) {
@@ -155,6 +177,7 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 		files             = make(fileSet)
 		tempManifestFiles = make(fileSet)
 		orphanedFiles     = make(fileSet)
+		locked            []lockedManifest
 
 		c ManifestContent
 	)
@@ -163,6 +186,13 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 		if err := p.loadManifestContentInto(ctx, m, &c); err != nil {
 			return result, errors.Wrapf(err, "load manifest %s", m.Path())
 		}
+		if !m.Temporary && c.Locked(start) {
+			locked = append(locked, lockedManifest{
+				ManifestInfo: m,
+				Index:        c.Index,
+				RetainUntil:  *c.RetainUntil,
+			})
+		}
 		if m.Temporary {
 			p.forEachDir(m, &c, tempManifestFiles.AddFiles)
 		} else {
@@ -215,6 +245,13 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 		}
 	}
 
+	if len(locked) > 0 {
+		p.logger.Info(ctx, "Checking lock of snapshot files")
+		if err := p.checkLocked(ctx, locked, &result); err != nil {
+			return result, errors.Wrap(err, "check lock")
+		}
+	}
+
 	// Remove orphaned files
 	if deleteOrphanedFiles {
 		n, err := p.deleteFiles(ctx, manifests[0].Location, orphanedFiles)
@@ -227,6 +264,49 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 	return result, nil
 }
 
+// lockedManifest holds information needed to check lock of snapshot files.
+type lockedManifest struct {
+	*ManifestInfo
+	Index       []FilesMeta
+	RetainUntil time.Time
+}
+
+// checkLocked counts files of locked snapshots that are not locked until
+// the retention date specified in manifest.
+func (p purger) checkLocked(ctx context.Context, manifests []lockedManifest, result *ValidationResult) error {
+	s := strset.New()
+	for _, m := range manifests {
+		c := ManifestContent{Index: m.Index}
+		dirs := map[string][]string{
+			path.Dir(m.Path()): {path.Base(m.Path())},
+		}
+		p.forEachDir(m.ManifestInfo, &c, func(dir string, files []string) {
+			dirs[dir] = files
+		})
+		for dir, files := range dirs {
+			if len(files) == 0 {
+				continue
+			}
+			unlocked, err := p.client.RcloneUnlocked(ctx, p.host, m.Location.RemotePath(dir), files, m.RetainUntil)
+			if err != nil {
+				return errors.Wrapf(err, "dir %s", dir)
+			}
+			if len(unlocked) > 0 {
+				p.logger.Info(ctx, "Found unlocked files",
+					"snapshot_tag", m.SnapshotTag,
+					"dir", dir,
+					"files", len(unlocked),
+				)
+				result.UnlockedFiles += len(unlocked)
+				s.Add(m.SnapshotTag)
+			}
+		}
+	}
+	result.UnlockedSnapshots = s.List()
+	sort.Strings(result.UnlockedSnapshots)
+	return nil
+}
+
 func (p purger) onScan(ctx context.Context, result ValidationResult) {
 	p.logger.Info(ctx, "Scanning files",
 		"scanned_files", result.ScannedFiles,
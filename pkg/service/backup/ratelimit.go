@@ -0,0 +1,133 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// rateLimiter holds rate limits of a running backup. Limits can be replaced
+// during the run, and change at the scheduled times of day.
+type rateLimiter struct {
+	runID uuid.UUID
+
+	mu       sync.Mutex
+	limits   []DCLimit
+	schedule []ScheduledRateLimit
+	changed  chan struct{}
+}
+
+func newRateLimiter(runID uuid.UUID, limits []DCLimit, schedule []ScheduledRateLimit) *rateLimiter {
+	return &rateLimiter{
+		runID:    runID,
+		limits:   limits,
+		schedule: schedule,
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// Set replaces the rate limits and schedule.
+func (r *rateLimiter) Set(limits []DCLimit, schedule []ScheduledRateLimit) {
+	r.mu.Lock()
+	r.limits = limits
+	r.schedule = schedule
+	r.mu.Unlock()
+
+	select {
+	case r.changed <- struct{}{}:
+	default:
+	}
+}
+
+// Changed returns a channel that receives a value after limits are set.
+func (r *rateLimiter) Changed() <-chan struct{} {
+	return r.changed
+}
+
+// Limits returns the rate limits and schedule.
+func (r *rateLimiter) Limits() ([]DCLimit, []ScheduledRateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.limits, r.schedule
+}
+
+// Limit returns rate limit of a DC at a given time.
+func (r *rateLimiter) Limit(dc string, t time.Time) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return rateLimitAt(r.limits, r.schedule, dc, t)
+}
+
+// Next returns the time of the next scheduled rate limit change after t,
+// or zero time if there is no schedule.
+func (r *rateLimiter) Next(t time.Time) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var next time.Time
+	midnight := t.Truncate(24 * time.Hour)
+	for _, l := range r.schedule {
+		v := midnight.Add(l.Start)
+		if !v.After(t) {
+			v = v.Add(24 * time.Hour)
+		}
+		if next.IsZero() || v.Before(next) {
+			next = v
+		}
+	}
+	return next
+}
+
+// rateLimitAt returns rate limit of a DC at a given time. If there is
+// a schedule for the DC, or a schedule without DC, the last scheduled limit
+// that started before t applies, wrapping around midnight. Otherwise
+// the DC limit applies, if there is no limit for the DC the limit without DC
+// is used, and then 0 - no limit.
+func rateLimitAt(limits []DCLimit, schedule []ScheduledRateLimit, dc string, t time.Time) int {
+	if s := dcSchedule(schedule, dc); len(s) > 0 {
+		tod := t.Sub(t.Truncate(24 * time.Hour))
+		l := s[len(s)-1]
+		for _, v := range s {
+			if v.Start <= tod {
+				l = v
+			}
+		}
+		return l.Limit
+	}
+
+	limit := 0
+	for _, l := range limits {
+		if l.DC == dc {
+			return l.Limit
+		}
+		if l.DC == "" {
+			limit = l.Limit
+		}
+	}
+	return limit
+}
+
+// dcSchedule returns schedule of a DC sorted by start time, if there is none
+// the schedule without DC is returned.
+func dcSchedule(schedule []ScheduledRateLimit, dc string) []ScheduledRateLimit {
+	var dcs, all []ScheduledRateLimit
+	for _, l := range schedule {
+		switch l.DC {
+		case dc:
+			dcs = append(dcs, l)
+		case "":
+			all = append(all, l)
+		}
+	}
+	if len(dcs) == 0 {
+		dcs = all
+	}
+	sort.SliceStable(dcs, func(i, j int) bool {
+		return dcs[i].Start < dcs[j].Start
+	})
+	return dcs
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+func TestRateLimitAt(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	limits := []DCLimit{{Limit: 100}, {DC: "dc2", Limit: 10}}
+	schedule := []ScheduledRateLimit{
+		{DC: "dc1", Start: 20 * time.Hour, Limit: 0},
+		{DC: "dc1", Start: 8 * time.Hour, Limit: 50},
+	}
+
+	table := []struct {
+		Name  string
+		DC    string
+		Time  time.Time
+		Limit int
+	}{
+		{
+			Name:  "scheduled",
+			DC:    "dc1",
+			Time:  day.Add(12 * time.Hour),
+			Limit: 50,
+		},
+		{
+			Name:  "scheduled at boundary",
+			DC:    "dc1",
+			Time:  day.Add(20 * time.Hour),
+			Limit: 0,
+		},
+		{
+			Name:  "scheduled wrap around midnight",
+			DC:    "dc1",
+			Time:  day.Add(time.Hour),
+			Limit: 0,
+		},
+		{
+			Name:  "dc limit",
+			DC:    "dc2",
+			Time:  day.Add(12 * time.Hour),
+			Limit: 10,
+		},
+		{
+			Name:  "default limit",
+			DC:    "dc3",
+			Time:  day.Add(12 * time.Hour),
+			Limit: 100,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if l := rateLimitAt(limits, schedule, test.DC, test.Time); l != test.Limit {
+				t.Fatalf("rateLimitAt() = %d, expected %d", l, test.Limit)
+			}
+		})
+	}
+}
+
+func TestRateLimitAtScheduleWithoutDC(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := []ScheduledRateLimit{
+		{Start: 8 * time.Hour, Limit: 50},
+		{DC: "dc1", Start: 8 * time.Hour, Limit: 10},
+	}
+
+	if l := rateLimitAt(nil, schedule, "dc1", day.Add(12*time.Hour)); l != 10 {
+		t.Fatalf("rateLimitAt() = %d, expected %d", l, 10)
+	}
+	if l := rateLimitAt(nil, schedule, "dc2", day.Add(12*time.Hour)); l != 50 {
+		t.Fatalf("rateLimitAt() = %d, expected %d", l, 50)
+	}
+}
+
+func TestRateLimiterNext(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	rl := newRateLimiter(uuid.Nil, nil, []ScheduledRateLimit{
+		{DC: "dc1", Start: 8 * time.Hour, Limit: 50},
+		{DC: "dc2", Start: 20 * time.Hour, Limit: 0},
+	})
+
+	if next := rl.Next(day.Add(8 * time.Hour)); !next.Equal(day.Add(20 * time.Hour)) {
+		t.Fatalf("Next() = %s, expected %s", next, day.Add(20*time.Hour))
+	}
+	if next := rl.Next(day.Add(21 * time.Hour)); !next.Equal(day.Add(32 * time.Hour)) {
+		t.Fatalf("Next() = %s, expected %s", next, day.Add(32*time.Hour))
+	}
+
+	rl.Set([]DCLimit{{Limit: 10}}, nil)
+	if next := rl.Next(day); !next.IsZero() {
+		t.Fatalf("Next() = %s, expected zero time", next)
+	}
+	select {
+	case <-rl.Changed():
+	default:
+		t.Fatal("Expected change notification")
+	}
+	if l := rl.Limit("dc1", day); l != 10 {
+		t.Fatalf("Limit() = %d, expected %d", l, 10)
+	}
+}
@@ -7,15 +7,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"path"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/go-set/strset"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
@@ -27,6 +31,7 @@ import (
 	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 	"github.com/scylladb/scylla-manager/pkg/util/uuid"
 	"go.uber.org/atomic"
+	"go.uber.org/multierr"
 )
 
 const defaultRateLimit = 100 // 100MiB
@@ -46,7 +51,11 @@ type Service struct {
 	clusterName    ClusterNameFunc
 	scyllaClient   scyllaclient.ProviderFunc
 	clusterSession SessionFunc
+	events         events.Publisher
 	logger         log.Logger
+
+	mu           sync.Mutex
+	rateLimiters map[uuid.UUID]*rateLimiter
 }
 
 func NewService(session gocqlx.Session, config Config, metrics metrics.BackupMetrics, clusterName ClusterNameFunc, scyllaClient scyllaclient.ProviderFunc,
@@ -74,10 +83,19 @@ func NewService(session gocqlx.Session, config Config, metrics metrics.BackupMet
 		clusterName:    clusterName,
 		scyllaClient:   scyllaClient,
 		clusterSession: clusterSession,
+		events:         events.NopPublisher,
 		logger:         logger,
+		rateLimiters:   make(map[uuid.UUID]*rateLimiter),
 	}, nil
 }
 
+// SetEventPublisher sets publisher that would be notified on backup and
+// validation progress changes. It needs to be called prior to running the
+// service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
 // Runner creates a Runner that handles repairs.
 func (s *Service) Runner() Runner {
 	return Runner{service: s}
@@ -121,6 +139,16 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 		return t, errors.Wrap(err, "invalid rate-limit")
 	}
 
+	// Validate rate limit schedule DCs
+	if err := checkDCs(scheduledRateLimitDCAtPos(p.RateLimitSchedule), len(p.RateLimitSchedule), dcMap); err != nil {
+		return t, errors.Wrap(err, "invalid rate-limit-schedule")
+	}
+
+	// Validate storage class DCs
+	if err := checkDCs(dcStorageClassDCAtPos(p.StorageClass), len(p.StorageClass), dcMap); err != nil {
+		return t, errors.Wrap(err, "invalid storage-class")
+	}
+
 	// Validate upload parallel DCs
 	if err := checkDCs(dcLimitDCAtPos(p.SnapshotParallel), len(p.SnapshotParallel), dcMap); err != nil {
 		return t, errors.Wrap(err, "invalid snapshot-parallel")
@@ -131,11 +159,41 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 		return t, errors.Wrap(err, "invalid upload-parallel")
 	}
 
+	// Validate hooks
+	if err := p.Hooks.validate(); err != nil {
+		return t, service.ErrValidate(errors.Wrap(err, "invalid hooks"))
+	}
+	if !p.Hooks.IsZero() {
+		h := p.Hooks
+		if h.OnFailure == "" {
+			h.OnFailure = HookFailureAbort
+		}
+		t.Hooks = &h
+	}
+
+	// Validate object lock
+	if p.LockPeriod < 0 {
+		return t, service.ErrValidate(errors.New("invalid lock-period: must be positive"))
+	}
+	if p.LockMode != "" && p.LockPeriod == 0 {
+		return t, service.ErrValidate(errors.New("invalid lock-mode: lock-period is not set"))
+	}
+
 	// Copy simple properties
 	t.Retention = p.Retention
 	t.RetentionMap = p.RetentionMap
+	t.ObjectTags = p.ObjectTags
+	t.LockPeriod = p.LockPeriod
+	if t.LockPeriod > 0 {
+		t.LockMode = p.LockMode
+		if t.LockMode == "" {
+			t.LockMode = LockModeGovernance
+		}
+	}
 	t.Continue = p.Continue
 	t.PurgeOnly = p.PurgeOnly
+	t.StaggerDC = p.StaggerDC
+	t.SingleReplica = p.SingleReplica
 
 	// Filter DCs
 	if t.DC, err = dcfilter.Apply(dcMap, p.DC); err != nil {
@@ -148,12 +206,22 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 	if len(t.RateLimit) == 0 {
 		t.RateLimit = []DCLimit{{Limit: defaultRateLimit}}
 	}
+	t.RateLimitSchedule = filterScheduledRateLimits(p.RateLimitSchedule, t.DC)
 	t.SnapshotParallel = filterDCLimits(p.SnapshotParallel, t.DC)
 	t.UploadParallel = filterDCLimits(p.UploadParallel, t.DC)
+	t.StorageClass = filterDCStorageClasses(p.StorageClass, t.DC)
 
 	if err := checkAllDCsCovered(t.Location, t.DC); err != nil {
 		return t, errors.Wrap(err, "invalid location")
 	}
+	if err := checkStorageClassSupported(t.Location, t.StorageClass, t.DC); err != nil {
+		return t, errors.Wrap(err, "invalid storage-class")
+	}
+	if t.LockPeriod > 0 {
+		if err := checkObjectLockSupported(t.Location); err != nil {
+			return t, errors.Wrap(err, "invalid lock-period")
+		}
+	}
 
 	targetDCs := strset.New(t.DC...)
 
@@ -237,11 +305,20 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 	if err != nil {
 		return t, err
 	}
+	if t.SingleReplica {
+		t.liveNodes = singleReplicaNodes(t.liveNodes, t.Units, rings)
+		s.logger.Info(ctx, "Backing up single replica of token ranges", "hosts", t.liveNodes.Hosts())
+	}
 
 	// Validate locations access
 	if err := s.checkLocationsAvailableFromNodes(ctx, client, t.liveNodes, t.Location); err != nil {
 		return t, errors.Wrap(err, "location is not accessible")
 	}
+	if t.LockPeriod > 0 {
+		if err := s.checkLocationsLockable(ctx, client, t.liveNodes, t.Location, t.LockMode); err != nil {
+			return t, errors.Wrap(err, "location does not support object lock")
+		}
+	}
 
 	return t, nil
 }
@@ -305,8 +382,19 @@ func (s *Service) checkLocationsAvailableFromNodes(ctx context.Context, client *
 		dcl[l.DC] = l
 	}
 
+	// Location index
+	locationHosts := map[Location][]string{}
+
+	for _, node := range nodes {
+		l, ok := dcl[node.Datacenter]
+		if !ok {
+			l = dcl[""]
+		}
+		locationHosts[l] = append(locationHosts[l], node.Addr)
+	}
+
 	// Run checkHostLocation in parallel
-	return service.ErrValidate(parallel.Run(len(nodes), parallel.NoLimit, func(i int) error {
+	if err := parallel.Run(len(nodes), parallel.NoLimit, func(i int) error {
 		node := nodes[i]
 
 		l, ok := dcl[node.Datacenter]
@@ -314,7 +402,92 @@ func (s *Service) checkLocationsAvailableFromNodes(ctx context.Context, client *
 			l = dcl[""]
 		}
 		return s.checkHostLocation(ctx, client, node.Addr, l)
-	}))
+	}); err != nil {
+		return service.ErrValidate(err)
+	}
+
+	// Check that file locations point to the same shared file system
+	for l, hosts := range locationHosts {
+		if l.Provider != File {
+			continue
+		}
+		if err := s.checkSharedLocation(ctx, client, hosts, l); err != nil {
+			return service.ErrValidate(err)
+		}
+	}
+
+	return nil
+}
+
+// checkLocationsLockable checks if object lock is enabled in the locations,
+// each location is checked from a single node.
+func (s *Service) checkLocationsLockable(ctx context.Context, client *scyllaclient.Client,
+	nodes scyllaclient.NodeStatusInfoSlice, locations []Location, mode LockMode) error {
+	dcl := map[string]Location{}
+	for _, l := range locations {
+		dcl[l.DC] = l
+	}
+	locationHost := map[Location]string{}
+	for _, node := range nodes {
+		l, ok := dcl[node.Datacenter]
+		if !ok {
+			l = dcl[""]
+		}
+		if _, ok := locationHost[l]; !ok {
+			locationHost[l] = node.Addr
+		}
+	}
+
+	var err error
+	for l, h := range locationHost {
+		// Locking no files only checks if object lock is enabled
+		if lerr := client.RcloneLock(ctx, h, l.RemotePath(""), nil, agentLockMode(mode), timeutc.Now()); lerr != nil {
+			err = multierr.Append(err, errors.Wrapf(lerr, "%s: %s", h, l))
+		}
+	}
+	return service.ErrValidate(err)
+}
+
+// agentLockMode converts mode to the S3 retention mode used by agent.
+func agentLockMode(mode LockMode) string {
+	return strings.ToUpper(string(mode))
+}
+
+// checkSharedLocation checks if all the hosts see the same directory under
+// location, it's needed for file locations as each node has its own mount of
+// the shared file system. A marker file is put from the first host and all
+// the other hosts must be able to see it.
+func (s *Service) checkSharedLocation(ctx context.Context, client *scyllaclient.Client, hosts []string, l Location) error {
+	if len(hosts) < 2 {
+		return nil
+	}
+
+	var (
+		h      = hosts[0]
+		marker = l.RemotePath(path.Join("backup", ".location-check", uuid.NewTime().String()))
+	)
+	if err := client.RclonePut(ctx, h, marker, bytes.NewBufferString(h), int64(len(h))); err != nil {
+		return errors.Wrapf(err, "%s: put location check file", h)
+	}
+	defer func() {
+		if err := client.RcloneDeleteFile(context.Background(), h, marker); err != nil {
+			s.logger.Error(ctx, "Failed to delete location check file", "host", h, "path", marker, "error", err)
+		}
+	}()
+
+	return parallel.Run(len(hosts)-1, parallel.NoLimit, func(i int) error {
+		o := hosts[i+1]
+		_, err := client.RcloneFileInfo(ctx, o, marker)
+		if scyllaclient.StatusCodeOf(err) == http.StatusNotFound {
+			s.logger.Info(ctx, "Location check FAILED", "host", o, "location", l, "error", "location is not shared with "+h)
+			return errors.Errorf("%s: location %s does not point to the same directory as on %s - "+
+				"make sure the same shared file system is mounted at the file root directory on all the nodes", o, l, h)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "%s: get location check file", o)
+		}
+		return nil
+	})
 }
 
 func (s *Service) checkHostLocation(ctx context.Context, client *scyllaclient.Client, h string, l Location) error {
@@ -428,10 +601,12 @@ func (s *Service) List(ctx context.Context, clusterID uuid.UUID, locations []Loc
 				SnapshotTag: mc.SnapshotTag,
 				Nodes:       1,
 				Size:        size,
+				Partial:     mc.Partial,
 			})
 		} else {
 			siptr.Nodes++
 			siptr.Size += size
+			siptr.Partial = siptr.Partial || mc.Partial
 		}
 
 		// Add unit information from index
@@ -695,7 +870,7 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 	}
 
 	// Create hostInfo for run hosts
-	hi, err := makeHostInfo(liveNodes, target.Location, target.RateLimit)
+	hi, err := makeHostInfo(liveNodes, target.Location, target.RateLimit, target.StorageClass)
 	if err != nil {
 		return err
 	}
@@ -705,6 +880,17 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		return errors.Wrap(err, "initialize: register the run")
 	}
 
+	// Register rate limiter so that rate limits can be changed during the run
+	rl := newRateLimiter(run.ID, target.RateLimit, target.RateLimitSchedule)
+	s.mu.Lock()
+	s.rateLimiters[clusterID] = rl
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.rateLimiters, clusterID)
+		s.mu.Unlock()
+	}()
+
 	// Get cluster name
 	clusterName, err := s.clusterName(ctx, run.ClusterID)
 	if err != nil {
@@ -713,17 +899,26 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 
 	// Create a worker
 	w := &worker{
-		ClusterID:            clusterID,
-		ClusterName:          clusterName,
-		TaskID:               taskID,
-		RunID:                runID,
-		SnapshotTag:          run.SnapshotTag,
-		Config:               s.config,
-		Metrics:              s.metrics,
-		Units:                run.Units,
-		Client:               client,
-		OnRunProgress:        s.putRunProgressLogError,
+		ClusterID:   clusterID,
+		ClusterName: clusterName,
+		TaskID:      taskID,
+		RunID:       runID,
+		SnapshotTag: run.SnapshotTag,
+		Config:      s.config,
+		Metrics:     s.metrics,
+		Units:       run.Units,
+		ObjectTags:  target.ObjectTags,
+		LockPeriod:  target.LockPeriod.Duration(),
+		LockMode:    target.LockMode,
+		Partial:     target.SingleReplica,
+		Client:      client,
+		RateLimiter: rl,
+		OnRunProgress: func(ctx context.Context, p *RunProgress) {
+			s.putRunProgressLogError(ctx, p)
+			s.publishRunProgress(ctx, run.Units, p)
+		},
 		ResumeUploadProgress: s.resumeUploadProgress(run.PrevID),
+		OnHookProgress:       s.putHookProgressLogError,
 		memoryPool: &sync.Pool{
 			New: func() interface{} {
 				return &bytes.Buffer{}
@@ -731,12 +926,16 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		},
 	}
 
+	if target.Hooks != nil {
+		w.Hooks = *target.Hooks
+	}
+
 	// Map stages to worker functions
-	gaurdFunc := func() error {
+	gaurdFunc := func([]hostInfo) error {
 		return nil
 	}
-	stageFunc := map[Stage]func() error{
-		StageAwaitSchema: func() error {
+	stageFunc := map[Stage]func(hosts []hostInfo) error{
+		StageAwaitSchema: func([]hostInfo) error {
 			clusterSession, err := s.clusterSession(ctx, clusterID)
 			if err != nil {
 				w.Logger.Info(ctx, "No CQL cluster session, backup of schema as CQL files would be skipped", "error", err)
@@ -748,26 +947,29 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 
 			return w.DumpSchema(ctx, clusterSession)
 		},
-		StageSnapshot: func() error {
-			return w.Snapshot(ctx, hi, target.SnapshotParallel)
+		StageSnapshot: func(hosts []hostInfo) error {
+			return w.Snapshot(ctx, hosts, target.SnapshotParallel)
 		},
-		StageIndex: func() error {
-			return w.Index(ctx, hi, target.UploadParallel)
+		StageIndex: func(hosts []hostInfo) error {
+			return w.Index(ctx, hosts, target.UploadParallel)
 		},
-		StageManifest: func() error {
-			return w.UploadManifest(ctx, hi)
+		StageManifest: func(hosts []hostInfo) error {
+			return w.UploadManifest(ctx, hosts)
 		},
-		StageSchema: func() error {
-			return w.UploadSchema(ctx, hi)
+		StageSchema: func(hosts []hostInfo) error {
+			return w.UploadSchema(ctx, hosts)
 		},
-		StageUpload: func() error {
-			return w.Upload(ctx, hi, target.UploadParallel)
+		StageUpload: func(hosts []hostInfo) error {
+			return w.Upload(ctx, hosts, target.UploadParallel)
 		},
-		StageMoveManifest: func() error {
-			return w.MoveManifest(ctx, hi)
+		StageMoveManifest: func(hosts []hostInfo) error {
+			return w.MoveManifest(ctx, hosts)
 		},
-		StagePurge: func() error {
-			return w.Purge(ctx, hi, target.RetentionMap)
+		StageLock: func(hosts []hostInfo) error {
+			return w.Lock(ctx, hosts)
+		},
+		StagePurge: func(hosts []hostInfo) error {
+			return w.Purge(ctx, hosts, target.RetentionMap)
 		},
 		StageDone: gaurdFunc,
 
@@ -778,7 +980,7 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 	prevStage := run.Stage
 
 	// Execute stages according to the stage order.
-	execStage := func(stage Stage, f func() error) error {
+	execStage := func(stage Stage, hosts []hostInfo, resume bool) error {
 		// In purge only mode skip all stages before purge.
 		if target.PurgeOnly {
 			if stage.Index() < StagePurge.Index() {
@@ -787,7 +989,7 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		}
 
 		// Skip completed stages
-		if run.PrevID != uuid.Nil {
+		if resume {
 			// Allow reindexing if previous state is manifest creation or upload
 			if stage == StageIndex && (prevStage == StageManifest || prevStage == StageUpload) {
 				// continue
@@ -802,22 +1004,84 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		w = w.WithLogger(s.logger.Named(name))
 
 		// Always cleanup stats
-		defer w.cleanup(ctx, hi)
+		defer w.cleanup(ctx, hosts)
 
 		// Run function
-		return errors.Wrap(f(), strings.ReplaceAll(name, "_", " "))
+		return errors.Wrap(stageFunc[stage](hosts), strings.ReplaceAll(name, "_", " "))
 	}
-	for _, s := range StageOrder() {
-		if f, ok := stageFunc[s]; ok {
-			if err := execStage(s, f); err != nil {
-				return err
+
+	// When staggering, stages from snapshot to upload are executed for one
+	// DC after another. A resumed run keeps staggering if the previous run
+	// was staggered, DCs backed up by the previous run are skipped.
+	stagger := !target.PurgeOnly && (target.StaggerDC || run.StaggerDC != "")
+	execStaggered := func() error {
+		var (
+			prevDC = run.StaggerDC
+			skip   = run.PrevID != uuid.Nil && prevDC != ""
+		)
+		for _, dc := range run.DC {
+			dcHosts := hostsInDC(hi, dc)
+			if len(dcHosts) == 0 {
+				continue
+			}
+
+			resume := false
+			if run.PrevID != uuid.Nil {
+				if skip && dc != prevDC {
+					continue
+				}
+				resume = skip || prevDC == ""
+				skip = false
+			}
+
+			s.updateStaggerDC(ctx, run, dc)
+			for _, stage := range staggeredStages {
+				if err := execStage(stage, dcHosts, resume); err != nil {
+					return errors.Wrap(err, dc)
+				}
 			}
 		}
+		return nil
+	}
+
+	for _, stage := range StageOrder() {
+		if _, ok := stageFunc[stage]; !ok {
+			continue
+		}
+		if stagger && isStaggeredStage(stage) {
+			if stage == staggeredStages[0] {
+				if err := execStaggered(); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := execStage(stage, hi, run.PrevID != uuid.Nil); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// staggeredStages are executed for one DC after another when staggering.
+var staggeredStages = []Stage{
+	StageSnapshot,
+	StageIndex,
+	StageManifest,
+	StageSchema,
+	StageUpload,
+}
+
+func isStaggeredStage(stage Stage) bool {
+	for _, s := range staggeredStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
 // decorateWithPrevRun gets task previous run and if it can be continued
 // sets PrevID on the given run.
 func (s *Service) decorateWithPrevRun(ctx context.Context, run *Run) error {
@@ -859,6 +1123,7 @@ func (s *Service) decorateWithPrevRun(ctx context.Context, run *Run) error {
 	run.DC = prev.DC
 	run.Nodes = prev.Nodes
 	run.Stage = prev.Stage
+	run.StaggerDC = prev.StaggerDC
 
 	return nil
 }
@@ -941,6 +1206,16 @@ func (s *Service) updateStage(ctx context.Context, run *Run, stage Stage) {
 	}
 }
 
+// updateStaggerDC updates and persists DC being backed up.
+func (s *Service) updateStaggerDC(ctx context.Context, run *Run, dc string) {
+	run.StaggerDC = dc
+
+	q := table.BackupRun.UpdateQuery(s.session, "stagger_dc").BindStruct(run)
+	if err := q.ExecRelease(); err != nil {
+		s.logger.Error(ctx, "Failed to update run DC", "error", err)
+	}
+}
+
 // putRunProgress upserts a backup run progress.
 func (s *Service) putRunProgress(ctx context.Context, p *RunProgress) error {
 	s.logger.Debug(ctx, "PutRunProgress", "run_progress", p)
@@ -956,6 +1231,64 @@ func (s *Service) putRunProgressLogError(ctx context.Context, p *RunProgress) {
 	}
 }
 
+// putHookProgress upserts a backup run hook progress.
+func (s *Service) putHookProgress(ctx context.Context, p *HookProgress) error {
+	s.logger.Debug(ctx, "PutHookProgress", "hook_progress", p)
+
+	q := table.BackupRunHookProgress.InsertQuery(s.session).BindStruct(p)
+	return q.ExecRelease()
+}
+
+// putHookProgressLogError executes putHookProgress and consumes the error.
+func (s *Service) putHookProgressLogError(ctx context.Context, p *HookProgress) {
+	if err := s.putHookProgress(ctx, p); err != nil {
+		s.logger.Error(ctx, "Failed to update hook progress", "error", err)
+	}
+}
+
+// getHookProgress returns results of hooks run in the run.
+func (s *Service) getHookProgress(run *Run) ([]HookProgress, error) {
+	q := table.BackupRunHookProgress.SelectQuery(s.session).BindMap(qb.M{
+		"cluster_id": run.ClusterID,
+		"task_id":    run.TaskID,
+		"run_id":     run.ID,
+	})
+
+	var hooks []HookProgress
+	return hooks, q.SelectRelease(&hooks)
+}
+
+// publishRunProgress sends backup progress event for a table.
+func (s *Service) publishRunProgress(ctx context.Context, units []Unit, p *RunProgress) {
+	var keyspace string
+	if p.Unit >= 0 && p.Unit < int64(len(units)) {
+		keyspace = units[p.Unit].Keyspace
+	}
+	s.events.Publish(ctx, events.Event{
+		Type:      events.BackupProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "backup",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: HostTableProgress{
+			Host:     p.Host,
+			Keyspace: keyspace,
+			TableProgress: TableProgress{
+				progress: progress{
+					Size:        p.Size,
+					Uploaded:    p.Uploaded,
+					Skipped:     p.Skipped,
+					Failed:      p.Failed,
+					StartedAt:   p.StartedAt,
+					CompletedAt: p.CompletedAt,
+				},
+				Table: p.TableName,
+				Error: p.Error,
+			},
+		},
+	})
+}
+
 func (s *Service) resumeUploadProgress(prevRunID uuid.UUID) func(context.Context, *RunProgress) {
 	return func(ctx context.Context, p *RunProgress) {
 		if prevRunID == uuid.Nil {
@@ -1026,16 +1359,71 @@ func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid
 		return Progress{}, err
 	}
 
+	hooks, err := s.getHookProgress(run)
+	if err != nil {
+		return Progress{}, errors.Wrap(err, "get hook progress")
+	}
+
 	switch run.Stage {
 	case stageNone, StageInit, StageSnapshot, StageIndex:
 		return Progress{
 			SnapshotTag: run.SnapshotTag,
 			DC:          run.DC,
+			Hooks:       hooks,
 			Stage:       run.Stage,
 		}, nil
 	}
 
-	return aggregateProgress(run, NewProgressVisitor(run, s.session))
+	p, err := aggregateProgress(run, NewProgressVisitor(run, s.session))
+	if err != nil {
+		return p, err
+	}
+	p.Hooks = hooks
+	s.decorateWithRateLimits(&p, run)
+	return p, nil
+}
+
+// decorateWithRateLimits sets rate limits of the run if it's running.
+func (s *Service) decorateWithRateLimits(p *Progress, run *Run) {
+	s.mu.Lock()
+	rl, ok := s.rateLimiters[run.ClusterID]
+	s.mu.Unlock()
+	if !ok || rl.runID != run.ID {
+		return
+	}
+
+	now := timeutc.Now()
+	limits, schedule := rl.Limits()
+	for _, dc := range run.DC {
+		p.RateLimit = append(p.RateLimit, DCLimit{
+			DC:    dc,
+			Limit: rateLimitAt(limits, schedule, dc, now),
+		})
+	}
+	p.RateLimitSchedule = schedule
+}
+
+// SetRateLimit changes rate limits of an ongoing backup, limits take effect
+// immediately and replace the rate limits and schedule of the backup task
+// for the rest of the run.
+func (s *Service) SetRateLimit(ctx context.Context, clusterID uuid.UUID, limits []DCLimit, schedule []ScheduledRateLimit) error {
+	s.mu.Lock()
+	rl, ok := s.rateLimiters[clusterID]
+	s.mu.Unlock()
+
+	if !ok {
+		return errors.Wrap(service.ErrNotFound, "backup task")
+	}
+
+	s.logger.Info(ctx, "Setting backup rate limit",
+		"cluster_id", clusterID,
+		"run_id", rl.runID,
+		"rate_limit", limits,
+		"rate_limit_schedule", schedule,
+	)
+	rl.Set(limits, schedule)
+
+	return nil
 }
 
 // DeleteSnapshot deletes backup data and meta files associated with provided snapshotTag.
@@ -1060,7 +1448,11 @@ func (s *Service) DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locat
 		return errors.Wrap(err, "resolve hosts")
 	}
 
-	deletedManifests := atomic.NewInt32(0)
+	var (
+		deletedManifests = atomic.NewInt32(0)
+		locked           = make(map[string]time.Time)
+		mu               sync.Mutex
+	)
 	if err := hostsInParallel(hosts, parallel.NoLimit, func(h hostInfo) error {
 		s.logger.Info(ctx, "Purging snapshot data on host", "host", h.IP)
 
@@ -1069,6 +1461,11 @@ func (s *Service) DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locat
 			return err
 		}
 		p := newPurger(client, h.IP, s.logger)
+		p.OnLocked = func(snapshotTag string, retainUntil time.Time) {
+			mu.Lock()
+			locked[snapshotTag] = retainUntil
+			mu.Unlock()
+		}
 		n, err := p.PurgeSnapshotTags(ctx, manifests, strset.New(snapshotTags...))
 		deletedManifests.Add(int32(n))
 
@@ -1082,6 +1479,19 @@ func (s *Service) DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locat
 		return err
 	}
 
+	if len(locked) > 0 {
+		tags := make([]string, 0, len(locked))
+		for t := range locked {
+			tags = append(tags, t)
+		}
+		sort.Strings(tags)
+		msg := make([]string, len(tags))
+		for i, t := range tags {
+			msg[i] = fmt.Sprintf("%s until %s", t, locked[t].Format(time.RFC3339))
+		}
+		return service.ErrValidate(errors.Errorf("snapshots are locked: %s", strings.Join(msg, ", ")))
+	}
+
 	if deletedManifests.Load() == 0 {
 		return service.ErrNotFound
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/pkg/service"
@@ -122,7 +123,7 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 		}
 	}
 
-	hosts, err := makeHostInfo(target.liveNodes, target.Location, nil)
+	hosts, err := makeHostInfo(target.liveNodes, target.Location, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -136,9 +137,10 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 	pop := popNodeIDManifestsForLocation(manifests)
 
 	var (
-		brokenSnapshots = strset.New()
-		orphanedFiles   int
-		mu              sync.Mutex
+		brokenSnapshots   = strset.New()
+		unlockedSnapshots = strset.New()
+		orphanedFiles     int
+		mu                sync.Mutex
 	)
 
 	if err := hostsInParallel(hosts, parallel.NoLimit, func(h hostInfo) error {
@@ -169,6 +171,7 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 			if err := s.putValidationRunProgress(progress); err != nil {
 				s.logger.Error(ctx, "Failed to put validation result", "error", err)
 			}
+			s.publishValidationRunProgress(ctx, progress)
 		}
 
 		p.OnScan = func(scanned, orphaned int, orphanedBytes int64) {
@@ -226,6 +229,7 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 			// Aggregate results
 			mu.Lock()
 			brokenSnapshots.Add(v.BrokenSnapshots...)
+			unlockedSnapshots.Add(v.UnlockedSnapshots...)
 			orphanedFiles += v.OrphanedFiles
 			mu.Unlock()
 
@@ -253,6 +257,11 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 		sort.Strings(bs)
 		msg = append(msg, fmt.Sprintf("broken snapshots: %s", strings.Join(bs, ", ")))
 	}
+	if !unlockedSnapshots.IsEmpty() {
+		us := unlockedSnapshots.List()
+		sort.Strings(us)
+		msg = append(msg, fmt.Sprintf("unlocked snapshots: %s", strings.Join(us, ", ")))
+	}
 	if !target.DeleteOrphanedFiles && orphanedFiles > 0 {
 		msg = append(msg, "orphaned files")
 	}
@@ -298,6 +307,25 @@ func (s *Service) putValidationRunProgress(p validationRunProgress) error {
 	return table.ValidateBackupRunProgress.InsertQuery(s.session).BindStruct(p).ExecRelease()
 }
 
+func (s *Service) publishValidationRunProgress(ctx context.Context, p validationRunProgress) {
+	s.events.Publish(ctx, events.Event{
+		Type:      events.ValidateBackupProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "validate_backup",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: ValidationHostProgress{
+			DC:               p.DC,
+			Host:             p.Host,
+			Location:         p.Location,
+			Manifests:        p.Manifests,
+			StartedAt:        p.StartedAt,
+			CompletedAt:      p.CompletedAt,
+			ValidationResult: p.ValidationResult,
+		},
+	})
+}
+
 // ValidationHostProgress represents validation results per host.
 type ValidationHostProgress struct {
 	DC          string     `json:"dc"`
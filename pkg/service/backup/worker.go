@@ -7,22 +7,25 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
 	"github.com/scylladb/scylla-manager/pkg/util/uuid"
 )
 
 // hostInfo groups target host properties needed for backup.
 type hostInfo struct {
-	DC        string
-	IP        string
-	ID        string
-	Location  Location
-	RateLimit DCLimit
+	DC           string
+	IP           string
+	ID           string
+	Location     Location
+	RateLimit    DCLimit
+	StorageClass string
 }
 
 func (h hostInfo) String() string {
@@ -56,8 +59,14 @@ type worker struct {
 	Config        Config
 	Metrics       metrics.BackupMetrics
 	Units         []Unit
+	ObjectTags    bool
+	LockPeriod    time.Duration
+	LockMode      LockMode
+	Partial       bool
+	Hooks         Hooks
 	Schema        *bytes.Buffer
 	Client        *scyllaclient.Client
+	RateLimiter   *rateLimiter
 	Logger        log.Logger
 	OnRunProgress func(ctx context.Context, p *RunProgress)
 	// ResumeUploadProgress populates upload stats of the provided run progress
@@ -65,6 +74,8 @@ type worker struct {
 	// If there is no previous run there should be no update.
 	// It's required to provide Size as current disk size of files.
 	ResumeUploadProgress func(ctx context.Context, p *RunProgress)
+	// OnHookProgress is called with result of every hook run.
+	OnHookProgress func(ctx context.Context, p *HookProgress)
 
 	// Cache for host snapshotDirs
 	snapshotDirs map[string][]snapshotDir
@@ -78,6 +89,21 @@ func (w *worker) WithLogger(logger log.Logger) *worker {
 	return w
 }
 
+// retainUntil returns time until files of the backup are locked, it's based
+// on the snapshot tag so that it does not change when backup is resumed.
+// It returns nil if files are not locked.
+func (w *worker) retainUntil() *time.Time {
+	if w.LockPeriod <= 0 {
+		return nil
+	}
+	t, err := SnapshotTagTime(w.SnapshotTag)
+	if err != nil {
+		t = timeutc.Now()
+	}
+	t = t.Add(w.LockPeriod)
+	return &t
+}
+
 func (w *worker) hostSnapshotDirs(h hostInfo) []snapshotDir {
 	w.mu.Lock()
 	defer w.mu.Unlock()
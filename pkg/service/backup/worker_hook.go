@@ -0,0 +1,66 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+// runHook runs the named hook on the host and reports the result with
+// OnHookProgress. A hook fails if it can't be run or returns non-zero exit
+// code, the failure is returned as error only with the abort failure policy.
+func (w *worker) runHook(ctx context.Context, h hostInfo, stage, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	w.Logger.Info(ctx, "Running hook", "host", h.IP, "stage", stage, "name", name)
+
+	now := timeutc.Now()
+	p := &HookProgress{
+		ClusterID: w.ClusterID,
+		TaskID:    w.TaskID,
+		RunID:     w.RunID,
+		Host:      h.IP,
+		Stage:     stage,
+		Name:      name,
+		StartedAt: &now,
+	}
+
+	res, err := w.Client.RunHook(ctx, h.IP, name, stage, w.SnapshotTag)
+	if err == nil {
+		p.ExitCode = int(res.ExitCode)
+		p.Output = res.Output
+		if res.ExitCode != 0 {
+			err = errors.Errorf("exit code %d", res.ExitCode)
+		}
+	}
+	if err != nil {
+		p.Error = err.Error()
+	}
+	end := timeutc.Now()
+	p.CompletedAt = &end
+	if w.OnHookProgress != nil {
+		w.OnHookProgress(ctx, p)
+	}
+
+	if err == nil {
+		w.Logger.Info(ctx, "Done running hook", "host", h.IP, "stage", stage, "name", name)
+		return nil
+	}
+
+	w.Logger.Error(ctx, "Hook failed",
+		"host", h.IP,
+		"stage", stage,
+		"name", name,
+		"error", err,
+		"output", p.Output,
+	)
+	if w.Hooks.OnFailure == HookFailureContinue {
+		return nil
+	}
+	return errors.Wrapf(err, "%s hook %s", stage, name)
+}
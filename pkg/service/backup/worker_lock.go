@@ -0,0 +1,89 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+// Lock makes SSTables, schema and manifest of the backup immutable until
+// the end of the lock period. Files are listed based on the uploaded
+// manifests so that locking can be resumed.
+func (w *worker) Lock(ctx context.Context, hosts []hostInfo) (err error) {
+	until := w.retainUntil()
+	if until == nil {
+		return nil
+	}
+
+	w.Logger.Info(ctx, "Locking backup files...", "retain_until", *until, "mode", w.LockMode)
+	defer func(start time.Time) {
+		if err != nil {
+			w.Logger.Error(ctx, "Locking backup files failed see exact errors above", "duration", timeutc.Since(start))
+		} else {
+			w.Logger.Info(ctx, "Done locking backup files", "duration", timeutc.Since(start))
+		}
+	}(timeutc.Now())
+
+	return hostsInParallel(hosts, parallel.NoLimit, func(h hostInfo) error {
+		w.Logger.Info(ctx, "Locking backup files on host", "host", h.IP)
+
+		err := w.lockHost(ctx, h, *until)
+		if err != nil {
+			w.Logger.Error(ctx, "Locking backup files failed on host", "host", h.IP, "error", err)
+		} else {
+			w.Logger.Info(ctx, "Done locking backup files on host", "host", h.IP)
+		}
+
+		return err
+	})
+}
+
+func (w *worker) lockHost(ctx context.Context, h hostInfo, until time.Time) error {
+	m := &ManifestInfo{
+		Location:    h.Location,
+		DC:          h.DC,
+		ClusterID:   w.ClusterID,
+		NodeID:      h.ID,
+		TaskID:      w.TaskID,
+		SnapshotTag: w.SnapshotTag,
+	}
+
+	var c ManifestContent
+	r, err := w.Client.RcloneOpen(ctx, h.IP, h.Location.RemotePath(m.Path()))
+	if err != nil {
+		return errors.Wrap(err, "open manifest")
+	}
+	err = c.Read(r)
+	r.Close()
+	if err != nil {
+		return errors.Wrap(err, "read manifest")
+	}
+
+	for _, fi := range c.Index {
+		if len(fi.Files) == 0 {
+			continue
+		}
+		dir := m.SSTableVersionDir(fi.Keyspace, fi.Table, fi.Version)
+		if err := w.lockFiles(ctx, h, dir, fi.Files, until); err != nil {
+			return errors.Wrapf(err, "%s.%s", fi.Keyspace, fi.Table)
+		}
+	}
+	if c.Schema != "" {
+		if err := w.lockFiles(ctx, h, path.Dir(c.Schema), []string{path.Base(c.Schema)}, until); err != nil {
+			return errors.Wrap(err, "schema")
+		}
+	}
+	return errors.Wrap(w.lockFiles(ctx, h, path.Dir(m.Path()), []string{path.Base(m.Path())}, until), "manifest")
+}
+
+func (w *worker) lockFiles(ctx context.Context, h hostInfo, dir string, files []string, until time.Time) error {
+	w.Logger.Debug(ctx, "Locking files", "host", h.IP, "dir", dir, "files", len(files))
+	return w.Client.RcloneLock(ctx, h.IP, h.Location.RemotePath(dir), files, agentLockMode(w.LockMode), until)
+}
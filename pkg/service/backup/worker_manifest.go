@@ -73,6 +73,8 @@ func (w *worker) createTemporaryManifest(h hostInfo, tokens []int64) ManifestInf
 		IP:          h.IP,
 		Index:       make([]FilesMeta, len(dirs)),
 		Tokens:      tokens,
+		RetainUntil: w.retainUntil(),
+		Partial:     w.Partial,
 	}
 	if w.Schema != nil {
 		c.Schema = RemoteSchemaFile(w.ClusterID, w.TaskID, w.SnapshotTag)
@@ -84,8 +86,10 @@ func (w *worker) createTemporaryManifest(h hostInfo, tokens []int64) ManifestInf
 		idx.Table = d.Table
 		idx.Version = d.Version
 		idx.Files = make([]string, 0, len(d.Progress.files))
+		idx.FileSizes = make([]int64, 0, len(d.Progress.files))
 		for _, f := range d.Progress.files {
 			idx.Files = append(idx.Files, f.Name)
+			idx.FileSizes = append(idx.FileSizes, f.Size)
 			idx.Size += f.Size
 		}
 		c.Size += d.Progress.Size
@@ -62,6 +62,12 @@ func (w *worker) Purge(ctx context.Context, hosts []hostInfo, policy map[uuid.UU
 			}
 			w.Metrics.SetPurgeFiles(w.ClusterID, host, total, success)
 		}
+		p.OnLocked = func(snapshotTag string, retainUntil time.Time) {
+			w.Logger.Info(ctx, "Snapshot is locked, it will be purged after retention ends",
+				"snapshot_tag", snapshotTag,
+				"retain_until", retainUntil,
+			)
+		}
 
 		f := func(nodeID string, manifests []*ManifestInfo) error {
 			var logger log.Logger
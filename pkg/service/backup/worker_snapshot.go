@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"go.uber.org/multierr"
 )
 
 func (w *worker) Snapshot(ctx context.Context, hosts []hostInfo, limits []DCLimit) (err error) {
@@ -40,7 +41,15 @@ func (w *worker) snapshotHost(ctx context.Context, h hostInfo) error {
 	if err := w.checkAvailableDiskSpace(ctx, h); err != nil {
 		return err
 	}
-	return w.takeSnapshot(ctx, h)
+
+	// The post snapshot hook is run even if the pre snapshot hook or snapshot
+	// failed so that it can undo what the pre snapshot hook did
+	// i.e. resume paused ingest.
+	err := w.runHook(ctx, h, HookPreSnapshot, w.Hooks.PreSnapshot)
+	if err == nil {
+		err = w.takeSnapshot(ctx, h)
+	}
+	return multierr.Append(err, w.runHook(ctx, h, HookPostSnapshot, w.Hooks.PostSnapshot))
 }
 
 func (w *worker) checkAvailableDiskSpace(ctx context.Context, h hostInfo) error {
@@ -0,0 +1,54 @@
+// Copyright (C) 2017 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient/scyllaclienttest"
+	. "github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+)
+
+func TestDeleteOldSnapshotsKeepsLocalSnapshots(t *testing.T) {
+	var (
+		oldTag   = SnapshotTagAt(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		curTag   = NewSnapshotTag()
+		localTag = snapshot.NewLocalSnapshotTag()
+		deleted  []string
+	)
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/storage_service/snapshots" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `[{"key":%q,"value":[]},{"key":%q,"value":[]},{"key":%q,"value":[]}]`, oldTag, curTag, localTag)
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Query().Get("tag"))
+		}
+	})
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerWithHandler(t, h)
+	defer closeServer()
+
+	w := &worker{
+		SnapshotTag: curTag,
+		Client:      client,
+		Logger:      log.NewDevelopment(),
+	}
+	if err := w.deleteOldSnapshots(context.Background(), hostInfo{IP: scyllaclienttest.TestHost}); err != nil {
+		t.Fatal("deleteOldSnapshots() error", err)
+	}
+	if diff := cmp.Diff(deleted, []string{oldTag}); diff != "" {
+		t.Fatalf("deleteOldSnapshots() deleted %s", diff)
+	}
+}
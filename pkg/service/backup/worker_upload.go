@@ -23,6 +23,12 @@ func (w *worker) Upload(ctx context.Context, hosts []hostInfo, limits []DCLimit)
 		}
 	}(timeutc.Now())
 
+	if w.RateLimiter != nil {
+		rctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go w.updateRateLimits(rctx, hosts)
+	}
+
 	return inParallelWithLimits(hosts, limits, func(h hostInfo) error {
 		w.Logger.Info(ctx, "Uploading snapshot files on host", "host", h.IP)
 		if err := w.uploadHost(ctx, h); err != nil {
@@ -31,7 +37,7 @@ func (w *worker) Upload(ctx context.Context, hosts []hostInfo, limits []DCLimit)
 		}
 
 		w.Logger.Info(ctx, "Done uploading snapshot files on host", "host", h.IP)
-		return nil
+		return w.runHook(ctx, h, HookPostUpload, w.Hooks.PostUpload)
 	})
 }
 
@@ -143,8 +149,48 @@ func (w *worker) snapshotJobID(ctx context.Context, d snapshotDir) int64 {
 }
 
 func (w *worker) setRateLimit(ctx context.Context, h hostInfo) error {
-	w.Logger.Info(ctx, "Setting rate limit", "host", h.IP, "limit", h.RateLimit.Limit)
-	return w.Client.RcloneSetBandwidthLimit(ctx, h.IP, h.RateLimit.Limit)
+	limit := h.RateLimit.Limit
+	if w.RateLimiter != nil {
+		limit = w.RateLimiter.Limit(h.DC, timeutc.Now())
+	}
+	w.Logger.Info(ctx, "Setting rate limit", "host", h.IP, "limit", limit)
+	// Only uploads are limited so that downloads on the node are not slowed
+	// down by the backup.
+	return w.Client.RcloneSetBandwidthLimits(ctx, h.IP, limit, 0)
+}
+
+// updateRateLimits sets rate limits on hosts at the scheduled times of day
+// and whenever rate limits are changed, until ctx is canceled.
+func (w *worker) updateRateLimits(ctx context.Context, hosts []hostInfo) {
+	for {
+		var (
+			timer *time.Timer
+			tick  <-chan time.Time
+		)
+		if next := w.RateLimiter.Next(timeutc.Now()); !next.IsZero() {
+			timer = time.NewTimer(next.Sub(timeutc.Now()))
+			tick = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-tick:
+		case <-w.RateLimiter.Changed():
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+
+		for _, h := range hosts {
+			if err := w.setRateLimit(ctx, h); err != nil {
+				w.Logger.Error(ctx, "Failed to set rate limit", "host", h.IP, "error", err)
+			}
+		}
+	}
 }
 
 func (w *worker) uploadSnapshotDir(ctx context.Context, h hostInfo, d snapshotDir) error {
@@ -163,7 +209,7 @@ func (w *worker) uploadSnapshotDir(ctx context.Context, h hostInfo, d snapshotDi
 		retries      = 10
 	)
 	for i := 0; i < retries; i++ {
-		if err := w.uploadDataDir(ctx, dataDst, dataSrc, d); err != nil {
+		if err := w.uploadDataDir(ctx, dataDst, dataSrc, w.uploadOptions(h), d); err != nil {
 			if errors.Is(err, errJobNotFound) {
 				continue
 			}
@@ -175,8 +221,8 @@ func (w *worker) uploadSnapshotDir(ctx context.Context, h hostInfo, d snapshotDi
 	return nil
 }
 
-func (w *worker) uploadDataDir(ctx context.Context, dst, src string, d snapshotDir) error {
-	id, err := w.Client.RcloneMoveDir(ctx, d.Host, dst, src)
+func (w *worker) uploadDataDir(ctx context.Context, dst, src string, opts scyllaclient.RcloneUploadOptions, d snapshotDir) error {
+	id, err := w.Client.RcloneMoveDirWithOptions(ctx, d.Host, dst, src, opts)
 	if err != nil {
 		return err
 	}
@@ -192,6 +238,24 @@ func (w *worker) uploadDataDir(ctx context.Context, dst, src string, d snapshotD
 	return nil
 }
 
+// uploadOptions returns storage class of the host location and, if object
+// tags are enabled, metadata identifying the backup the SSTables were
+// uploaded by. SSTables are deduplicated so a file keeps metadata of
+// the first backup that uploaded it.
+func (w *worker) uploadOptions(h hostInfo) scyllaclient.RcloneUploadOptions {
+	opts := scyllaclient.RcloneUploadOptions{
+		StorageClass: h.StorageClass,
+	}
+	if w.ObjectTags {
+		opts.Metadata = map[string]string{
+			"scylla-manager-cluster-id":   w.ClusterID.String(),
+			"scylla-manager-task-id":      w.TaskID.String(),
+			"scylla-manager-snapshot-tag": w.SnapshotTag,
+		}
+	}
+	return opts
+}
+
 var errJobNotFound = errors.New("job not found")
 
 func (w *worker) waitJob(ctx context.Context, id int64, d snapshotDir) (err error) {
@@ -0,0 +1,110 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cleanup
+
+import (
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Unit represents keyspace and its tables.
+type Unit struct {
+	Keyspace  string   `json:"keyspace"`
+	Tables    []string `json:"tables,omitempty"`
+	AllTables bool     `json:"all_tables"`
+}
+
+// Target specifies what shall be cleaned up and how.
+type Target struct {
+	Units    []Unit           `json:"units"`
+	DC       []string         `json:"dc"`
+	Hosts    []string         `json:"hosts"`
+	Parallel []backup.DCLimit `json:"parallel"`
+	Continue bool             `json:"continue"`
+
+	nodes scyllaclient.NodeStatusInfoSlice
+}
+
+// taskProperties is the main data structure of the runner.Properties blob.
+type taskProperties struct {
+	Keyspace []string         `json:"keyspace"`
+	DC       []string         `json:"dc"`
+	Parallel []backup.DCLimit `json:"parallel"`
+	Continue bool             `json:"continue"`
+}
+
+func defaultTaskProperties() taskProperties {
+	return taskProperties{
+		Continue: true,
+	}
+}
+
+// Run tracks cleanup progress, shares ID with scheduler.Run that initiated it.
+type Run struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	ID        uuid.UUID
+
+	PrevID    uuid.UUID
+	DC        []string
+	StartTime time.Time
+}
+
+// RunProgress describes cleanup progress of a keyspace on a host.
+type RunProgress struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Host      string
+	Keyspace  string `db:"keyspace_name"`
+
+	DC          string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Error       string
+}
+
+// Done returns true if cleanup of the keyspace on the host succeeded.
+func (p *RunProgress) Done() bool {
+	return p.CompletedAt != nil && p.Error == ""
+}
+
+// progress counts keyspaces cleaned up on hosts.
+type progress = nodeops.Progress
+
+// Progress groups cleanup progress for all hosts.
+type Progress struct {
+	progress
+
+	DC    []string       `json:"dcs,omitempty"`
+	Hosts []HostProgress `json:"hosts,omitempty"`
+}
+
+// HostProgress groups cleanup progress for keyspaces belonging to this host.
+type HostProgress struct {
+	progress
+
+	Host      string             `json:"host"`
+	DC        string             `json:"dc"`
+	Keyspaces []KeyspaceProgress `json:"keyspaces,omitempty"`
+}
+
+// KeyspaceProgress defines cleanup progress for the keyspace on a host.
+type KeyspaceProgress struct {
+	Keyspace    string     `json:"keyspace"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// HostKeyspaceProgress defines progress for the keyspace on a host.
+// It's published as cleanup progress event.
+type HostKeyspaceProgress struct {
+	Host string `json:"host"`
+	DC   string `json:"dc"`
+	KeyspaceProgress
+}
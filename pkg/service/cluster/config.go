@@ -0,0 +1,46 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/service"
+)
+
+// TopologyPolicies specifies actions taken when cluster topology changes.
+type TopologyPolicies struct {
+	// PauseRepairOnNodeJoin stops running repairs when a node starts joining
+	// the cluster, the repairs are resumed after all nodes joined.
+	PauseRepairOnNodeJoin bool `yaml:"pause_repair_on_node_join"`
+	// CleanupOnNodeAdded runs cleanup of all the nodes after a node joined
+	// the cluster and there are no other joining nodes.
+	CleanupOnNodeAdded bool `yaml:"cleanup_on_node_added"`
+}
+
+// Config specifies the cluster service configuration.
+type Config struct {
+	// TopologyCheckInterval specifies how often clusters are checked for
+	// topology changes, 0 disables the checks.
+	TopologyCheckInterval time.Duration    `yaml:"topology_check_interval"`
+	TopologyPolicies      TopologyPolicies `yaml:"topology_policies"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		TopologyCheckInterval: 5 * time.Minute,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return service.ErrNilPtr
+	}
+
+	if c.TopologyCheckInterval < 0 {
+		return errors.New("invalid topology_check_interval, must be >= 0")
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ package cluster
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/pkg/service"
@@ -20,11 +21,17 @@ type Cluster struct {
 	Port       int       `json:"port,omitempty"`
 	AuthToken  string    `json:"auth_token"`
 
+	Labels map[string]string `json:"labels,omitempty"`
+
 	Username        string `json:"username,omitempty" db:"-"`
 	Password        string `json:"password,omitempty" db:"-"`
 	SSLUserCertFile []byte `json:"ssl_user_cert_file,omitempty" db:"-"`
 	SSLUserKeyFile  []byte `json:"ssl_user_key_file,omitempty" db:"-"`
 	WithoutRepair   bool   `json:"without_repair,omitempty" db:"-"`
+
+	AgentTLSCertFile []byte `json:"agent_tls_cert_file,omitempty" db:"-"`
+	AgentTLSKeyFile  []byte `json:"agent_tls_key_file,omitempty" db:"-"`
+	AgentTLSCAFile   []byte `json:"agent_tls_ca_file,omitempty" db:"-"`
 }
 
 // String returns cluster Name or ID if Name is is empty.
@@ -63,13 +70,58 @@ func (c *Cluster) Validate() error {
 		_, err := tls.X509KeyPair(c.SSLUserCertFile, c.SSLUserKeyFile)
 		errs = multierr.Append(errs, errors.Wrap(err, "invalid SSL user key pair"))
 	}
+	if len(c.AgentTLSCertFile) != 0 && len(c.AgentTLSKeyFile) == 0 {
+		errs = multierr.Append(errs, errors.New("missing agent TLS key"))
+	}
+	if len(c.AgentTLSKeyFile) != 0 && len(c.AgentTLSCertFile) == 0 {
+		errs = multierr.Append(errs, errors.New("missing agent TLS cert"))
+	}
+	if len(c.AgentTLSCertFile) != 0 {
+		_, err := tls.X509KeyPair(c.AgentTLSCertFile, c.AgentTLSKeyFile)
+		errs = multierr.Append(errs, errors.Wrap(err, "invalid agent TLS key pair"))
+	}
+	if len(c.AgentTLSCAFile) != 0 && !x509.NewCertPool().AppendCertsFromPEM(c.AgentTLSCAFile) {
+		errs = multierr.Append(errs, errors.New("invalid agent TLS CA, no PEM encoded certificates found"))
+	}
+	errs = multierr.Append(errs, validateLabels(c.Labels))
 
 	return service.ErrValidate(errors.Wrap(errs, "invalid cluster"))
 }
 
+// hasAgentTLS returns true if any of the agent TLS files is set.
+func (c *Cluster) hasAgentTLS() bool {
+	return len(c.AgentTLSCertFile) != 0 || len(c.AgentTLSKeyFile) != 0 || len(c.AgentTLSCAFile) != 0
+}
+
+// agentTLSConfig returns TLS config for connecting to agents. If CA is set
+// agent certificates are verified, if client certificate is set it's
+// presented to agents.
+func (c *Cluster) agentTLSConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: true,
+	}
+	if len(c.AgentTLSCertFile) != 0 {
+		keyPair, err := tls.X509KeyPair(c.AgentTLSCertFile, c.AgentTLSKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid agent TLS key pair")
+		}
+		cfg.Certificates = []tls.Certificate{keyPair}
+	}
+	if len(c.AgentTLSCAFile) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(c.AgentTLSCAFile) {
+			return nil, errors.New("invalid agent TLS CA")
+		}
+		cfg.InsecureSkipVerify = false
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
 // Filter filters Clusters.
 type Filter struct {
-	Name string
+	Name     string
+	Selector LabelSelector
 }
 
 func (f *Filter) Validate() error {
@@ -0,0 +1,54 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"testing"
+)
+
+func TestClusterValidateAgentTLS(t *testing.T) {
+	table := []struct {
+		Name    string
+		Cluster Cluster
+	}{
+		{
+			Name:    "Missing key",
+			Cluster: Cluster{AgentTLSCertFile: []byte("cert")},
+		},
+		{
+			Name:    "Missing cert",
+			Cluster: Cluster{AgentTLSKeyFile: []byte("key")},
+		},
+		{
+			Name:    "Invalid key pair",
+			Cluster: Cluster{AgentTLSCertFile: []byte("cert"), AgentTLSKeyFile: []byte("key")},
+		},
+		{
+			Name:    "Invalid CA",
+			Cluster: Cluster{AgentTLSCAFile: []byte("ca")},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if err := test.Cluster.Validate(); err == nil {
+				t.Fatal("Validate() expected error")
+			}
+		})
+	}
+}
+
+func TestClusterAgentTLSConfig(t *testing.T) {
+	c := Cluster{}
+	cfg, err := c.agentTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.InsecureSkipVerify {
+		t.Fatal("InsecureSkipVerify expected without CA")
+	}
+	if len(cfg.Certificates) != 0 {
+		t.Fatal("Certificates expected empty")
+	}
+}
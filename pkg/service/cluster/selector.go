@@ -0,0 +1,128 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]{0,61}[a-zA-Z0-9])?$`)
+	labelValueRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9._/-]{0,61}[a-zA-Z0-9])?)?$`)
+)
+
+// validateLabels checks if label keys and values consist of alphanumeric
+// characters, '.', '_', '/' or '-' and are at most 63 characters long.
+// Label values may be empty.
+func validateLabels(labels map[string]string) error {
+	var errs error
+	for k, v := range labels {
+		if !labelKeyRegexp.MatchString(k) {
+			errs = multierr.Append(errs, errors.Errorf("invalid label key %q", k))
+		}
+		if !labelValueRegexp.MatchString(v) {
+			errs = multierr.Append(errs, errors.Errorf("invalid label %q value %q", k, v))
+		}
+	}
+	return errs
+}
+
+type labelOperator int
+
+const (
+	labelEquals labelOperator = iota
+	labelNotEquals
+	labelExists
+	labelDoesNotExist
+)
+
+type labelRequirement struct {
+	Key      string
+	Operator labelOperator
+	Value    string
+}
+
+func (r labelRequirement) matches(labels map[string]string) bool {
+	v, ok := labels[r.Key]
+	switch r.Operator {
+	case labelEquals:
+		return ok && v == r.Value
+	case labelNotEquals:
+		return !ok || v != r.Value
+	case labelExists:
+		return ok
+	case labelDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// LabelSelector selects clusters based on their labels. It's a list of
+// requirements that all need to be met.
+type LabelSelector []labelRequirement
+
+// ParseLabelSelector parses comma separated list of requirements.
+// Supported requirements are:
+//
+//	key=value, key==value - label key is set to value
+//	key!=value - label key is not set to value or is not set at all
+//	key - label key is set
+//	!key - label key is not set
+//
+// An empty string results in a selector that matches all clusters.
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	var out LabelSelector
+	for _, r := range strings.Split(s, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+
+		var lr labelRequirement
+		switch {
+		case strings.Contains(r, "!="):
+			lr.Operator = labelNotEquals
+			lr.Key, lr.Value = splitRequirement(r, "!=")
+		case strings.Contains(r, "=="):
+			lr.Operator = labelEquals
+			lr.Key, lr.Value = splitRequirement(r, "==")
+		case strings.Contains(r, "="):
+			lr.Operator = labelEquals
+			lr.Key, lr.Value = splitRequirement(r, "=")
+		case strings.HasPrefix(r, "!"):
+			lr.Operator = labelDoesNotExist
+			lr.Key = strings.TrimSpace(r[1:])
+		default:
+			lr.Operator = labelExists
+			lr.Key = r
+		}
+
+		if !labelKeyRegexp.MatchString(lr.Key) {
+			return nil, errors.Errorf("invalid selector %q: invalid label key %q", r, lr.Key)
+		}
+		if !labelValueRegexp.MatchString(lr.Value) {
+			return nil, errors.Errorf("invalid selector %q: invalid label value %q", r, lr.Value)
+		}
+		out = append(out, lr)
+	}
+	return out, nil
+}
+
+func splitRequirement(r, op string) (key, value string) {
+	i := strings.Index(r, op)
+	return strings.TrimSpace(r[:i]), strings.TrimSpace(r[i+len(op):])
+}
+
+// Matches returns true if labels meet all the requirements.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
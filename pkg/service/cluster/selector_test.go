@@ -0,0 +1,65 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"testing"
+)
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{
+		"env":  "prod",
+		"team": "payments",
+	}
+
+	table := []struct {
+		Selector string
+		Golden   bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env==prod", true},
+		{"env=staging", false},
+		{"env!=staging", true},
+		{"region!=eu", true},
+		{"env=prod, team=payments", true},
+		{"env=prod,team=search", false},
+		{"team", true},
+		{"region", false},
+		{"!region", true},
+		{"!env", false},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Selector, func(t *testing.T) {
+			s, err := ParseLabelSelector(test.Selector)
+			if err != nil {
+				t.Fatal("ParseLabelSelector() error", err)
+			}
+			if m := s.Matches(labels); m != test.Golden {
+				t.Fatalf("Matches() = %v, expected %v", m, test.Golden)
+			}
+		})
+	}
+}
+
+func TestParseLabelSelectorError(t *testing.T) {
+	for _, s := range []string{"=prod", "env=a b", "!", "-env"} {
+		if _, err := ParseLabelSelector(s); err == nil {
+			t.Errorf("ParseLabelSelector(%q) expected error", s)
+		}
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	if err := validateLabels(map[string]string{"env": "prod", "tier": ""}); err != nil {
+		t.Fatal("validateLabels() error", err)
+	}
+	if err := validateLabels(map[string]string{"env prod": "x"}); err == nil {
+		t.Fatal("validateLabels() expected error")
+	}
+	if err := validateLabels(map[string]string{"env": "a,b"}); err == nil {
+		t.Fatal("validateLabels() expected error")
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/gocql/gocql"
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
@@ -45,23 +47,38 @@ type Change struct {
 // Service manages cluster configurations.
 type Service struct {
 	session          gocqlx.Session
+	config           Config
 	metrics          metrics.ClusterMetrics
 	secretsStore     store.Store
 	clientCache      *scyllaclient.CachedProvider
 	logger           log.Logger
 	onChangeListener func(ctx context.Context, c Change) error
+	events           events.Publisher
+
+	topologyMu               sync.Mutex
+	topology                 map[uuid.UUID]scyllaclient.NodeStatusInfoSlice
+	onTopologyChangeListener func(ctx context.Context, u TopologyUpdate) error
+	watcherCancel            context.CancelFunc
+	watcherDone              chan struct{}
 }
 
-func NewService(session gocqlx.Session, metrics metrics.ClusterMetrics, secretsStore store.Store, l log.Logger) (*Service, error) {
+func NewService(session gocqlx.Session, config Config, metrics metrics.ClusterMetrics, secretsStore store.Store, l log.Logger) (*Service, error) {
 	if session.Session == nil || session.Closed() {
 		return nil, errors.New("invalid session")
 	}
 
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
 	s := &Service{
 		session:      session,
+		config:       config,
 		metrics:      metrics,
 		secretsStore: secretsStore,
 		logger:       l,
+		events:       events.NopPublisher,
+		topology:     make(map[uuid.UUID]scyllaclient.NodeStatusInfoSlice),
 	}
 	s.clientCache = scyllaclient.NewCachedProvider(s.client)
 
@@ -104,6 +121,10 @@ func (s *Service) client(ctx context.Context, clusterID uuid.UUID) (*scyllaclien
 		return nil, err
 	}
 
+	if err := s.loadAgentTLSIdentity(c); err != nil {
+		return nil, errors.Wrap(err, "load agent TLS identity")
+	}
+
 	client, err := s.createClient(c)
 	if err != nil {
 		return nil, errors.Wrap(err, "create client")
@@ -128,10 +149,44 @@ func (s *Service) createClient(c *Cluster) (*scyllaclient.Client, error) {
 		config.Port = fmt.Sprint(c.Port)
 	}
 	config.AuthToken = c.AuthToken
+	if c.hasAgentTLS() {
+		tlsConfig, err := c.agentTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := scyllaclient.DefaultTransport()
+		transport.TLSClientConfig = tlsConfig
+		config.Transport = transport
+	}
 
 	return scyllaclient.NewClient(config, s.logger.Named("client"))
 }
 
+// loadAgentTLSIdentity sets agent TLS files of the cluster that are not set
+// from secrets store. Client certificate and key are set together.
+func (s *Service) loadAgentTLSIdentity(c *Cluster) error {
+	if len(c.AgentTLSCertFile) != 0 && len(c.AgentTLSCAFile) != 0 {
+		return nil
+	}
+	id := &secrets.AgentTLSIdentity{
+		ClusterID: c.ID,
+	}
+	if err := s.secretsStore.Get(id); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if len(c.AgentTLSCertFile) == 0 {
+		c.AgentTLSCertFile = id.Cert
+		c.AgentTLSKeyFile = id.PrivateKey
+	}
+	if len(c.AgentTLSCAFile) == 0 {
+		c.AgentTLSCAFile = id.CA
+	}
+	return nil
+}
+
 // discoverHosts returns a list of all hosts sorted by DC speed. This is
 // an optimisation for Epsilon-Greedy host pool used internally by
 // scyllaclient.Client that makes it use supposedly faster hosts first.
@@ -184,15 +239,19 @@ func (s *Service) ListClusters(ctx context.Context, f *Filter) ([]*Cluster, erro
 	})
 
 	// Nothing to filter
-	if f.Name == "" {
+	if f.Name == "" && len(f.Selector) == 0 {
 		return clusters, nil
 	}
 
 	filtered := clusters[:0]
 	for _, u := range clusters {
-		if u.Name == f.Name {
-			filtered = append(filtered, u)
+		if f.Name != "" && u.Name != f.Name {
+			continue
+		}
+		if !f.Selector.Matches(u.Labels) {
+			continue
 		}
+		filtered = append(filtered, u)
 	}
 
 	return filtered, nil
@@ -354,6 +413,23 @@ func (s *Service) PutCluster(ctx context.Context, c *Cluster) (err error) {
 		rollback = append(rollback, r)
 	}
 
+	if c.hasAgentTLS() {
+		withTLS := *c
+		if err := s.loadAgentTLSIdentity(&withTLS); err != nil {
+			return errors.Wrap(err, "load agent TLS identity")
+		}
+		r, err := store.PutWithRollback(s.secretsStore, &secrets.AgentTLSIdentity{
+			ClusterID:  c.ID,
+			Cert:       withTLS.AgentTLSCertFile,
+			PrivateKey: withTLS.AgentTLSKeyFile,
+			CA:         withTLS.AgentTLSCAFile,
+		})
+		if err != nil {
+			return errors.Wrap(err, "save agent TLS identity")
+		}
+		rollback = append(rollback, r)
+	}
+
 	if c.Username != "" {
 		r, err := store.PutWithRollback(s.secretsStore, &secrets.CQLCreds{
 			ClusterID: c.ID,
@@ -406,7 +482,13 @@ func (s *Service) validateHostsConnectivity(ctx context.Context, c *Cluster) err
 		return errors.Wrap(err, "load known hosts")
 	}
 
-	client, err := s.createClient(c)
+	// Use stored agent TLS identity without exposing it in c
+	withTLS := *c
+	if err := s.loadAgentTLSIdentity(&withTLS); err != nil {
+		return errors.Wrap(err, "load agent TLS identity")
+	}
+
+	client, err := s.createClient(&withTLS)
 	if err != nil {
 		return errors.Wrap(err, "create client")
 	}
@@ -459,6 +541,7 @@ func (s *Service) DeleteCluster(ctx context.Context, clusterID uuid.UUID) error
 	}
 
 	s.clientCache.Invalidate(clusterID)
+	s.forgetTopology(clusterID)
 
 	return s.notifyChangeListener(ctx, Change{ID: clusterID, Type: Delete})
 }
@@ -477,6 +560,110 @@ func (s *Service) DeleteSSLUserCert(_ context.Context, clusterID uuid.UUID) erro
 	})
 }
 
+// DeleteAgentTLSIdentity removes the associated AgentTLSIdentity from secrets
+// store, the cluster client is recreated without it.
+func (s *Service) DeleteAgentTLSIdentity(_ context.Context, clusterID uuid.UUID) error {
+	if err := s.secretsStore.Delete(&secrets.AgentTLSIdentity{
+		ClusterID: clusterID,
+	}); err != nil {
+		return err
+	}
+	s.clientCache.Invalidate(clusterID)
+	return nil
+}
+
+// RotateAuthToken switches the cluster to a new Scylla Manager Agent auth
+// token. If token is not empty it's saved as the pending token, otherwise
+// the pending token saved previously is used. The current token is retired
+// only if agents on all nodes accept the pending token, otherwise the pending
+// token is kept so that rotation can be retried once agents are reconfigured.
+func (s *Service) RotateAuthToken(ctx context.Context, clusterID uuid.UUID, token string) error {
+	s.logger.Debug(ctx, "RotateAuthToken", "cluster_id", clusterID)
+
+	c, err := s.GetClusterByID(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	pending := &secrets.PendingAuthToken{
+		ClusterID: clusterID,
+	}
+	if token != "" {
+		pending.Token = token
+		if err := s.secretsStore.Put(pending); err != nil {
+			return errors.Wrap(err, "save pending auth token")
+		}
+	} else if err := s.secretsStore.Get(pending); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return service.ErrValidate(errors.New("no pending auth token, specify the new token"))
+		}
+		return errors.Wrap(err, "get pending auth token")
+	}
+
+	s.logger.Info(ctx, "Rotating agent auth token", "cluster_id", clusterID)
+
+	if err := s.validateAuthToken(ctx, c, pending.Token); err != nil {
+		return errors.Wrap(err, "new auth token not accepted, make sure it's set in auth_tokens config option on all nodes and agents are reloaded")
+	}
+
+	c.AuthToken = pending.Token
+	q := table.Cluster.UpdateQuery(s.session, "auth_token").BindStruct(c)
+	if err := q.ExecRelease(); err != nil {
+		return err
+	}
+	if err := s.secretsStore.Delete(pending); err != nil {
+		return errors.Wrap(err, "delete pending auth token")
+	}
+	s.clientCache.Invalidate(clusterID)
+
+	s.logger.Info(ctx, "Agent auth token rotated", "cluster_id", clusterID)
+
+	return nil
+}
+
+// validateAuthToken checks that agents on all the cluster nodes, including
+// the ones that are down, accept the token.
+func (s *Service) validateAuthToken(ctx context.Context, c *Cluster, token string) error {
+	if err := s.loadKnownHosts(c); err != nil {
+		return errors.Wrap(err, "load known hosts")
+	}
+	if err := s.loadAgentTLSIdentity(c); err != nil {
+		return errors.Wrap(err, "load agent TLS identity")
+	}
+
+	withToken := *c
+	withToken.AuthToken = token
+	client, err := s.createClient(&withToken)
+	if err != nil {
+		return errors.Wrap(err, "create client")
+	}
+	defer client.Close()
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return service.ErrValidate(errors.Wrap(err, "status"))
+	}
+
+	hosts := status.Hosts()
+	var errs error
+	for i, err := range client.CheckHostsConnectivity(ctx, hosts) {
+		errs = multierr.Append(errs, errors.Wrap(err, hosts[i]))
+	}
+	if errs != nil {
+		return service.ErrValidate(errors.Wrap(errs, "connectivity check"))
+	}
+
+	return nil
+}
+
+// CancelAuthTokenRotation removes the pending auth token, the cluster keeps
+// using the current token.
+func (s *Service) CancelAuthTokenRotation(_ context.Context, clusterID uuid.UUID) error {
+	return s.secretsStore.Delete(&secrets.PendingAuthToken{
+		ClusterID: clusterID,
+	})
+}
+
 // ListNodes returns information about all the nodes in the cluster.
 // Address will be set as node name if it's not resolvable.
 func (s *Service) ListNodes(ctx context.Context, clusterID uuid.UUID) ([]Node, error) {
@@ -598,7 +785,8 @@ func (s *Service) notifyChangeListener(ctx context.Context, c Change) error {
 	return s.onChangeListener(ctx, c)
 }
 
-// Close closes all connections to cluster.
+// Close stops the topology watcher and closes all connections to cluster.
 func (s *Service) Close() {
+	s.stopTopologyWatcher()
 	s.clientCache.Close()
 }
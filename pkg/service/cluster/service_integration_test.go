@@ -29,7 +29,7 @@ func TestServiceStorageIntegration(t *testing.T) {
 
 	secretsStore := store.NewTableStore(session, table.Secrets)
 
-	s, err := cluster.NewService(session, metrics.NewClusterMetrics(), secretsStore, log.NewDevelopment())
+	s, err := cluster.NewService(session, cluster.DefaultConfig(), metrics.NewClusterMetrics(), secretsStore, log.NewDevelopment())
 	if err != nil {
 		t.Fatal(err)
 	}
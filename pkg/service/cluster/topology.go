@@ -0,0 +1,293 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// TopologyChangeType specifies type of TopologyChange.
+type TopologyChangeType string
+
+// TopologyChangeType enumeration.
+const (
+	NodeAdded     TopologyChangeType = "node_added"
+	NodeRemoved   TopologyChangeType = "node_removed"
+	NodeReplaced  TopologyChangeType = "node_replaced"
+	NodeDCChanged TopologyChangeType = "node_dc_changed"
+	NodeJoining   TopologyChangeType = "node_joining"
+	NodeJoined    TopologyChangeType = "node_joined"
+)
+
+// TopologyChange describes a change of a single node detected by
+// the topology watcher. Prev fields are set for replaced nodes and nodes
+// that changed datacenter.
+type TopologyChange struct {
+	Type           TopologyChangeType `json:"type"`
+	Host           string             `json:"host"`
+	HostID         string             `json:"host_id,omitempty"`
+	Datacenter     string             `json:"dc,omitempty"`
+	PrevHost       string             `json:"prev_host,omitempty"`
+	PrevHostID     string             `json:"prev_host_id,omitempty"`
+	PrevDatacenter string             `json:"prev_dc,omitempty"`
+}
+
+// TopologyUpdate is passed to the topology change listener when changes are
+// detected in a cluster.
+type TopologyUpdate struct {
+	ClusterID uuid.UUID
+	Changes   []TopologyChange
+	// Joining lists hosts that are joining the cluster.
+	Joining []string
+}
+
+// SetOnTopologyChangeListener sets a function that would be invoked when
+// topology of a cluster changes.
+func (s *Service) SetOnTopologyChangeListener(f func(ctx context.Context, u TopologyUpdate) error) {
+	s.onTopologyChangeListener = f
+}
+
+// SetEventPublisher sets publisher that would be notified on topology
+// changes.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
+// StartTopologyWatcher starts checking topology of all the clusters every
+// Config.TopologyCheckInterval. The watcher is stopped by Close.
+func (s *Service) StartTopologyWatcher(ctx context.Context) {
+	if s.config.TopologyCheckInterval <= 0 {
+		return
+	}
+
+	s.logger.Info(ctx, "Starting topology watcher", "interval", s.config.TopologyCheckInterval)
+
+	ctx, s.watcherCancel = context.WithCancel(ctx)
+	s.watcherDone = make(chan struct{})
+
+	go func() {
+		defer close(s.watcherDone)
+
+		t := time.NewTicker(s.config.TopologyCheckInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			s.checkAllTopologies(ctx)
+		}
+	}()
+}
+
+func (s *Service) stopTopologyWatcher() {
+	if s.watcherCancel == nil {
+		return
+	}
+	s.watcherCancel()
+	<-s.watcherDone
+}
+
+func (s *Service) checkAllTopologies(ctx context.Context) {
+	clusters, err := s.ListClusters(ctx, &Filter{})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list clusters", "error", err)
+		return
+	}
+	for _, c := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := s.CheckTopology(ctx, c.ID); err != nil {
+			s.logger.Error(ctx, "Topology check failed", "cluster_id", c.ID, "error", err)
+		}
+	}
+}
+
+// CheckTopology compares the current cluster status with the status
+// observed in the previous check or, if there was no check yet, with
+// the cluster known hosts. If there are changes known hosts are updated,
+// cached client is discarded, events are published and topology change
+// listener is notified.
+func (s *Service) CheckTopology(ctx context.Context, clusterID uuid.UUID) ([]TopologyChange, error) {
+	s.logger.Debug(ctx, "CheckTopology", "cluster_id", clusterID)
+
+	c, err := s.GetClusterByID(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.Client(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client")
+	}
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "status")
+	}
+
+	s.topologyMu.Lock()
+	prev, ok := s.topology[clusterID]
+	s.topology[clusterID] = status
+	s.topologyMu.Unlock()
+	if !ok {
+		prev = knownHostsTopology(c.KnownHosts, status)
+	}
+
+	changes := diffTopology(prev, status)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	s.logger.Info(ctx, "Cluster topology changed", "cluster_id", clusterID, "changes", changes)
+
+	if hostsChanged(changes) {
+		hosts, err := s.discoverHosts(ctx, client)
+		if err != nil {
+			return changes, errors.Wrap(err, "discover cluster topology")
+		}
+		if err := s.setKnownHosts(c, hosts); err != nil {
+			return changes, errors.Wrap(err, "update cluster")
+		}
+		s.clientCache.Invalidate(clusterID)
+	}
+
+	for _, ch := range changes {
+		s.events.Publish(ctx, events.Event{
+			Type:      events.TopologyChange,
+			ClusterID: clusterID,
+			Data:      ch,
+		})
+	}
+
+	if s.onTopologyChangeListener == nil {
+		return changes, nil
+	}
+	u := TopologyUpdate{
+		ClusterID: clusterID,
+		Changes:   changes,
+		Joining:   status.State(scyllaclient.NodeStateJoining).Hosts(),
+	}
+	return changes, s.onTopologyChangeListener(ctx, u)
+}
+
+func (s *Service) forgetTopology(clusterID uuid.UUID) {
+	s.topologyMu.Lock()
+	delete(s.topology, clusterID)
+	s.topologyMu.Unlock()
+}
+
+// knownHostsTopology returns topology based on the cluster known hosts.
+// Known hosts may not contain nodes that were down when they were saved,
+// such nodes are taken from the current status so that they are not
+// reported as added.
+func knownHostsTopology(knownHosts []string, status scyllaclient.NodeStatusInfoSlice) scyllaclient.NodeStatusInfoSlice {
+	var out scyllaclient.NodeStatusInfoSlice
+	for _, h := range knownHosts {
+		out = append(out, scyllaclient.NodeStatusInfo{Addr: h})
+	}
+	for _, n := range status.Down() {
+		if !containsHost(knownHosts, n.Addr) {
+			out = append(out, scyllaclient.NodeStatusInfo{Addr: n.Addr})
+		}
+	}
+	return out
+}
+
+func containsHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+// diffTopology returns changes between prev and cur. Nodes are matched by
+// host ID and then by address. Nodes in prev without host ID are only
+// checked for being removed or joining.
+func diffTopology(prev, cur scyllaclient.NodeStatusInfoSlice) []TopologyChange {
+	var (
+		byID    = make(map[string]int, len(prev))
+		byAddr  = make(map[string]int, len(prev))
+		matched = make([]bool, len(prev))
+		out     []TopologyChange
+	)
+	for i, n := range prev {
+		if n.HostID != "" {
+			byID[n.HostID] = i
+		}
+		byAddr[n.Addr] = i
+	}
+
+	change := func(t TopologyChangeType, n scyllaclient.NodeStatusInfo) TopologyChange {
+		return TopologyChange{
+			Type:       t,
+			Host:       n.Addr,
+			HostID:     n.HostID,
+			Datacenter: n.Datacenter,
+		}
+	}
+
+	for _, n := range cur {
+		i, ok := byID[n.HostID]
+		if !ok {
+			i, ok = byAddr[n.Addr]
+		}
+		if !ok || matched[i] {
+			out = append(out, change(NodeAdded, n))
+			if n.State == scyllaclient.NodeStateJoining {
+				out = append(out, change(NodeJoining, n))
+			}
+			continue
+		}
+		matched[i] = true
+
+		p := prev[i]
+		if p.HostID != "" {
+			if p.HostID != n.HostID || p.Addr != n.Addr {
+				ch := change(NodeReplaced, n)
+				ch.PrevHost = p.Addr
+				ch.PrevHostID = p.HostID
+				out = append(out, ch)
+			}
+			if p.Datacenter != n.Datacenter {
+				ch := change(NodeDCChanged, n)
+				ch.PrevDatacenter = p.Datacenter
+				out = append(out, ch)
+			}
+		}
+		switch {
+		case p.State != scyllaclient.NodeStateJoining && n.State == scyllaclient.NodeStateJoining:
+			out = append(out, change(NodeJoining, n))
+		case p.State == scyllaclient.NodeStateJoining && n.State == scyllaclient.NodeStateNormal:
+			out = append(out, change(NodeJoined, n))
+		}
+	}
+
+	for i, p := range prev {
+		if !matched[i] {
+			out = append(out, change(NodeRemoved, p))
+		}
+	}
+
+	return out
+}
+
+// hostsChanged returns true if changes affect known hosts.
+func hostsChanged(changes []TopologyChange) bool {
+	for _, ch := range changes {
+		switch ch.Type {
+		case NodeAdded, NodeRemoved, NodeReplaced, NodeDCChanged:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2017 ScyllaDB
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+)
+
+func TestDiffTopology(t *testing.T) {
+	node := func(addr, id, dc string, state scyllaclient.NodeState) scyllaclient.NodeStatusInfo {
+		return scyllaclient.NodeStatusInfo{
+			Addr:       addr,
+			HostID:     id,
+			Datacenter: dc,
+			Status:     scyllaclient.NodeStatusUp,
+			State:      state,
+		}
+	}
+
+	table := []struct {
+		Name   string
+		Prev   scyllaclient.NodeStatusInfoSlice
+		Cur    scyllaclient.NodeStatusInfoSlice
+		Golden []TopologyChange
+	}{
+		{
+			Name: "No changes",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+		},
+		{
+			Name: "Added joining",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", ""), node("h2", "id2", "dc1", scyllaclient.NodeStateJoining)},
+			Golden: []TopologyChange{
+				{Type: NodeAdded, Host: "h2", HostID: "id2", Datacenter: "dc1"},
+				{Type: NodeJoining, Host: "h2", HostID: "id2", Datacenter: "dc1"},
+			},
+		},
+		{
+			Name: "Joined",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h2", "id2", "dc1", scyllaclient.NodeStateJoining)},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h2", "id2", "dc1", "")},
+			Golden: []TopologyChange{
+				{Type: NodeJoined, Host: "h2", HostID: "id2", Datacenter: "dc1"},
+			},
+		},
+		{
+			Name: "Removed",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", ""), node("h2", "id2", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Golden: []TopologyChange{
+				{Type: NodeRemoved, Host: "h2", HostID: "id2", Datacenter: "dc1"},
+			},
+		},
+		{
+			Name: "Replaced with the same address",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id3", "dc1", "")},
+			Golden: []TopologyChange{
+				{Type: NodeReplaced, Host: "h1", HostID: "id3", Datacenter: "dc1", PrevHost: "h1", PrevHostID: "id1"},
+			},
+		},
+		{
+			Name: "Address changed",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h3", "id1", "dc1", "")},
+			Golden: []TopologyChange{
+				{Type: NodeReplaced, Host: "h3", HostID: "id1", Datacenter: "dc1", PrevHost: "h1", PrevHostID: "id1"},
+			},
+		},
+		{
+			Name: "DC changed",
+			Prev: scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", "")},
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc2", "")},
+			Golden: []TopologyChange{
+				{Type: NodeDCChanged, Host: "h1", HostID: "id1", Datacenter: "dc2", PrevDatacenter: "dc1"},
+			},
+		},
+		{
+			Name: "Known hosts",
+			Prev: knownHostsTopology([]string{"h1", "h2"}, nil),
+			Cur:  scyllaclient.NodeStatusInfoSlice{node("h1", "id1", "dc1", ""), node("h3", "id3", "dc2", "")},
+			Golden: []TopologyChange{
+				{Type: NodeAdded, Host: "h3", HostID: "id3", Datacenter: "dc2"},
+				{Type: NodeRemoved, Host: "h2"},
+			},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(diffTopology(test.Prev, test.Cur), test.Golden); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestKnownHostsTopologyDownNodes(t *testing.T) {
+	status := scyllaclient.NodeStatusInfoSlice{
+		{Addr: "h1", HostID: "id1", Status: scyllaclient.NodeStatusUp},
+		{Addr: "h2", HostID: "id2", Status: scyllaclient.NodeStatusDown},
+	}
+	if changes := diffTopology(knownHostsTopology([]string{"h1"}, status), status); len(changes) != 0 {
+		t.Fatalf("diffTopology() = %v, expected no changes", changes)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2017 ScyllaDB
+
+package compaction
+
+import (
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Unit represents keyspace and its tables.
+type Unit struct {
+	Keyspace  string   `json:"keyspace"`
+	Tables    []string `json:"tables,omitempty"`
+	AllTables bool     `json:"all_tables"`
+}
+
+// Target specifies what shall be compacted and how.
+type Target struct {
+	Units    []Unit           `json:"units"`
+	DC       []string         `json:"dc"`
+	Hosts    []string         `json:"hosts"`
+	Parallel []backup.DCLimit `json:"parallel"`
+	Continue bool             `json:"continue"`
+
+	nodes scyllaclient.NodeStatusInfoSlice
+}
+
+// taskProperties is the main data structure of the runner.Properties blob.
+type taskProperties struct {
+	Keyspace []string         `json:"keyspace"`
+	DC       []string         `json:"dc"`
+	Parallel []backup.DCLimit `json:"parallel"`
+	Continue bool             `json:"continue"`
+}
+
+func defaultTaskProperties() taskProperties {
+	return taskProperties{
+		Continue: true,
+	}
+}
+
+// Run tracks compaction progress, shares ID with scheduler.Run that initiated it.
+type Run struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	ID        uuid.UUID
+
+	PrevID    uuid.UUID
+	DC        []string
+	StartTime time.Time
+}
+
+// RunProgress describes compaction progress of a table on a host.
+type RunProgress struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Host      string
+	Keyspace  string `db:"keyspace_name"`
+	Table     string `db:"table_name"`
+
+	DC          string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	SizeBefore  int64
+	SizeAfter   int64
+	Error       string
+}
+
+// Done returns true if compaction of the table on the host succeeded.
+func (p *RunProgress) Done() bool {
+	return p.CompletedAt != nil && p.Error == ""
+}
+
+// Reclaimed returns number of bytes freed by compaction of the table.
+func (p *RunProgress) Reclaimed() int64 {
+	if !p.Done() {
+		return 0
+	}
+	return p.SizeBefore - p.SizeAfter
+}
+
+// progress counts tables compacted on hosts.
+type progress struct {
+	nodeops.Progress
+	Reclaimed int64 `json:"reclaimed"`
+}
+
+// Progress groups compaction progress for all hosts.
+type Progress struct {
+	progress
+
+	DC    []string       `json:"dcs,omitempty"`
+	Hosts []HostProgress `json:"hosts,omitempty"`
+}
+
+// HostProgress groups compaction progress for tables belonging to this host.
+type HostProgress struct {
+	progress
+
+	Host   string          `json:"host"`
+	DC     string          `json:"dc"`
+	Tables []TableProgress `json:"tables,omitempty"`
+}
+
+// TableProgress defines compaction progress for the table on a host.
+type TableProgress struct {
+	Keyspace    string     `json:"keyspace"`
+	Table       string     `json:"table"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	SizeBefore  int64      `json:"size_before"`
+	SizeAfter   int64      `json:"size_after"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// HostTableProgress defines progress for the table on a host.
+// It's published as compaction progress event.
+type HostTableProgress struct {
+	Host string `json:"host"`
+	DC   string `json:"dc"`
+	TableProgress
+}
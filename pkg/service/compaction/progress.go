@@ -0,0 +1,50 @@
+// Copyright (C) 2017 ScyllaDB
+
+package compaction
+
+import (
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+)
+
+// aggregateProgress groups run progress by host, run progress is expected
+// to be sorted by host.
+func aggregateProgress(run *Run, runProgress []*RunProgress) Progress {
+	p := Progress{
+		DC: run.DC,
+	}
+
+	for _, r := range nodeops.GroupByHost(len(runProgress), func(i int) string { return runProgress[i].Host }) {
+		hp := HostProgress{
+			Host: r.Host,
+			DC:   runProgress[r.Start].DC,
+		}
+		for _, rp := range runProgress[r.Start:r.End] {
+			hp.Tables = append(hp.Tables, TableProgress{
+				Keyspace:    rp.Keyspace,
+				Table:       rp.Table,
+				StartedAt:   rp.StartedAt,
+				CompletedAt: rp.CompletedAt,
+				SizeBefore:  rp.SizeBefore,
+				SizeAfter:   rp.SizeAfter,
+				Error:       rp.Error,
+			})
+			hp.progress.add(rp)
+		}
+		hp.progress.Complete()
+		p.progress.merge(hp.progress)
+		p.Hosts = append(p.Hosts, hp)
+	}
+	p.progress.Complete()
+
+	return p
+}
+
+func (p *progress) add(rp *RunProgress) {
+	p.Progress.Add(rp.StartedAt, rp.CompletedAt, rp.Error)
+	p.Reclaimed += rp.Reclaimed()
+}
+
+func (p *progress) merge(o progress) {
+	p.Progress.Merge(o.Progress)
+	p.Reclaimed += o.Reclaimed
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2017 ScyllaDB
+
+package compaction
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateProgressReclaimed(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	run := &Run{DC: []string{"dc1"}}
+	rps := []*RunProgress{
+		{Host: "h1", Keyspace: "ks", Table: "t1", CompletedAt: &now, SizeBefore: 100, SizeAfter: 60},
+		{Host: "h1", Keyspace: "ks", Table: "t2", CompletedAt: &now, SizeBefore: 100, Error: "error"},
+		{Host: "h2", Keyspace: "ks", Table: "t1", CompletedAt: &now, SizeBefore: 50, SizeAfter: 40},
+		{Host: "h2", Keyspace: "ks", Table: "t2", SizeBefore: 10},
+	}
+
+	p := aggregateProgress(run, rps)
+	if p.Reclaimed != 50 {
+		t.Errorf("Reclaimed = %d, expected 50", p.Reclaimed)
+	}
+	for i, golden := range []int64{40, 10} {
+		if v := p.Hosts[i].Reclaimed; v != golden {
+			t.Errorf("Host %s Reclaimed = %d, expected %d", p.Hosts[i].Host, v, golden)
+		}
+	}
+}
@@ -0,0 +1,560 @@
+// Copyright (C) 2017 ScyllaDB
+
+package compaction
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/dcfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/ksfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/pointer"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+// Config specifies the compaction service configuration.
+// DiskSpaceFreeMinPercent of the disk must be left free on a node after
+// the largest of the compacted tables is rewritten, nodes that do not have
+// the headroom are not compacted.
+type Config = nodeops.Config
+
+// DefaultConfig returns default configuration of the compaction service.
+func DefaultConfig() Config {
+	return nodeops.DefaultConfig()
+}
+
+// Service orchestrates major compaction of tables on nodes.
+type Service struct {
+	runs   nodeops.RunStore
+	config Config
+
+	scyllaClient scyllaclient.ProviderFunc
+	events       events.Publisher
+	logger       log.Logger
+}
+
+func NewService(session gocqlx.Session, config Config, scyllaClient scyllaclient.ProviderFunc, logger log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if scyllaClient == nil {
+		return nil, errors.New("invalid scylla provider")
+	}
+
+	return &Service{
+		runs: nodeops.RunStore{
+			Session:     session,
+			Run:         table.CompactionRun,
+			RunProgress: table.CompactionRunProgress,
+		},
+		config:       config,
+		scyllaClient: scyllaClient,
+		events:       events.NopPublisher,
+		logger:       logger,
+	}, nil
+}
+
+// SetEventPublisher sets publisher that would be notified on compaction
+// progress changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
+// Runner creates a Runner that handles compactions.
+func (s *Service) Runner() nodeops.Runner {
+	return nodeops.Runner{
+		Operation: "compaction",
+		GetTarget: func(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (interface{}, error) {
+			return s.GetTarget(ctx, clusterID, properties)
+		},
+		RunTarget: func(ctx context.Context, clusterID, taskID, runID uuid.UUID, target interface{}) error {
+			return s.Compaction(ctx, clusterID, taskID, runID, target.(Target))
+		},
+	}
+}
+
+// GetTarget converts runner properties into compaction Target.
+func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (Target, error) {
+	s.logger.Info(ctx, "Generating compaction target", "cluster_id", clusterID)
+
+	p := defaultTaskProperties()
+	t := Target{}
+
+	if err := json.Unmarshal(properties, &p); err != nil {
+		return t, service.ErrValidate(err)
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return t, errors.Wrapf(err, "get client")
+	}
+
+	// Get hosts in DCs
+	dcMap, err := client.Datacenters(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "read datacenters")
+	}
+
+	// Validate parallel DCs
+	for _, l := range p.Parallel {
+		if _, ok := dcMap[l.DC]; l.DC != "" && !ok {
+			return t, service.ErrValidate(errors.Errorf("invalid parallel: no such datacenter %s", l.DC))
+		}
+	}
+
+	// Copy simple properties
+	t.Continue = p.Continue
+
+	// Filter DCs
+	if t.DC, err = dcfilter.Apply(dcMap, p.DC); err != nil {
+		return t, err
+	}
+	t.Parallel = nodeops.FilterDCLimits(p.Parallel, t.DC)
+
+	targetDCs := strset.New(t.DC...)
+
+	// Filter keyspaces
+	f, err := ksfilter.NewFilter(p.Keyspace)
+	if err != nil {
+		return t, err
+	}
+	keyspaces, err := client.Keyspaces(ctx)
+	if err != nil {
+		return t, errors.Wrapf(err, "read keyspaces")
+	}
+	for _, keyspace := range keyspaces {
+		// Get the ring description and skip local data
+		ring, err := client.DescribeRing(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get ring description", keyspace)
+		}
+		if ring.Replication == scyllaclient.LocalStrategy {
+			continue
+		}
+		// Check if keyspace has replica in any DC
+		if !targetDCs.HasAny(ring.Datacenters()...) {
+			continue
+		}
+
+		tables, err := client.Tables(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get tables", keyspace)
+		}
+		f.Add(keyspace, tables)
+	}
+
+	// Get the filtered units
+	v, err := f.Apply(false)
+	if err != nil {
+		return t, err
+	}
+	for _, u := range v {
+		t.Units = append(t.Units, Unit{
+			Keyspace:  u.Keyspace,
+			Tables:    u.Tables,
+			AllTables: u.AllTables,
+		})
+	}
+
+	// Get nodes, compaction requires all of them to be up
+	status, err := client.Status(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "get status")
+	}
+	t.nodes = status.Datacenter(t.DC)
+	if down := t.nodes.Down(); len(down) > 0 {
+		return t, service.ErrValidate(errors.Errorf("nodes are down: %s", strings.Join(down.Hosts(), ", ")))
+	}
+	t.Hosts = t.nodes.Hosts()
+
+	return t, nil
+}
+
+// Compaction executes major compaction on a given target.
+func (s *Service) Compaction(ctx context.Context, clusterID, taskID, runID uuid.UUID, target Target) error {
+	s.logger.Debug(ctx, "Compaction",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"target", target,
+	)
+
+	run := &Run{
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		ID:        runID,
+		DC:        target.DC,
+		StartTime: timeutc.Now().UTC(),
+	}
+
+	// Get the cluster client
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return errors.Wrap(err, "get client proxy")
+	}
+
+	if len(target.nodes) == 0 {
+		return errors.New("no nodes to compact")
+	}
+
+	// Get progress of the previous run if it can be continued
+	var done map[string]*RunProgress
+	if target.Continue {
+		prev, err := s.GetLastResumableRun(ctx, clusterID, taskID)
+		if err != nil && !errors.Is(err, service.ErrNotFound) {
+			return errors.Wrap(err, "get previous run")
+		}
+		if prev != nil {
+			s.logger.Info(ctx, "Resuming previous run", "prev_run_id", prev.ID)
+			run.PrevID = prev.ID
+			if done, err = s.doneRunProgress(prev); err != nil {
+				return errors.Wrap(err, "get previous run progress")
+			}
+		}
+	}
+
+	// Register the run
+	if err := s.putRun(run); err != nil {
+		return errors.Wrap(err, "register the run")
+	}
+
+	// Register progress of all the host tables
+	hostProgress := make(map[string][]*RunProgress, len(target.nodes))
+	for _, n := range target.nodes {
+		for _, u := range target.Units {
+			for _, t := range u.Tables {
+				rp, ok := done[progressKey(n.Addr, u.Keyspace, t)]
+				if ok {
+					rp.RunID = runID
+				} else {
+					rp = &RunProgress{
+						ClusterID: clusterID,
+						TaskID:    taskID,
+						RunID:     runID,
+						Host:      n.Addr,
+						Keyspace:  u.Keyspace,
+						Table:     t,
+						DC:        n.Datacenter,
+					}
+				}
+				if err := s.putRunProgress(ctx, rp); err != nil {
+					return errors.Wrap(err, "register the run progress")
+				}
+				hostProgress[n.Addr] = append(hostProgress[n.Addr], rp)
+			}
+		}
+	}
+
+	s.logger.Info(ctx, "Compaction started",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"prev_run_id", run.PrevID,
+	)
+
+	err = nodeops.InParallelPerDC(target.nodes, target.Parallel, func(host string) error {
+		return s.compactHost(ctx, client, host, hostProgress[host])
+	})
+	// Report window end or stop as is so that the run is continued
+	if ctx.Err() != nil {
+		s.logger.Info(ctx, "Compaction interrupted", "run_id", runID, "error", err)
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "Compaction done",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	return nil
+}
+
+func progressKey(host, keyspace, table string) string {
+	return host + "/" + keyspace + "." + table
+}
+
+// compactHost runs major compaction of tables on a host one by one, tables
+// compacted in the previous run are skipped. Before the first table is
+// compacted the host is checked for free disk space headroom.
+func (s *Service) compactHost(ctx context.Context, client *scyllaclient.Client, host string, progress []*RunProgress) error {
+	var pending []*RunProgress
+	for _, rp := range progress {
+		if rp.Done() {
+			s.logger.Info(ctx, "Skipping table compacted in previous run", "host", host, "keyspace", rp.Keyspace, "table", rp.Table)
+			continue
+		}
+		pending = append(pending, rp)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := s.checkHeadroom(ctx, client, host, pending); err != nil {
+		now := timeutc.Now()
+		for _, rp := range pending {
+			rp.StartedAt = nil
+			rp.CompletedAt = &now
+			rp.Error = err.Error()
+			s.onRunProgress(ctx, rp)
+		}
+		return err
+	}
+
+	var errs error
+	for _, rp := range pending {
+		if ctx.Err() != nil {
+			return multierr.Append(errs, ctx.Err())
+		}
+
+		s.logger.Info(ctx, "Compacting table", "host", host, "keyspace", rp.Keyspace, "table", rp.Table)
+
+		rp.StartedAt = pointer.TimePtr(timeutc.Now())
+		rp.CompletedAt = nil
+		rp.SizeBefore = 0
+		rp.SizeAfter = 0
+		rp.Error = ""
+		s.onRunProgress(ctx, rp)
+
+		err := s.compactTable(ctx, client, rp)
+		if ctx.Err() != nil {
+			// Do not let compaction run in the background after the window
+			// ends or the run is stopped, the table is compacted again in
+			// the next run.
+			s.stopCompaction(ctx, client, host)
+			rp.StartedAt = nil
+			s.onRunProgress(ctx, rp)
+			return multierr.Append(errs, ctx.Err())
+		}
+
+		rp.CompletedAt = pointer.TimePtr(timeutc.Now())
+		if err != nil {
+			rp.Error = err.Error()
+			errs = multierr.Append(errs, errors.Wrapf(err, "table %s.%s", rp.Keyspace, rp.Table))
+			s.logger.Error(ctx, "Compaction failed", "host", host, "keyspace", rp.Keyspace, "table", rp.Table, "error", err)
+		} else {
+			s.logger.Info(ctx, "Done compacting table",
+				"host", host,
+				"keyspace", rp.Keyspace,
+				"table", rp.Table,
+				"size_before", rp.SizeBefore,
+				"size_after", rp.SizeAfter,
+			)
+		}
+		s.onRunProgress(ctx, rp)
+	}
+	return errs
+}
+
+// compactTable runs major compaction of a table and records the table size
+// before and after the compaction.
+func (s *Service) compactTable(ctx context.Context, client *scyllaclient.Client, rp *RunProgress) error {
+	size, err := client.TableDiskSize(ctx, rp.Host, rp.Keyspace, rp.Table)
+	if err != nil {
+		return errors.Wrap(err, "get table size")
+	}
+	rp.SizeBefore = size
+	s.onRunProgress(ctx, rp)
+
+	if err := client.TableMajorCompaction(ctx, rp.Host, rp.Keyspace, rp.Table); err != nil {
+		return err
+	}
+
+	size, err = client.TableDiskSize(ctx, rp.Host, rp.Keyspace, rp.Table)
+	if err != nil {
+		return errors.Wrap(err, "get table size")
+	}
+	rp.SizeAfter = size
+	return nil
+}
+
+// checkHeadroom checks if there is enough free disk space on the host to
+// rewrite the largest of the tables and keep the configured minimal amount
+// of free space.
+func (s *Service) checkHeadroom(ctx context.Context, client *scyllaclient.Client, host string, progress []*RunProgress) error {
+	var largest int64
+	for _, rp := range progress {
+		size, err := client.TableDiskSize(ctx, host, rp.Keyspace, rp.Table)
+		if err != nil {
+			return errors.Wrapf(err, "get table %s.%s size", rp.Keyspace, rp.Table)
+		}
+		if size > largest {
+			largest = size
+		}
+	}
+
+	du, err := client.RcloneDiskUsage(ctx, host, nodeops.DataDir)
+	if err != nil {
+		return errors.Wrap(err, "get disk usage")
+	}
+
+	required := headroom(du.Total, largest, s.config.DiskSpaceFreeMinPercent)
+	s.logger.Info(ctx, "Available disk space", "host", host, "free", du.Free, "required", required)
+	if du.Free < required {
+		return errors.Errorf("not enough disk space, required %d bytes free, available %d bytes", required, du.Free)
+	}
+	return nil
+}
+
+// headroom returns the amount of free disk space needed to rewrite a table
+// of the given size and keep minPercent of the disk free.
+func headroom(total, tableSize int64, minPercent int) int64 {
+	return tableSize + total*int64(minPercent)/100
+}
+
+func (s *Service) stopCompaction(ctx context.Context, client *scyllaclient.Client, host string) {
+	stopCtx := log.CopyTraceID(context.Background(), ctx)
+	stopCtx = scyllaclient.Interactive(stopCtx)
+	if err := client.StopCompaction(stopCtx, host, scyllaclient.MajorCompaction); err != nil {
+		s.logger.Error(stopCtx, "Failed to stop compaction", "host", host, "error", err)
+	}
+}
+
+func (s *Service) onRunProgress(ctx context.Context, rp *RunProgress) {
+	if err := s.putRunProgress(ctx, rp); err != nil {
+		s.logger.Error(ctx, "Failed to update compaction progress", "error", err)
+	}
+	s.publishRunProgress(ctx, rp)
+}
+
+// putRun upserts a compaction run.
+func (s *Service) putRun(r *Run) error {
+	return s.runs.PutRun(r)
+}
+
+// putRunProgress upserts a compaction run progress.
+func (s *Service) putRunProgress(ctx context.Context, p *RunProgress) error {
+	s.logger.Debug(ctx, "PutRunProgress", "run_progress", p)
+
+	return s.runs.PutRunProgress(p)
+}
+
+// publishRunProgress sends compaction progress event for a table on a host.
+func (s *Service) publishRunProgress(ctx context.Context, p *RunProgress) {
+	s.events.Publish(ctx, events.Event{
+		Type:      events.CompactionProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "compaction",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: HostTableProgress{
+			Host: p.Host,
+			DC:   p.DC,
+			TableProgress: TableProgress{
+				Keyspace:    p.Keyspace,
+				Table:       p.Table,
+				StartedAt:   p.StartedAt,
+				CompletedAt: p.CompletedAt,
+				SizeBefore:  p.SizeBefore,
+				SizeAfter:   p.SizeAfter,
+				Error:       p.Error,
+			},
+		},
+	})
+}
+
+// doneRunProgress returns progress of tables successfully compacted in
+// the run indexed by host, keyspace and table.
+func (s *Service) doneRunProgress(run *Run) (map[string]*RunProgress, error) {
+	rps, err := s.getRunProgress(run.ClusterID, run.TaskID, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*RunProgress)
+	for _, rp := range rps {
+		if rp.Done() {
+			m[progressKey(rp.Host, rp.Keyspace, rp.Table)] = rp
+		}
+	}
+	return m, nil
+}
+
+func (s *Service) getRunProgress(clusterID, taskID, runID uuid.UUID) ([]*RunProgress, error) {
+	var v []*RunProgress
+	return v, s.runs.GetRunProgress(clusterID, taskID, runID, &v)
+}
+
+// GetLastResumableRun returns the the most recent started but not done run of
+// the task, if there is a recent run that is completely done ErrNotFound is
+// reported.
+func (s *Service) GetLastResumableRun(ctx context.Context, clusterID, taskID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetLastResumableRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+	)
+
+	var runs []*Run
+	if err := s.runs.GetRecentRuns(clusterID, taskID, &runs); err != nil {
+		return nil, err
+	}
+
+	i, err := nodeops.LastResumableRun(len(runs), func(i int) (nodeops.Progress, error) {
+		r := runs[i]
+		rps, err := s.getRunProgress(r.ClusterID, r.TaskID, r.ID)
+		if err != nil {
+			return nodeops.Progress{}, err
+		}
+		return aggregateProgress(r, rps).progress.Progress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return runs[i], nil
+}
+
+// GetRun returns a run based on ID. If nothing was found scylla-manager.ErrNotFound
+// is returned.
+func (s *Service) GetRun(ctx context.Context, clusterID, taskID, runID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	var r Run
+	return &r, s.runs.GetRun(clusterID, taskID, runID, &r)
+}
+
+// GetProgress aggregates progress for the run of the task and breaks it down
+// by host and table.
+// If nothing was found scylla-manager.ErrNotFound is returned.
+func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (Progress, error) {
+	s.logger.Debug(ctx, "GetProgress",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	run, err := s.GetRun(ctx, clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	rps, err := s.getRunProgress(clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	return aggregateProgress(run, rps), nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2017 ScyllaDB
+
+package compaction
+
+import (
+	"testing"
+)
+
+func TestHeadroom(t *testing.T) {
+	table := []struct {
+		Name       string
+		Total      int64
+		TableSize  int64
+		MinPercent int
+		Golden     int64
+	}{
+		{
+			Name:      "No min percent",
+			Total:     1000,
+			TableSize: 100,
+			Golden:    100,
+		},
+		{
+			Name:       "Min percent",
+			Total:      1000,
+			TableSize:  100,
+			MinPercent: 10,
+			Golden:     200,
+		},
+		{
+			Name:       "Empty table",
+			Total:      1000,
+			MinPercent: 5,
+			Golden:     50,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := headroom(test.Total, test.TableSize, test.MinPercent); v != test.Golden {
+				t.Fatalf("headroom() = %d, expected %d", v, test.Golden)
+			}
+		})
+	}
+}
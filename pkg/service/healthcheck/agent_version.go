@@ -0,0 +1,71 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"fmt"
+
+	"github.com/scylladb/scylla-manager/pkg/util/version"
+)
+
+// agentCompatibility lists versions of Scylla Manager Agent supported by
+// versions of Scylla Manager. If Scylla Manager version is not listed agent
+// major and minor versions must match Scylla Manager major and minor versions.
+var agentCompatibility = []struct {
+	Manager string
+	Agent   string
+}{
+	{Manager: ">= 3.1, < 3.2", Agent: ">= 3.0, < 3.2"},
+	{Manager: ">= 3.0, < 3.1", Agent: ">= 2.6, < 3.1"},
+}
+
+// agentVersionConstraint returns constraint on agent version for the given
+// Scylla Manager version.
+func agentVersionConstraint(managerVersion string) (string, error) {
+	for _, c := range agentCompatibility {
+		ok, err := version.CheckConstraint(managerVersion, c.Manager)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return c.Agent, nil
+		}
+	}
+
+	v := version.Short(managerVersion)
+	var major, minor int
+	if _, err := fmt.Sscanf(v, "%d.%d", &major, &minor); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(">= %d.%d, < %d.%d", major, minor, major, minor+1), nil
+}
+
+// checkAgentVersion returns status and cause of agent version compatibility
+// with Scylla Manager. Development builds are considered compatible with any
+// version.
+func checkAgentVersion(managerVersion, agentVersion string) (status, cause string) {
+	if agentVersion == "" {
+		return "", ""
+	}
+	if isDevelopmentVersion(managerVersion) || isDevelopmentVersion(agentVersion) {
+		return statusOK, ""
+	}
+
+	constraint, err := agentVersionConstraint(managerVersion)
+	if err != nil {
+		return statusError, fmt.Sprintf("unsupported Scylla Manager version %s", managerVersion)
+	}
+	ok, err := version.CheckConstraint(agentVersion, constraint)
+	if err != nil {
+		return statusError, fmt.Sprintf("unsupported agent version %s", agentVersion)
+	}
+	if !ok {
+		return statusMismatch, fmt.Sprintf("agent %s is not compatible with Scylla Manager %s, supported agent versions are %s",
+			version.Short(agentVersion), version.Short(managerVersion), constraint)
+	}
+	return statusOK, ""
+}
+
+func isDevelopmentVersion(v string) bool {
+	return v == "Snapshot" || version.MasterVersion(v)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"testing"
+)
+
+func TestCheckAgentVersion(t *testing.T) {
+	table := []struct {
+		Name    string
+		Manager string
+		Agent   string
+		Status  string
+	}{
+		{
+			Name:    "Unknown agent version",
+			Manager: "3.1.0",
+			Status:  "",
+		},
+		{
+			Name:    "Same version",
+			Manager: "3.1.0-0.20210701.1234abcd",
+			Agent:   "3.1.0-0.20210701.1234abcd",
+			Status:  statusOK,
+		},
+		{
+			Name:    "Previous agent version from matrix",
+			Manager: "3.1.2",
+			Agent:   "3.0.1",
+			Status:  statusOK,
+		},
+		{
+			Name:    "Too old agent",
+			Manager: "3.1.0",
+			Agent:   "2.6.3",
+			Status:  statusMismatch,
+		},
+		{
+			Name:    "Newer agent",
+			Manager: "3.0.0",
+			Agent:   "3.1.0",
+			Status:  statusMismatch,
+		},
+		{
+			Name:    "Version not in matrix",
+			Manager: "3.2.1",
+			Agent:   "3.2.0",
+			Status:  statusOK,
+		},
+		{
+			Name:    "Version not in matrix mismatch",
+			Manager: "3.2.1",
+			Agent:   "3.1.0",
+			Status:  statusMismatch,
+		},
+		{
+			Name:    "Development manager",
+			Manager: "Snapshot",
+			Agent:   "2.6.0",
+			Status:  statusOK,
+		},
+		{
+			Name:    "Development agent",
+			Manager: "3.1.0",
+			Agent:   "666.dev-0.20210701.1234abcd",
+			Status:  statusOK,
+		},
+		{
+			Name:    "Invalid agent version",
+			Manager: "3.1.0",
+			Agent:   "foo",
+			Status:  statusError,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			status, cause := checkAgentVersion(test.Manager, test.Agent)
+			if status != test.Status {
+				t.Errorf("checkAgentVersion() = %s, %s expected %s", status, cause, test.Status)
+			}
+			if status == statusOK && cause != "" {
+				t.Errorf("Unexpected cause %s", cause)
+			}
+		})
+	}
+}
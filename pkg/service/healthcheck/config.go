@@ -30,13 +30,29 @@ type Config struct {
 	Probes int `yaml:"probes"`
 	// NodeInfoTTL specifies how long node info should be cached.
 	NodeInfoTTL time.Duration `yaml:"node_info_ttl"`
+	// HistorySampleInterval specifies how often ping results of a node are
+	// saved to the health check history, zero disables the history.
+	HistorySampleInterval time.Duration `yaml:"history_sample_interval"`
+	// DiskFreeMinPercent specifies minimal free disk space on the data
+	// directory below which the disk status is LOW.
+	DiskFreeMinPercent int `yaml:"disk_free_min_percent"`
+	// ClockSkewMax specifies maximal tolerated difference between node clock
+	// and Scylla Manager clock.
+	ClockSkewMax time.Duration `yaml:"clock_skew_max"`
+	// CertExpiryMin specifies how long before expiry of CQL or agent TLS
+	// certificate the certificate status is EXPIRING.
+	CertExpiryMin time.Duration `yaml:"cert_expiry_min"`
 }
 
 func DefaultConfig() Config {
 	return Config{
-		RelativeTimeout: 50 * time.Millisecond,
-		MaxTimeout:      30 * time.Second,
-		Probes:          200,
-		NodeInfoTTL:     5 * time.Minute,
+		RelativeTimeout:       50 * time.Millisecond,
+		MaxTimeout:            30 * time.Second,
+		Probes:                200,
+		NodeInfoTTL:           5 * time.Minute,
+		HistorySampleInterval: time.Minute,
+		DiskFreeMinPercent:    10,
+		ClockSkewMax:          time.Second,
+		CertExpiryMin:         30 * 24 * time.Hour,
 	}
 }
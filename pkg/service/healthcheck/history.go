@@ -0,0 +1,233 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// historyDay is the time span of a single health check history partition.
+const historyDay = 24 * time.Hour
+
+// historySample is a single ping result saved in the health check history.
+type historySample struct {
+	ClusterID  uuid.UUID
+	Day        time.Time
+	Ts         time.Time
+	Host       string
+	PingType   string
+	Datacenter string  `db:"dc"`
+	Up         bool    `db:"up"`
+	RTTMs      float64 `db:"rtt_ms"`
+}
+
+type clusterIDHostPingType struct {
+	ClusterID uuid.UUID
+	Host      string
+	PingType  pingType
+}
+
+// historyRecorderFunc saves ping result of a node in the health check history.
+type historyRecorderFunc func(ctx context.Context, clusterID uuid.UUID, node scyllaclient.NodeStatusInfo, rtt time.Duration, err error)
+
+func nopHistoryRecorder(context.Context, uuid.UUID, scyllaclient.NodeStatusInfo, time.Duration, error) {
+}
+
+// historyRecorder returns historyRecorderFunc for a given ping type.
+// Ping results are sampled, a node result is saved at most once per
+// Config.HistorySampleInterval.
+func (s *Service) historyRecorder(pt pingType) historyRecorderFunc {
+	if s.config.HistorySampleInterval <= 0 {
+		return nopHistoryRecorder
+	}
+
+	return func(ctx context.Context, clusterID uuid.UUID, node scyllaclient.NodeStatusInfo, rtt time.Duration, err error) {
+		// Ping is not supported by the node i.e. Alternator is disabled.
+		if rtt == 0 && err == nil {
+			return
+		}
+
+		now := timeutc.Now()
+		if !s.shouldSample(clusterIDHostPingType{clusterID, node.Addr, pt}, now) {
+			return
+		}
+
+		sample := historySample{
+			ClusterID:  clusterID,
+			Day:        now.Truncate(historyDay),
+			Ts:         now,
+			Host:       node.Addr,
+			PingType:   pt.String(),
+			Datacenter: node.Datacenter,
+			Up:         err == nil,
+			RTTMs:      float64(rtt.Milliseconds()),
+		}
+		q := table.HealthcheckHistory.InsertQuery(s.session).BindStruct(&sample)
+		if err := q.ExecRelease(); err != nil {
+			s.logger.Error(ctx, "Failed to save health check history",
+				"cluster_id", clusterID,
+				"host", node.Addr,
+				"ping_type", pt,
+				"error", err,
+			)
+		}
+	}
+}
+
+func (s *Service) shouldSample(key clusterIDHostPingType, now time.Time) bool {
+	s.historyMu.Lock()
+	defer s.historyMu.Unlock()
+
+	if last, ok := s.lastSample[key]; ok && now.Sub(last) < s.config.HistorySampleInterval {
+		return false
+	}
+	s.lastSample[key] = now
+	return true
+}
+
+// StatusHistory returns availability and RTT percentiles of cluster nodes
+// and datacenters calculated from the health check history samples taken
+// between since and until.
+func (s *Service) StatusHistory(ctx context.Context, clusterID uuid.UUID, since, until time.Time) (StatusHistory, error) {
+	s.logger.Debug(ctx, "StatusHistory",
+		"cluster_id", clusterID,
+		"since", since,
+		"until", until,
+	)
+
+	var samples []historySample
+
+	q := table.HealthcheckHistory.SelectBuilder().Where(
+		qb.GtOrEqNamed("ts", "since"),
+		qb.LtOrEqNamed("ts", "until"),
+	).Query(s.session).WithContext(ctx)
+	defer q.Release()
+
+	for day := since.Truncate(historyDay); !day.After(until); day = day.Add(historyDay) {
+		var v []historySample
+		q.BindMap(qb.M{
+			"cluster_id": clusterID,
+			"day":        day,
+			"since":      since,
+			"until":      until,
+		})
+		if err := q.Select(&v); err != nil {
+			return StatusHistory{}, err
+		}
+		samples = append(samples, v...)
+	}
+
+	return makeStatusHistory(samples, since, until), nil
+}
+
+type pingSamples map[string][]historySample
+
+func (ps pingSamples) add(s historySample) {
+	ps[s.PingType] = append(ps[s.PingType], s)
+}
+
+func (ps pingSamples) availability(dc, host string) Availability {
+	return Availability{
+		Datacenter: dc,
+		Host:       host,
+		CQL:        makePingStats(ps[cqlPing.String()]),
+		REST:       makePingStats(ps[restPing.String()]),
+		Alternator: makePingStats(ps[alternatorPing.String()]),
+	}
+}
+
+func makeStatusHistory(samples []historySample, since, until time.Time) StatusHistory {
+	type dcHost struct {
+		DC   string
+		Host string
+	}
+
+	var (
+		nodes = make(map[dcHost]pingSamples)
+		dcs   = make(map[string]pingSamples)
+	)
+	for _, s := range samples {
+		k := dcHost{s.Datacenter, s.Host}
+		if nodes[k] == nil {
+			nodes[k] = make(pingSamples)
+		}
+		nodes[k].add(s)
+		if dcs[s.Datacenter] == nil {
+			dcs[s.Datacenter] = make(pingSamples)
+		}
+		dcs[s.Datacenter].add(s)
+	}
+
+	out := StatusHistory{
+		Since:       since,
+		Until:       until,
+		Nodes:       make([]Availability, 0, len(nodes)),
+		Datacenters: make([]Availability, 0, len(dcs)),
+	}
+	for k, v := range nodes {
+		out.Nodes = append(out.Nodes, v.availability(k.DC, k.Host))
+	}
+	for dc, v := range dcs {
+		out.Datacenters = append(out.Datacenters, v.availability(dc, ""))
+	}
+
+	sort.Slice(out.Nodes, func(i, j int) bool {
+		if out.Nodes[i].Datacenter != out.Nodes[j].Datacenter {
+			return out.Nodes[i].Datacenter < out.Nodes[j].Datacenter
+		}
+		return out.Nodes[i].Host < out.Nodes[j].Host
+	})
+	sort.Slice(out.Datacenters, func(i, j int) bool {
+		return out.Datacenters[i].Datacenter < out.Datacenters[j].Datacenter
+	})
+
+	return out
+}
+
+// makePingStats returns statistics of the samples, RTT percentiles are
+// calculated for successful pings only. It returns nil if there are no
+// samples.
+func makePingStats(samples []historySample) *PingStats {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	var rtt []float64
+	for _, s := range samples {
+		if s.Up {
+			rtt = append(rtt, s.RTTMs)
+		}
+	}
+	sort.Float64s(rtt)
+
+	return &PingStats{
+		Samples:      len(samples),
+		Up:           len(rtt),
+		Availability: 100 * float64(len(rtt)) / float64(len(samples)),
+		RTTP50:       percentile(rtt, 50),
+		RTTP95:       percentile(rtt, 95),
+		RTTP99:       percentile(rtt, 99),
+	}
+}
+
+// percentile returns p-th percentile of sorted values using the nearest-rank
+// method.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
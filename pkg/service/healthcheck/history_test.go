@@ -0,0 +1,110 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+func TestMakeStatusHistory(t *testing.T) {
+	until := timeutc.Now()
+	since := until.Add(-time.Hour)
+
+	var samples []historySample
+	add := func(dc, host string, pt pingType, up bool, rtt float64) {
+		samples = append(samples, historySample{
+			Datacenter: dc,
+			Host:       host,
+			PingType:   pt.String(),
+			Up:         up,
+			RTTMs:      rtt,
+		})
+	}
+	for i := 1; i <= 10; i++ {
+		add("dc1", "h1", cqlPing, true, float64(i))
+		add("dc1", "h2", cqlPing, i > 5, float64(i))
+		add("dc2", "h3", restPing, true, 1)
+	}
+
+	golden := StatusHistory{
+		Since: since,
+		Until: until,
+		Nodes: []Availability{
+			{
+				Datacenter: "dc1",
+				Host:       "h1",
+				CQL:        &PingStats{Samples: 10, Up: 10, Availability: 100, RTTP50: 5, RTTP95: 10, RTTP99: 10},
+			},
+			{
+				Datacenter: "dc1",
+				Host:       "h2",
+				CQL:        &PingStats{Samples: 10, Up: 5, Availability: 50, RTTP50: 8, RTTP95: 10, RTTP99: 10},
+			},
+			{
+				Datacenter: "dc2",
+				Host:       "h3",
+				REST:       &PingStats{Samples: 10, Up: 10, Availability: 100, RTTP50: 1, RTTP95: 1, RTTP99: 1},
+			},
+		},
+		Datacenters: []Availability{
+			{
+				Datacenter: "dc1",
+				CQL:        &PingStats{Samples: 20, Up: 15, Availability: 75, RTTP50: 7, RTTP95: 10, RTTP99: 10},
+			},
+			{
+				Datacenter: "dc2",
+				REST:       &PingStats{Samples: 10, Up: 10, Availability: 100, RTTP50: 1, RTTP95: 1, RTTP99: 1},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(makeStatusHistory(samples, since, until), golden); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	table := []struct {
+		Name   string
+		Values []float64
+		P      float64
+		Golden float64
+	}{
+		{
+			Name:   "Empty",
+			P:      50,
+			Golden: 0,
+		},
+		{
+			Name:   "Single",
+			Values: []float64{7},
+			P:      99,
+			Golden: 7,
+		},
+		{
+			Name:   "Median",
+			Values: []float64{1, 2, 3, 4},
+			P:      50,
+			Golden: 2,
+		},
+		{
+			Name:   "Zero",
+			Values: []float64{1, 2, 3, 4},
+			P:      0,
+			Golden: 1,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := percentile(test.Values, test.P); v != test.Golden {
+				t.Fatalf("percentile() = %v, expected %v", v, test.Golden)
+			}
+		})
+	}
+}
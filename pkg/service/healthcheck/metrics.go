@@ -79,6 +79,41 @@ var (
 		Name:      "alternator_timeout_ms",
 		Help:      "Host Alternator Timeout",
 	}, []string{clusterKey, dcKey})
+
+	diskFreePercent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "scylla_manager",
+		Subsystem: "healthcheck",
+		Name:      "disk_free_percent",
+		Help:      "Host data directory free disk space percent",
+	}, []string{clusterKey, hostKey})
+
+	clockOffset = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "scylla_manager",
+		Subsystem: "healthcheck",
+		Name:      "clock_offset_ms",
+		Help:      "Host clock offset against Scylla Manager clock",
+	}, []string{clusterKey, hostKey})
+
+	certExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "scylla_manager",
+		Subsystem: "healthcheck",
+		Name:      "cert_expiry_seconds",
+		Help:      "Time left until expiry of the host CQL or agent TLS certificate",
+	}, []string{clusterKey, hostKey})
+
+	schemaAgreement = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "scylla_manager",
+		Subsystem: "healthcheck",
+		Name:      "schema_agreement",
+		Help:      "Host schema version agrees with the majority of nodes",
+	}, []string{clusterKey, hostKey})
+
+	gossipMismatch = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "scylla_manager",
+		Subsystem: "healthcheck",
+		Name:      "gossip_mismatch",
+		Help:      "Number of nodes which host gossip status differs from cluster status",
+	}, []string{clusterKey, hostKey})
 )
 
 func init() {
@@ -92,6 +127,11 @@ func init() {
 		alternatorStatus,
 		alternatorRTT,
 		alternatorTimeout,
+		diskFreePercent,
+		clockOffset,
+		certExpiry,
+		schemaAgreement,
+		gossipMismatch,
 	)
 }
 
@@ -3,6 +3,8 @@
 package healthcheck
 
 import (
+	"time"
+
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 )
 
@@ -14,6 +16,13 @@ const (
 	statusTimeout      = `TIMEOUT`
 	statusUnauthorized = `UNAUTHORIZED`
 	statusHTTP         = `HTTP`
+
+	statusOK       = `OK`
+	statusLow      = `LOW`
+	statusSkew     = `SKEW`
+	statusExpiring = `EXPIRING`
+	statusExpired  = `EXPIRED`
+	statusMismatch = `MISMATCH`
 )
 
 // NodeStatus represents the status of a particular node.
@@ -37,6 +46,20 @@ type NodeStatus struct {
 	CPUCount         int64   `json:"cpu_count"`
 	ScyllaVersion    string  `json:"scylla_version"`
 	AgentVersion     string  `json:"agent_version"`
+
+	AgentVersionStatus string `json:"agent_version_status,omitempty"`
+	AgentVersionCause  string `json:"agent_version_cause,omitempty"`
+
+	DiskStatus      string     `json:"disk_status,omitempty"`
+	DiskFreePercent float64    `json:"disk_free_percent,omitempty"`
+	ClockStatus     string     `json:"clock_status,omitempty"`
+	ClockOffset     float64    `json:"clock_offset_ms,omitempty"`
+	CertStatus      string     `json:"cert_status,omitempty"`
+	CertExpiry      *time.Time `json:"cert_expiry,omitempty"`
+	SchemaStatus    string     `json:"schema_status,omitempty"`
+	SchemaVersion   string     `json:"schema_version,omitempty"`
+	GossipStatus    string     `json:"gossip_status,omitempty"`
+	GossipCause     string     `json:"gossip_cause,omitempty"`
 }
 
 func makeNodeStatus(src []scyllaclient.NodeStatusInfo) []NodeStatus {
@@ -49,3 +72,43 @@ func makeNodeStatus(src []scyllaclient.NodeStatusInfo) []NodeStatus {
 	}
 	return dst
 }
+
+// NodeAgentConfig contains the effective configuration of the agent running
+// on a node, in YAML format with secrets redacted.
+type NodeAgentConfig struct {
+	Datacenter string `json:"dc"`
+	Host       string `json:"host"`
+	Config     string `json:"config,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// PingStats contains statistics of ping samples of a given type.
+// Availability is a percentage of successful pings, RTT percentiles are
+// calculated for successful pings only.
+type PingStats struct {
+	Samples      int     `json:"samples"`
+	Up           int     `json:"up"`
+	Availability float64 `json:"availability"`
+	RTTP50       float64 `json:"rtt_p50_ms"`
+	RTTP95       float64 `json:"rtt_p95_ms"`
+	RTTP99       float64 `json:"rtt_p99_ms"`
+}
+
+// Availability represents availability of a node, or a datacenter if Host
+// is empty, over a period of time.
+type Availability struct {
+	Datacenter string     `json:"dc"`
+	Host       string     `json:"host,omitempty"`
+	CQL        *PingStats `json:"cql,omitempty"`
+	REST       *PingStats `json:"rest,omitempty"`
+	Alternator *PingStats `json:"alternator,omitempty"`
+}
+
+// StatusHistory represents availability of cluster nodes and datacenters
+// calculated from the health check history.
+type StatusHistory struct {
+	Since       time.Time      `json:"since"`
+	Until       time.Time      `json:"until"`
+	Nodes       []Availability `json:"nodes"`
+	Datacenters []Availability `json:"datacenters"`
+}
@@ -0,0 +1,366 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+const (
+	// dataDir is the rclone remote of Scylla data directory.
+	dataDir = "data:"
+	// unreachableSchemaVersion is reported by Scylla for hosts it cannot
+	// get schema version from.
+	unreachableSchemaVersion = "UNREACHABLE"
+	// certDialTimeout specifies timeout of TLS handshake when fetching
+	// certificates.
+	certDialTimeout = 5 * time.Second
+)
+
+// nodeCheckResult contains results of the checks, other than pings, run
+// for a single node.
+type nodeCheckResult struct {
+	DiskStatus      string
+	DiskFreePercent float64
+	ClockStatus     string
+	ClockOffset     time.Duration
+	CertStatus      string
+	CertExpiry      time.Time
+	SchemaStatus    string
+	SchemaVersion   string
+	GossipStatus    string
+	GossipMismatch  []string
+}
+
+func (r nodeCheckResult) decorateNodeStatus(status *NodeStatus) {
+	status.DiskStatus = r.DiskStatus
+	status.DiskFreePercent = r.DiskFreePercent
+	status.ClockStatus = r.ClockStatus
+	status.ClockOffset = float64(r.ClockOffset.Milliseconds())
+	status.CertStatus = r.CertStatus
+	if !r.CertExpiry.IsZero() {
+		t := r.CertExpiry
+		status.CertExpiry = &t
+	}
+	status.SchemaStatus = r.SchemaStatus
+	status.SchemaVersion = r.SchemaVersion
+	status.GossipStatus = r.GossipStatus
+	if len(r.GossipMismatch) > 0 {
+		status.GossipCause = "status differs for " + strings.Join(r.GossipMismatch, ", ")
+	}
+}
+
+func (s *Service) parallelNodeChecksFunc(ctx context.Context, clusterID uuid.UUID, status scyllaclient.NodeStatusInfoSlice, out []NodeStatus) func() error {
+	return func() error {
+		res, err := s.checkNodes(ctx, clusterID, status)
+		if err != nil {
+			return err
+		}
+		for i := range res {
+			res[i].decorateNodeStatus(&out[i])
+		}
+		return nil
+	}
+}
+
+// checkNodes checks disk space, clock skew, certificate expiry, schema
+// agreement and gossip status of the UN nodes, result at position i
+// corresponds to node at position i.
+func (s *Service) checkNodes(ctx context.Context, clusterID uuid.UUID, status scyllaclient.NodeStatusInfoSlice) ([]nodeCheckResult, error) {
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client")
+	}
+
+	versions, err := client.SchemaVersions(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Schema versions fetch failed",
+			"cluster_id", clusterID,
+			"error", err,
+		)
+	}
+	hostSchema, majority := majoritySchemaVersion(versions)
+
+	out := make([]nodeCheckResult, len(status))
+	err = parallel.Run(len(status), parallel.NoLimit, func(i int) (_ error) {
+		// Ignore check if node is not Un and Normal
+		if !status[i].IsUN() {
+			return
+		}
+
+		o := &out[i]
+		host := status[i].Addr
+		s.checkDisk(ctx, clusterID, client, host, o)
+		s.checkClock(ctx, clusterID, client, host, o)
+		s.checkCerts(ctx, clusterID, client, host, o)
+		s.checkGossip(ctx, clusterID, client, status, host, o)
+		if versions != nil {
+			o.SchemaVersion = hostSchema[host]
+			if o.SchemaVersion == majority {
+				o.SchemaStatus = statusOK
+			} else {
+				o.SchemaStatus = statusMismatch
+			}
+		}
+		return
+	})
+
+	return out, err
+}
+
+func (s *Service) checkDisk(ctx context.Context, clusterID uuid.UUID, client *scyllaclient.Client, host string, o *nodeCheckResult) {
+	du, err := client.RcloneDiskUsage(ctx, host, dataDir)
+	if err != nil {
+		s.logger.Error(ctx, "Disk usage check failed",
+			"cluster_id", clusterID,
+			"host", host,
+			"error", err,
+		)
+		o.DiskStatus = statusError
+		return
+	}
+	if du.Total > 0 {
+		o.DiskFreePercent = 100 * float64(du.Free) / float64(du.Total)
+	}
+	if o.DiskFreePercent < float64(s.config.DiskFreeMinPercent) {
+		o.DiskStatus = statusLow
+	} else {
+		o.DiskStatus = statusOK
+	}
+}
+
+func (s *Service) checkClock(ctx context.Context, clusterID uuid.UUID, client *scyllaclient.Client, host string, o *nodeCheckResult) {
+	offset, err := client.ClockOffset(ctx, host)
+	if err != nil {
+		s.logger.Error(ctx, "Clock check failed",
+			"cluster_id", clusterID,
+			"host", host,
+			"error", err,
+		)
+		o.ClockStatus = statusError
+		return
+	}
+	o.ClockOffset = offset
+	if offset > s.config.ClockSkewMax || -offset > s.config.ClockSkewMax {
+		o.ClockStatus = statusSkew
+	} else {
+		o.ClockStatus = statusOK
+	}
+}
+
+// checkCerts sets expiry of the certificate that expires first, out of
+// CQL and agent certificates. The check is skipped if TLS is not used.
+func (s *Service) checkCerts(ctx context.Context, clusterID uuid.UUID, client *scyllaclient.Client, host string, o *nodeCheckResult) {
+	var addrs []string
+	if addr, ok := client.AgentAddr(host); ok {
+		addrs = append(addrs, addr)
+	}
+	if ni, err := s.nodeInfo(ctx, clusterID, host); err == nil && ni.hasTLSConfig(cqlPing) {
+		addrs = append(addrs, ni.CQLAddr(host))
+	}
+
+	for _, addr := range addrs {
+		notAfter, err := certNotAfter(ctx, addr)
+		if err != nil {
+			s.logger.Error(ctx, "Certificate check failed",
+				"cluster_id", clusterID,
+				"host", host,
+				"addr", addr,
+				"error", err,
+			)
+			o.CertStatus = statusError
+			return
+		}
+		if o.CertExpiry.IsZero() || notAfter.Before(o.CertExpiry) {
+			o.CertExpiry = notAfter
+		}
+	}
+	if o.CertExpiry.IsZero() {
+		return
+	}
+
+	now := timeutc.Now()
+	switch {
+	case now.After(o.CertExpiry):
+		o.CertStatus = statusExpired
+	case now.Add(s.config.CertExpiryMin).After(o.CertExpiry):
+		o.CertStatus = statusExpiring
+	default:
+		o.CertStatus = statusOK
+	}
+}
+
+// certNotAfter returns expiry time of the certificate presented by addr.
+// The certificate is read even if the handshake fails because the server
+// requires a client certificate.
+func certNotAfter(ctx context.Context, addr string) (time.Time, error) {
+	var notAfter time.Time
+	config := DefaultTLSConfig.Clone()
+	config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return nil
+		}
+		cert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		notAfter = cert.NotAfter
+		return nil
+	}
+
+	d := tls.Dialer{
+		NetDialer: &net.Dialer{
+			Timeout: certDialTimeout,
+		},
+		Config: config,
+	}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err == nil {
+		conn.Close()
+	}
+	if !notAfter.IsZero() {
+		return notAfter, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Time{}, errors.New("no certificates")
+}
+
+// checkGossip compares status of nodes as seen by gossiper of host with
+// the cluster status.
+func (s *Service) checkGossip(ctx context.Context, clusterID uuid.UUID, client *scyllaclient.Client, status scyllaclient.NodeStatusInfoSlice, host string, o *nodeCheckResult) {
+	down, err := client.GossiperEndpointDown(ctx, host)
+	if err != nil {
+		s.logger.Error(ctx, "Gossip check failed",
+			"cluster_id", clusterID,
+			"host", host,
+			"error", err,
+		)
+		o.GossipStatus = statusError
+		return
+	}
+
+	o.GossipMismatch = findGossipMismatch(status, host, down)
+	if len(o.GossipMismatch) > 0 {
+		o.GossipStatus = statusMismatch
+	} else {
+		o.GossipStatus = statusOK
+	}
+}
+
+// findGossipMismatch returns nodes that are down according to host but up
+// according to the cluster status, or the other way round.
+func findGossipMismatch(status scyllaclient.NodeStatusInfoSlice, host string, down []string) []string {
+	d := strset.New(down...)
+
+	var out []string
+	for _, n := range status {
+		if n.Addr == host {
+			continue
+		}
+		if d.Has(n.Addr) == (n.Status == scyllaclient.NodeStatusUp) {
+			out = append(out, n.Addr)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// majoritySchemaVersion returns mapping from host to schema version and
+// the version used by most of the hosts.
+func majoritySchemaVersion(versions map[string][]string) (hostSchema map[string]string, majority string) {
+	hostSchema = make(map[string]string)
+	max := 0
+	for v, hosts := range versions {
+		if v == unreachableSchemaVersion {
+			continue
+		}
+		for _, h := range hosts {
+			hostSchema[h] = v
+		}
+		if len(hosts) > max || len(hosts) == max && v < majority {
+			max = len(hosts)
+			majority = v
+		}
+	}
+	return hostSchema, majority
+}
+
+// nodeChecksMetrics updates metrics with results of node checks and removes
+// metrics of hosts that are no longer live.
+func (s *Service) nodeChecksMetrics(ctx context.Context, clusterID uuid.UUID, status scyllaclient.NodeStatusInfoSlice) {
+	res, err := s.checkNodes(ctx, clusterID, status)
+	if err != nil {
+		s.logger.Error(ctx, "Node checks failed", "cluster_id", clusterID, "error", err)
+		removeNodeChecksMetrics(clusterID, nil)
+		return
+	}
+
+	live := strset.New()
+	for i := range res {
+		if !status[i].IsUN() {
+			continue
+		}
+		live.Add(status[i].Addr)
+
+		hl := prometheus.Labels{
+			clusterKey: clusterID.String(),
+			hostKey:    status[i].Addr,
+		}
+		r := res[i]
+		if r.DiskStatus != statusError {
+			diskFreePercent.With(hl).Set(r.DiskFreePercent)
+		}
+		if r.ClockStatus != statusError {
+			clockOffset.With(hl).Set(float64(r.ClockOffset.Milliseconds()))
+		}
+		if !r.CertExpiry.IsZero() {
+			certExpiry.With(hl).Set(time.Until(r.CertExpiry).Seconds())
+		}
+		if r.SchemaStatus != "" {
+			v := 0.0
+			if r.SchemaStatus == statusOK {
+				v = 1
+			}
+			schemaAgreement.With(hl).Set(v)
+		}
+		if r.GossipStatus != statusError {
+			gossipMismatch.With(hl).Set(float64(len(r.GossipMismatch)))
+		}
+	}
+	removeNodeChecksMetrics(clusterID, live)
+}
+
+// removeNodeChecksMetrics removes node checks metrics of cluster hosts that
+// are not in keep.
+func removeNodeChecksMetrics(clusterID uuid.UUID, keep *strset.Set) {
+	for _, g := range []*prometheus.GaugeVec{diskFreePercent, clockOffset, certExpiry, schemaAgreement, gossipMismatch} {
+		apply(collect(g), func(cluster, dc, host, pt string, v float64) {
+			if clusterID.String() != cluster {
+				return
+			}
+			if keep != nil && keep.Has(host) {
+				return
+			}
+			g.Delete(prometheus.Labels{
+				clusterKey: clusterID.String(),
+				hostKey:    host,
+			})
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2017 ScyllaDB
+
+package healthcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+)
+
+func TestFindGossipMismatch(t *testing.T) {
+	status := scyllaclient.NodeStatusInfoSlice{
+		{Addr: "h1", Status: scyllaclient.NodeStatusUp},
+		{Addr: "h2", Status: scyllaclient.NodeStatusUp},
+		{Addr: "h3", Status: scyllaclient.NodeStatusDown},
+		{Addr: "h4", Status: scyllaclient.NodeStatusDown},
+	}
+
+	table := []struct {
+		Name   string
+		Host   string
+		Down   []string
+		Golden []string
+	}{
+		{
+			Name: "Agreement",
+			Host: "h1",
+			Down: []string{"h3", "h4"},
+		},
+		{
+			Name:   "Down node seen as up",
+			Host:   "h1",
+			Down:   []string{"h3"},
+			Golden: []string{"h4"},
+		},
+		{
+			Name:   "Up node seen as down",
+			Host:   "h2",
+			Down:   []string{"h1", "h3", "h4"},
+			Golden: []string{"h1"},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(findGossipMismatch(status, test.Host, test.Down), test.Golden); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestMajoritySchemaVersion(t *testing.T) {
+	versions := map[string][]string{
+		"v1":                     {"h1", "h2"},
+		"v2":                     {"h3"},
+		unreachableSchemaVersion: {"h4", "h5", "h6"},
+	}
+
+	hostSchema, majority := majoritySchemaVersion(versions)
+	if majority != "v1" {
+		t.Fatalf("majority = %s, expected v1", majority)
+	}
+	golden := map[string]string{
+		"h1": "v1",
+		"h2": "v1",
+		"h3": "v2",
+	}
+	if diff := cmp.Diff(hostSchema, golden); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestCertNotAfterClientCertRequired(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	notAfter, err := certNotAfter(context.Background(), srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if golden := srv.Certificate().NotAfter; !notAfter.Equal(golden) {
+		t.Fatalf("certNotAfter() = %s, expected %s", notAfter, golden)
+	}
+}
@@ -22,6 +22,9 @@ type Runner struct {
 	timeout      dynamicTimeoutProviderFunc
 	metrics      *runnerMetrics
 	ping         func(ctx context.Context, clusterID uuid.UUID, host string, timeout time.Duration) (rtt time.Duration, err error)
+	history      historyRecorderFunc
+	// nodeChecks if set runs checks other than ping and updates their metrics.
+	nodeChecks func(ctx context.Context, clusterID uuid.UUID, status scyllaclient.NodeStatusInfoSlice)
 }
 
 type runnerMetrics struct {
@@ -34,6 +37,9 @@ func (r Runner) Run(ctx context.Context, clusterID, taskID, runID uuid.UUID, pro
 	defer func() {
 		if err != nil {
 			r.removeMetricsForCluster(clusterID)
+			if r.nodeChecks != nil {
+				removeNodeChecksMetrics(clusterID, nil)
+			}
 		}
 	}()
 
@@ -53,6 +59,9 @@ func (r Runner) Run(ctx context.Context, clusterID, taskID, runID uuid.UUID, pro
 	live := status.Live()
 	r.removeMetricsForMissingHosts(clusterID, live)
 	r.checkHosts(ctx, clusterID, live)
+	if r.nodeChecks != nil {
+		r.nodeChecks(ctx, clusterID, status)
+	}
 
 	return nil
 }
@@ -79,6 +88,7 @@ func (r Runner) checkHosts(ctx context.Context, clusterID uuid.UUID, status []sc
 		}
 		r.metrics.rtt.With(hl).Set(float64(rtt.Milliseconds()))
 		r.metrics.timeout.With(dl).Set(float64(timeout.Milliseconds()))
+		r.history(ctx, clusterID, status[i], rtt, err)
 
 		// Record RTT only in case of success or timeout.
 		if err == nil || errors.Is(err, ping.ErrTimeout) {
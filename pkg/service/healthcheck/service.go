@@ -12,6 +12,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/pkg"
 	"github.com/scylladb/scylla-manager/pkg/ping"
 	"github.com/scylladb/scylla-manager/pkg/ping/cqlping"
 	"github.com/scylladb/scylla-manager/pkg/ping/dynamoping"
@@ -64,6 +66,7 @@ type nodeInfo struct {
 
 // Service manages health checks.
 type Service struct {
+	session      gocqlx.Session
 	config       Config
 	scyllaClient scyllaclient.ProviderFunc
 	secretsStore store.Store
@@ -73,20 +76,26 @@ type Service struct {
 	nodeInfoCache   map[clusterIDHost]nodeInfo
 	dynamicTimeouts map[clusterIDDCPingType]*dynamicTimeout
 
+	historyMu sync.Mutex
+	// fields below are protected by historyMu
+	lastSample map[clusterIDHostPingType]time.Time
+
 	logger log.Logger
 }
 
-func NewService(config Config, scyllaClient scyllaclient.ProviderFunc, secretsStore store.Store, logger log.Logger) (*Service, error) {
+func NewService(session gocqlx.Session, config Config, scyllaClient scyllaclient.ProviderFunc, secretsStore store.Store, logger log.Logger) (*Service, error) {
 	if scyllaClient == nil {
 		return nil, errors.New("invalid scylla provider")
 	}
 
 	return &Service{
+		session:         session,
 		config:          config,
 		scyllaClient:    scyllaClient,
 		secretsStore:    secretsStore,
 		nodeInfoCache:   make(map[clusterIDHost]nodeInfo),
 		dynamicTimeouts: make(map[clusterIDDCPingType]*dynamicTimeout),
+		lastSample:      make(map[clusterIDHostPingType]time.Time),
 		logger:          logger,
 	}, nil
 }
@@ -101,7 +110,8 @@ func (s *Service) CQLRunner() Runner {
 			rtt:     cqlRTT,
 			timeout: cqlTimeout,
 		},
-		ping: s.pingCQL,
+		ping:    s.pingCQL,
+		history: s.historyRecorder(cqlPing),
 	}
 }
 
@@ -116,7 +126,9 @@ func (s *Service) RESTRunner() Runner {
 			rtt:     restRTT,
 			timeout: restTimeout,
 		},
-		ping: s.pingREST,
+		ping:       s.pingREST,
+		history:    s.historyRecorder(restPing),
+		nodeChecks: s.nodeChecksMetrics,
 	}
 }
 
@@ -131,7 +143,8 @@ func (s *Service) AlternatorRunner() Runner {
 			rtt:     alternatorRTT,
 			timeout: alternatorTimeout,
 		},
-		ping: s.pingAlternator,
+		ping:    s.pingAlternator,
+		history: s.historyRecorder(alternatorPing),
 	}
 }
 
@@ -156,10 +169,60 @@ func (s *Service) Status(ctx context.Context, clusterID uuid.UUID) ([]NodeStatus
 	g.Go(s.parallelCQLPingFunc(ctx, clusterID, status, out))
 	g.Go(s.parallelRESTPingFunc(ctx, clusterID, status, out))
 	g.Go(s.parallelNodeInfoFunc(ctx, clusterID, status, out))
+	g.Go(s.parallelNodeChecksFunc(ctx, clusterID, status, out))
 
 	return out, g.Wait()
 }
 
+// PingNode checks if the node responds to REST and CQL pings, it returns
+// the first ping error.
+func (s *Service) PingNode(ctx context.Context, clusterID uuid.UUID, host, dc string) error {
+	if _, err := s.pingREST(ctx, clusterID, host, s.restTimeout(clusterID, dc).Timeout()); err != nil {
+		return errors.Wrap(err, "REST ping")
+	}
+	if _, err := s.pingCQL(ctx, clusterID, host, s.cqlTimeout(clusterID, dc).Timeout()); err != nil {
+		return errors.Wrap(err, "CQL ping")
+	}
+	return nil
+}
+
+// AgentConfig returns the effective agent configuration of every UN node in
+// the cluster. Failures to get the configuration are reported per node.
+func (s *Service) AgentConfig(ctx context.Context, clusterID uuid.UUID) ([]NodeAgentConfig, error) {
+	s.logger.Debug(ctx, "AgentConfig", "cluster_id", clusterID)
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client")
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "status")
+	}
+	status = status.Live()
+
+	out := make([]NodeAgentConfig, len(status))
+	err = parallel.Run(len(status), parallel.NoLimit, func(i int) error {
+		out[i].Datacenter = status[i].Datacenter
+		out[i].Host = status[i].Addr
+
+		c, err := client.AgentConfig(ctx, status[i].Addr)
+		if err != nil {
+			s.logger.Error(ctx, "Agent config fetch failed",
+				"cluster_id", clusterID,
+				"host", status[i].Addr,
+				"error", err,
+			)
+			out[i].Error = err.Error()
+			return nil
+		}
+		out[i].Config = c
+		return nil
+	})
+	return out, err
+}
+
 func (s *Service) parallelNodeInfoFunc(ctx context.Context, clusterID uuid.UUID, status scyllaclient.NodeStatusInfoSlice, out []NodeStatus) func() error {
 	return func() error {
 		return parallel.Run(len(status), parallel.NoLimit, func(i int) (_ error) {
@@ -358,6 +421,7 @@ func (s *Service) decorateNodeStatus(status *NodeStatus, ni nodeInfo) {
 	status.CPUCount = ni.CPUCount
 	status.ScyllaVersion = ni.ScyllaVersion
 	status.AgentVersion = ni.AgentVersion
+	status.AgentVersionStatus, status.AgentVersionCause = checkAgentVersion(pkg.Version(), ni.AgentVersion)
 }
 
 func (s *Service) pingCQL(ctx context.Context, clusterID uuid.UUID, host string, timeout time.Duration) (rtt time.Duration, err error) {
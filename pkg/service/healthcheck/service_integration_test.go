@@ -18,6 +18,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/pkg/secrets"
@@ -36,7 +37,7 @@ func TestStatusIntegration(t *testing.T) {
 	clusterID := uuid.MustRandom()
 
 	s := store.NewTableStore(session, table.Secrets)
-	testStatusIntegration(t, clusterID, s)
+	testStatusIntegration(t, session, clusterID, s)
 }
 
 func TestStatusWithCQLCredentialsIntegration(t *testing.T) {
@@ -56,10 +57,10 @@ func TestStatusWithCQLCredentialsIntegration(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	testStatusIntegration(t, clusterID, s)
+	testStatusIntegration(t, session, clusterID, s)
 }
 
-func testStatusIntegration(t *testing.T, clusterID uuid.UUID, secretsStore store.Store) {
+func testStatusIntegration(t *testing.T, session gocqlx.Session, clusterID uuid.UUID, secretsStore store.Store) {
 	logger := log.NewDevelopmentWithLevel(zapcore.InfoLevel).Named("healthcheck")
 
 	// Tests here do not test the dynamic t/o functionality
@@ -68,6 +69,7 @@ func testStatusIntegration(t *testing.T, clusterID uuid.UUID, secretsStore store
 
 	hrt := NewHackableRoundTripper(scyllaclient.DefaultTransport())
 	s, err := NewService(
+		session,
 		c,
 		func(context.Context, uuid.UUID) (*scyllaclient.Client, error) {
 			sc := scyllaclient.TestConfig(ManagedClusterHosts(), AgentAuthToken())
@@ -88,7 +90,9 @@ func testStatusIntegration(t *testing.T, clusterID uuid.UUID, secretsStore store
 		opts := cmp.Options{
 			UUIDComparer(),
 			cmpopts.IgnoreFields(NodeStatus{}, "HostID", "Status", "CQLRtt", "RESTRtt", "AlternatorRtt",
-				"TotalRAM", "Uptime", "CPUCount", "ScyllaVersion", "AgentVersion"),
+				"TotalRAM", "Uptime", "CPUCount", "ScyllaVersion", "AgentVersion",
+				"DiskStatus", "DiskFreePercent", "ClockStatus", "ClockOffset", "CertStatus", "CertExpiry",
+				"SchemaStatus", "SchemaVersion", "GossipStatus", "GossipCause"),
 		}
 		if diff := cmp.Diff(golden, status, opts...); diff != "" {
 			t.Errorf("Status() = %+v, diff %s", status, diff)
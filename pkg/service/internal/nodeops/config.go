@@ -0,0 +1,35 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/service"
+)
+
+// Config specifies configuration of a service that rewrites SSTables,
+// i.e. cleanup, compaction and SSTable maintenance.
+type Config struct {
+	// DiskSpaceFreeMinPercent specifies minimal amount of free disk space
+	// on a node required to rewrite SSTables, services document how exactly
+	// it's checked.
+	DiskSpaceFreeMinPercent int `yaml:"disk_space_free_min_percent"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		DiskSpaceFreeMinPercent: 10,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return service.ErrNilPtr
+	}
+
+	if c.DiskSpaceFreeMinPercent < 0 || c.DiskSpaceFreeMinPercent >= 100 {
+		return errors.New("invalid disk_space_free_min_percent, must be between 0 and 100")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2017 ScyllaDB
+
+// Package nodeops contains helpers shared by services that run an operation
+// on nodes of a cluster, i.e. cleanup, compaction, SSTable maintenance and
+// rolling restart.
+package nodeops
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"go.uber.org/multierr"
+)
+
+// DataDir is the data directory of a node, it's used to check disk usage.
+const DataDir = "data:"
+
+// DefaultParallel is the number of hosts in a DC processed at the same time
+// if not specified otherwise.
+const DefaultParallel = 1
+
+// FilterDCLimits returns limits for the given DCs and the default limit.
+func FilterDCLimits(limits []backup.DCLimit, dcs []string) []backup.DCLimit {
+	m := strset.New(dcs...)
+	var out []backup.DCLimit
+	for _, l := range limits {
+		if l.DC == "" || m.Has(l.DC) {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// DCParallel returns the number of hosts in the DC that can be processed
+// at the same time.
+func DCParallel(limits []backup.DCLimit, dc string) int {
+	limit := DefaultParallel
+	for _, l := range limits {
+		if l.DC == dc {
+			return l.Limit
+		}
+		if l.DC == "" {
+			limit = l.Limit
+		}
+	}
+	return limit
+}
+
+// InParallelPerDC runs f for hosts of every DC in parallel, number of hosts
+// processed at the same time in a DC is limited according to limits.
+func InParallelPerDC(nodes scyllaclient.NodeStatusInfoSlice, limits []backup.DCLimit, f func(host string) error) error {
+	dcHosts := make(map[string][]string)
+	for _, n := range nodes {
+		dcHosts[n.Datacenter] = append(dcHosts[n.Datacenter], n.Addr)
+	}
+
+	out := make(chan error)
+	for dc, hosts := range dcHosts {
+		go func(hosts []string, limit int) {
+			out <- parallel.Run(len(hosts), limit, func(i int) error {
+				return errors.Wrapf(f(hosts[i]), "%s", hosts[i])
+			})
+		}(hosts, DCParallel(limits, dc))
+	}
+
+	var errs error
+	for range dcHosts {
+		errs = multierr.Append(errs, <-out)
+	}
+	return errs
+}
+
+// CheckAvailableDiskSpace returns an error listing hosts that have less than
+// minPercent of the data directory disk free. If minPercent is 0 the check
+// is skipped.
+func CheckAvailableDiskSpace(ctx context.Context, client *scyllaclient.Client, hosts []string, minPercent int, logger log.Logger) error {
+	if minPercent == 0 {
+		return nil
+	}
+
+	var (
+		mu  sync.Mutex
+		low []string
+	)
+	if err := parallel.Run(len(hosts), parallel.NoLimit, func(i int) error {
+		du, err := client.RcloneDiskUsage(ctx, hosts[i], DataDir)
+		if err != nil {
+			return errors.Wrapf(err, "%s: get disk usage", hosts[i])
+		}
+		freePercent := int(100 * (float64(du.Free) / float64(du.Total)))
+		logger.Info(ctx, "Available disk space", "host", hosts[i], "percent", freePercent)
+		if freePercent < minPercent {
+			mu.Lock()
+			low = append(low, fmt.Sprintf("%s (%d%%)", hosts[i], freePercent))
+			mu.Unlock()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(low) > 0 {
+		sort.Strings(low)
+		return errors.Errorf("not enough disk space, required %d%% free on %s",
+			minPercent, strings.Join(low, ", "))
+	}
+	return nil
+}
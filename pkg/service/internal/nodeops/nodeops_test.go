@@ -0,0 +1,67 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/service/backup"
+)
+
+func TestFilterDCLimits(t *testing.T) {
+	limits := []backup.DCLimit{{Limit: 3}, {DC: "dc1", Limit: 2}, {DC: "dc2", Limit: 1}}
+	golden := []backup.DCLimit{{Limit: 3}, {DC: "dc1", Limit: 2}}
+
+	if diff := cmp.Diff(FilterDCLimits(limits, []string{"dc1"}), golden); diff != "" {
+		t.Fatal("FilterDCLimits() diff", diff)
+	}
+}
+
+func TestDCParallel(t *testing.T) {
+	table := []struct {
+		Name   string
+		Limits []backup.DCLimit
+		DC     string
+		Golden int
+	}{
+		{
+			Name:   "Default",
+			DC:     "dc1",
+			Golden: DefaultParallel,
+		},
+		{
+			Name:   "Global",
+			Limits: []backup.DCLimit{{Limit: 3}},
+			DC:     "dc1",
+			Golden: 3,
+		},
+		{
+			Name:   "DC overrides global",
+			Limits: []backup.DCLimit{{Limit: 3}, {DC: "dc1", Limit: 2}},
+			DC:     "dc1",
+			Golden: 2,
+		},
+		{
+			Name:   "Other DC",
+			Limits: []backup.DCLimit{{DC: "dc2", Limit: 2}},
+			DC:     "dc1",
+			Golden: DefaultParallel,
+		},
+		{
+			Name:   "No limit",
+			Limits: []backup.DCLimit{{DC: "dc1", Limit: 0}},
+			DC:     "dc1",
+			Golden: 0,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := DCParallel(test.Limits, test.DC); v != test.Golden {
+				t.Fatalf("DCParallel() = %d, expected %d", v, test.Golden)
+			}
+		})
+	}
+}
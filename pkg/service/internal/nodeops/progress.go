@@ -0,0 +1,85 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"time"
+)
+
+// Progress counts items, i.e. keyspaces, tables or hosts, processed in a run.
+type Progress struct {
+	Total       int        `json:"total"`
+	Success     int        `json:"success"`
+	Failed      int        `json:"failed"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// Add counts an item that was started, completed and failed with err.
+func (p *Progress) Add(startedAt, completedAt *time.Time, err string) {
+	p.Total++
+	if completedAt != nil {
+		if err != "" {
+			p.Failed++
+		} else {
+			p.Success++
+		}
+		p.CompletedAt = maxTime(p.CompletedAt, completedAt)
+	}
+	p.StartedAt = minTime(p.StartedAt, startedAt)
+}
+
+// Merge adds counts of o.
+func (p *Progress) Merge(o Progress) {
+	p.Total += o.Total
+	p.Success += o.Success
+	p.Failed += o.Failed
+	p.StartedAt = minTime(p.StartedAt, o.StartedAt)
+	p.CompletedAt = maxTime(p.CompletedAt, o.CompletedAt)
+}
+
+// Complete clears CompletedAt if not all the items are completed.
+func (p *Progress) Complete() {
+	if p.Total == 0 || p.Success+p.Failed < p.Total {
+		p.CompletedAt = nil
+	}
+}
+
+// Done returns true if there are items and all of them succeeded.
+func (p Progress) Done() bool {
+	return p.Total > 0 && p.Success == p.Total
+}
+
+// HostRange is a range [Start, End) of items belonging to Host.
+type HostRange struct {
+	Host  string
+	Start int
+	End   int
+}
+
+// GroupByHost returns ranges of consecutive items of the same host out of
+// n items, items are expected to be sorted by host.
+func GroupByHost(n int, host func(i int) string) []HostRange {
+	var out []HostRange
+	for i := 0; i < n; i++ {
+		if len(out) == 0 || out[len(out)-1].Host != host(i) {
+			out = append(out, HostRange{Host: host(i), Start: i})
+		}
+		out[len(out)-1].End = i + 1
+	}
+	return out
+}
+
+func minTime(a, b *time.Time) *time.Time {
+	if a == nil || b != nil && b.Before(*a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b *time.Time) *time.Time {
+	if a == nil || b != nil && b.After(*a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProgress(t *testing.T) {
+	var (
+		t0 = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 = t0.Add(time.Minute)
+		t2 = t0.Add(2 * time.Minute)
+		t3 = t0.Add(3 * time.Minute)
+	)
+
+	var h0 Progress
+	h0.Add(&t1, &t2, "")
+	h0.Add(&t0, &t3, "error")
+	h0.Complete()
+
+	var h1 Progress
+	h1.Add(&t1, &t2, "")
+	h1.Add(&t2, nil, "")
+	h1.Complete()
+
+	var p Progress
+	p.Merge(h0)
+	p.Merge(h1)
+	p.Complete()
+
+	table := []struct {
+		Name     string
+		Progress Progress
+		Golden   Progress
+		Done     bool
+	}{
+		{
+			Name:     "Completed",
+			Progress: h0,
+			Golden:   Progress{Total: 2, Success: 1, Failed: 1, StartedAt: &t0, CompletedAt: &t3},
+		},
+		{
+			Name:     "Running",
+			Progress: h1,
+			Golden:   Progress{Total: 2, Success: 1, StartedAt: &t1},
+		},
+		{
+			Name:     "Merged",
+			Progress: p,
+			Golden:   Progress{Total: 4, Success: 2, Failed: 1, StartedAt: &t0},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(test.Progress, test.Golden); diff != "" {
+				t.Fatal("Progress diff", diff)
+			}
+		})
+	}
+}
+
+func TestGroupByHost(t *testing.T) {
+	hosts := []string{"h1", "h1", "h2", "h3", "h3"}
+	golden := []HostRange{
+		{Host: "h1", Start: 0, End: 2},
+		{Host: "h2", Start: 2, End: 3},
+		{Host: "h3", Start: 3, End: 5},
+	}
+
+	v := GroupByHost(len(hosts), func(i int) string { return hosts[i] })
+	if diff := cmp.Diff(v, golden); diff != "" {
+		t.Fatal("GroupByHost() diff", diff)
+	}
+	if v := GroupByHost(0, nil); v != nil {
+		t.Fatalf("GroupByHost() = %v, expected nil", v)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Runner implements scheduler.Runner, it gets target of the operation from
+// task properties and runs the operation.
+type Runner struct {
+	// Operation is the name of the operation used in errors.
+	Operation string
+	// GetTarget converts task properties into target of the operation.
+	GetTarget func(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (interface{}, error)
+	// RunTarget runs the operation with target returned by GetTarget.
+	RunTarget func(ctx context.Context, clusterID, taskID, runID uuid.UUID, target interface{}) error
+}
+
+func (r Runner) Run(ctx context.Context, clusterID, taskID, runID uuid.UUID, properties json.RawMessage) error {
+	t, err := r.GetTarget(ctx, clusterID, properties)
+	if err != nil {
+		return errors.Wrapf(err, "get %s target", r.Operation)
+	}
+
+	return r.RunTarget(ctx, clusterID, taskID, runID, t)
+}
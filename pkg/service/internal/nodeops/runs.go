@@ -0,0 +1,87 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/gocqlx/v2/table"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// recentRuns is the number of the most recent runs of a task searched for
+// a run that can be resumed.
+const recentRuns = 20
+
+// RunStore reads and writes runs and run progress of a service in
+// the given tables.
+type RunStore struct {
+	Session     gocqlx.Session
+	Run         *table.Table
+	RunProgress *table.Table
+}
+
+// PutRun upserts a run.
+func (s RunStore) PutRun(r interface{}) error {
+	return s.Run.InsertQuery(s.Session).BindStruct(r).ExecRelease()
+}
+
+// PutRunProgress upserts a run progress.
+func (s RunStore) PutRunProgress(p interface{}) error {
+	return s.RunProgress.InsertQuery(s.Session).BindStruct(p).ExecRelease()
+}
+
+// GetRun loads a run based on ID into r. If nothing was found
+// scylla-manager.ErrNotFound is returned.
+func (s RunStore) GetRun(clusterID, taskID, runID uuid.UUID, r interface{}) error {
+	q := s.Run.GetQuery(s.Session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+		"id":         runID,
+	})
+	return q.GetRelease(r)
+}
+
+// GetRunProgress loads all the progress of a run into v.
+func (s RunStore) GetRunProgress(clusterID, taskID, runID uuid.UUID, v interface{}) error {
+	q := s.RunProgress.SelectQuery(s.Session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+		"run_id":     runID,
+	})
+	return q.SelectRelease(v)
+}
+
+// GetRecentRuns loads the most recent runs of the task into v, runs are
+// sorted from the most recent.
+func (s RunStore) GetRecentRuns(clusterID, taskID uuid.UUID, v interface{}) error {
+	q := qb.Select(s.Run.Name()).Where(
+		qb.Eq("cluster_id"),
+		qb.Eq("task_id"),
+	).Limit(recentRuns).Query(s.Session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+	})
+	return q.SelectRelease(v)
+}
+
+// LastResumableRun returns index of the most recent started but not done run
+// out of n runs sorted from the most recent, progress returns progress of
+// the i-th run. If there is a recent run that is completely done
+// scylla-manager.ErrNotFound is returned.
+func LastResumableRun(n int, progress func(i int) (Progress, error)) (int, error) {
+	for i := 0; i < n; i++ {
+		p, err := progress(i)
+		if err != nil {
+			return 0, err
+		}
+		if p.Total > 0 {
+			if p.Done() {
+				break
+			}
+			return i, nil
+		}
+	}
+	return 0, service.ErrNotFound
+}
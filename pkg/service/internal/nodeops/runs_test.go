@@ -0,0 +1,60 @@
+// Copyright (C) 2017 ScyllaDB
+
+package nodeops
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/scylladb/scylla-manager/pkg/service"
+)
+
+func TestLastResumableRun(t *testing.T) {
+	var (
+		empty   = Progress{}
+		done    = Progress{Total: 2, Success: 2}
+		running = Progress{Total: 2, Success: 1}
+	)
+
+	table := []struct {
+		Name   string
+		Runs   []Progress
+		Golden int
+		Err    error
+	}{
+		{
+			Name: "No runs",
+			Err:  service.ErrNotFound,
+		},
+		{
+			Name:   "Running",
+			Runs:   []Progress{running, done},
+			Golden: 0,
+		},
+		{
+			Name:   "Skip empty",
+			Runs:   []Progress{empty, running},
+			Golden: 1,
+		},
+		{
+			Name: "Done",
+			Runs: []Progress{done, running},
+			Err:  service.ErrNotFound,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			v, err := LastResumableRun(len(test.Runs), func(i int) (Progress, error) {
+				return test.Runs[i], nil
+			})
+			if !errors.Is(err, test.Err) {
+				t.Fatalf("LastResumableRun() error %s, expected %s", err, test.Err)
+			}
+			if err == nil && v != test.Golden {
+				t.Fatalf("LastResumableRun() = %d, expected %d", v, test.Golden)
+			}
+		})
+	}
+}
@@ -182,6 +182,13 @@ type TableProgress struct {
 	Table    string `json:"table"`
 }
 
+// HostTableProgress represents progress for table on a single host.
+// It's published as repair progress event.
+type HostTableProgress struct {
+	Host string `json:"host"`
+	TableProgress
+}
+
 // Progress breakdown repair progress by tables for all hosts and each host
 // separately.
 type Progress struct {
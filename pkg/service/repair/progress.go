@@ -12,6 +12,7 @@ import (
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
@@ -56,6 +57,7 @@ type dbProgressManager struct {
 	run     *Run
 	session gocqlx.Session
 	metrics metrics.RepairMetrics
+	events  events.Publisher
 	logger  log.Logger
 
 	mu       sync.Mutex
@@ -70,6 +72,7 @@ func newProgressManager(run *Run, session gocqlx.Session, metrics metrics.Repair
 		run:     run,
 		session: session,
 		metrics: metrics,
+		events:  events.NopPublisher,
 		logger:  logger.With("run_id", run.ID),
 
 		progress: make(map[progressKey]*RunProgress),
@@ -281,6 +284,7 @@ func (pm *dbProgressManager) OnJobResult(ctx context.Context, r jobResult) {
 
 		pm.metrics.SetTokenRanges(pm.run.ClusterID, ttr.Keyspace, ttr.Table, h,
 			pm.progress[pk].TokenRanges, pm.progress[pk].Success, pm.progress[pk].Error)
+		pm.publish(ctx, pm.progress[pk], end)
 	}
 
 	sk := stateKey{
@@ -306,6 +310,32 @@ func (pm *dbProgressManager) OnJobResult(ctx context.Context, r jobResult) {
 	}
 }
 
+func (pm *dbProgressManager) publish(ctx context.Context, rp *RunProgress, now time.Time) {
+	pm.events.Publish(ctx, events.Event{
+		Type:      events.RepairProgress,
+		ClusterID: pm.run.ClusterID,
+		TaskType:  "repair",
+		TaskID:    pm.run.TaskID,
+		RunID:     pm.run.ID,
+		Time:      now,
+		Data: HostTableProgress{
+			Host: rp.Host,
+			TableProgress: TableProgress{
+				progress: progress{
+					TokenRanges: rp.TokenRanges,
+					Success:     rp.Success,
+					Error:       rp.Error,
+					StartedAt:   rp.StartedAt,
+					CompletedAt: rp.CompletedAt,
+					Duration:    rp.CurrentDuration(now).Milliseconds(),
+				},
+				Keyspace: rp.Keyspace,
+				Table:    rp.Table,
+			},
+		},
+	})
+}
+
 func (pm *dbProgressManager) CheckRepaired(ttr *tableTokenRange) bool {
 	sk := stateKey{
 		keyspace: ttr.Keyspace,
@@ -15,6 +15,7 @@ import (
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
 	"github.com/scylladb/scylla-manager/pkg/dht"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
@@ -35,6 +36,7 @@ type Service struct {
 	metrics metrics.RepairMetrics
 
 	scyllaClient scyllaclient.ProviderFunc
+	events       events.Publisher
 	logger       log.Logger
 
 	intensityHandlers map[uuid.UUID]*intensityHandler
@@ -55,11 +57,18 @@ func NewService(session gocqlx.Session, config Config, metrics metrics.RepairMet
 		config:            config,
 		metrics:           metrics,
 		scyllaClient:      scyllaClient,
+		events:            events.NopPublisher,
 		logger:            logger,
 		intensityHandlers: make(map[uuid.UUID]*intensityHandler),
 	}, nil
 }
 
+// SetEventPublisher sets publisher that would be notified on repair progress
+// changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
 // Runner creates a Runner that handles repairs.
 func (s *Service) Runner() Runner {
 	return Runner{service: s}
@@ -260,6 +269,7 @@ func (s *Service) Repair(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		manager = newProgressManager(run, s.session, s.metrics, s.logger)
 		gen     = newGenerator(s.config.GracefulStopTimeout, manager, s.logger)
 	)
+	manager.events = s.events
 
 	// Feed generator with token ranges and calculate max possible number of
 	// parallel repair threads in all keyspaces.
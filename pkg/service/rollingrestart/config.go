@@ -0,0 +1,43 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"go.uber.org/multierr"
+)
+
+// Config specifies the rolling restart service configuration.
+type Config struct {
+	// WaitTimeout specifies how long to wait for a restarted node to respond
+	// to CQL and REST pings and then for all the nodes to be UN.
+	WaitTimeout time.Duration `yaml:"wait_timeout"`
+	// PollInterval specifies how often the node and cluster status is checked
+	// while waiting.
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		WaitTimeout:  15 * time.Minute,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return service.ErrNilPtr
+	}
+
+	var err error
+	if c.WaitTimeout <= 0 {
+		err = multierr.Append(err, errors.New("invalid wait_timeout, must be > 0"))
+	}
+	if c.PollInterval <= 0 {
+		err = multierr.Append(err, errors.New("invalid poll_interval, must be > 0"))
+	}
+	return err
+}
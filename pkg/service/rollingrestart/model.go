@@ -0,0 +1,87 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Target specifies which nodes shall be restarted and in what order.
+type Target struct {
+	DC       []string `json:"dc"`
+	DCOrder  []string `json:"dc_order"`
+	Hosts    []string `json:"hosts"`
+	Continue bool     `json:"continue"`
+
+	nodes scyllaclient.NodeStatusInfoSlice
+}
+
+// taskProperties is the main data structure of the runner.Properties blob.
+type taskProperties struct {
+	DC       []string `json:"dc"`
+	DCOrder  []string `json:"dc_order"`
+	Continue bool     `json:"continue"`
+}
+
+func defaultTaskProperties() taskProperties {
+	return taskProperties{
+		Continue: true,
+	}
+}
+
+// Run tracks rolling restart progress, shares ID with scheduler.Run that
+// initiated it.
+type Run struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	ID        uuid.UUID
+
+	PrevID    uuid.UUID
+	DC        []string
+	StartTime time.Time
+}
+
+// RunProgress describes restart progress of a host.
+type RunProgress struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Host      string
+
+	DC          string
+	Order       int `db:"restart_order"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Error       string
+}
+
+// Done returns true if the host was restarted and the cluster became healthy
+// afterwards.
+func (p *RunProgress) Done() bool {
+	return p.CompletedAt != nil && p.Error == ""
+}
+
+// progress counts restarted hosts.
+type progress = nodeops.Progress
+
+// Progress groups rolling restart progress for all hosts.
+type Progress struct {
+	progress
+
+	DC    []string       `json:"dcs,omitempty"`
+	Hosts []HostProgress `json:"hosts,omitempty"`
+}
+
+// HostProgress defines restart progress of a host.
+// It's also published as rolling restart progress event.
+type HostProgress struct {
+	Host        string     `json:"host"`
+	DC          string     `json:"dc"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Error       string     `json:"error,omitempty"`
+}
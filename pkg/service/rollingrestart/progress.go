@@ -0,0 +1,33 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"sort"
+)
+
+// aggregateProgress lists run progress of hosts in the restart order.
+func aggregateProgress(run *Run, runProgress []*RunProgress) Progress {
+	rps := make([]*RunProgress, len(runProgress))
+	copy(rps, runProgress)
+	sort.SliceStable(rps, func(i, j int) bool {
+		return rps[i].Order < rps[j].Order
+	})
+
+	p := Progress{
+		DC: run.DC,
+	}
+	for _, rp := range rps {
+		p.Hosts = append(p.Hosts, HostProgress{
+			Host:        rp.Host,
+			DC:          rp.DC,
+			StartedAt:   rp.StartedAt,
+			CompletedAt: rp.CompletedAt,
+			Error:       rp.Error,
+		})
+		p.progress.Add(rp.StartedAt, rp.CompletedAt, rp.Error)
+	}
+	p.progress.Complete()
+
+	return p
+}
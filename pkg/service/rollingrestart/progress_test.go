@@ -0,0 +1,31 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAggregateProgressOrder(t *testing.T) {
+	run := &Run{DC: []string{"dc1", "dc2"}}
+	rps := []*RunProgress{
+		{Host: "h1", DC: "dc2", Order: 2},
+		{Host: "h2", DC: "dc1", Order: 1},
+		{Host: "h3", DC: "dc1", Order: 0},
+	}
+
+	golden := []HostProgress{
+		{Host: "h3", DC: "dc1"},
+		{Host: "h2", DC: "dc1"},
+		{Host: "h1", DC: "dc2"},
+	}
+
+	if diff := cmp.Diff(aggregateProgress(run, rps).Hosts, golden); diff != "" {
+		t.Fatal(diff)
+	}
+	if rps[0].Host != "h1" {
+		t.Fatal("aggregateProgress() modified run progress order")
+	}
+}
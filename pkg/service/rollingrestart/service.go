@@ -0,0 +1,486 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/dcfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/pointer"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// PingFunc checks if the node is up and serving clients.
+type PingFunc func(ctx context.Context, clusterID uuid.UUID, host, dc string) error
+
+// Service orchestrates restarts of Scylla nodes one at a time.
+type Service struct {
+	runs   nodeops.RunStore
+	config Config
+
+	scyllaClient scyllaclient.ProviderFunc
+	pingNode     PingFunc
+	events       events.Publisher
+	logger       log.Logger
+}
+
+func NewService(session gocqlx.Session, config Config, scyllaClient scyllaclient.ProviderFunc, pingNode PingFunc, logger log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if scyllaClient == nil {
+		return nil, errors.New("invalid scylla provider")
+	}
+
+	if pingNode == nil {
+		return nil, errors.New("invalid ping function")
+	}
+
+	return &Service{
+		runs: nodeops.RunStore{
+			Session:     session,
+			Run:         table.RollingRestartRun,
+			RunProgress: table.RollingRestartRunProgress,
+		},
+		config:       config,
+		scyllaClient: scyllaClient,
+		pingNode:     pingNode,
+		events:       events.NopPublisher,
+		logger:       logger,
+	}, nil
+}
+
+// SetEventPublisher sets publisher that would be notified on rolling restart
+// progress changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
+// Runner creates a Runner that handles rolling restarts.
+func (s *Service) Runner() nodeops.Runner {
+	return nodeops.Runner{
+		Operation: "rolling restart",
+		GetTarget: func(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (interface{}, error) {
+			return s.GetTarget(ctx, clusterID, properties)
+		},
+		RunTarget: func(ctx context.Context, clusterID, taskID, runID uuid.UUID, target interface{}) error {
+			return s.RollingRestart(ctx, clusterID, taskID, runID, target.(Target))
+		},
+	}
+}
+
+// GetTarget converts runner properties into rolling restart Target.
+func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (Target, error) {
+	s.logger.Info(ctx, "Generating rolling restart target", "cluster_id", clusterID)
+
+	p := defaultTaskProperties()
+	t := Target{}
+
+	if err := json.Unmarshal(properties, &p); err != nil {
+		return t, service.ErrValidate(err)
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return t, errors.Wrapf(err, "get client")
+	}
+
+	// Get hosts in DCs
+	dcMap, err := client.Datacenters(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "read datacenters")
+	}
+
+	// Validate DC order
+	for _, dc := range p.DCOrder {
+		if _, ok := dcMap[dc]; !ok {
+			return t, service.ErrValidate(errors.Errorf("invalid dc_order: no such datacenter %s", dc))
+		}
+	}
+
+	// Copy simple properties
+	t.DCOrder = p.DCOrder
+	t.Continue = p.Continue
+
+	// Filter DCs
+	if t.DC, err = dcfilter.Apply(dcMap, p.DC); err != nil {
+		return t, err
+	}
+
+	// Get nodes, restart requires all the nodes in the cluster to be UN
+	status, err := client.Status(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "get status")
+	}
+	if nodes := notUN(status); len(nodes) > 0 {
+		return t, service.ErrValidate(errors.Errorf("nodes are not UN: %s", strings.Join(nodes.Hosts(), ", ")))
+	}
+	t.nodes = restartOrder(status.Datacenter(t.DC), t.DCOrder)
+	t.Hosts = t.nodes.Hosts()
+
+	return t, nil
+}
+
+// restartOrder sorts nodes so that DCs are restarted in the given order,
+// DCs not listed in order are restarted afterwards in alphabetical order.
+// The order of nodes within a DC is preserved.
+func restartOrder(nodes scyllaclient.NodeStatusInfoSlice, order []string) scyllaclient.NodeStatusInfoSlice {
+	rank := make(map[string]int, len(order))
+	for i, dc := range order {
+		rank[dc] = i
+	}
+	dcRank := func(dc string) (int, string) {
+		if r, ok := rank[dc]; ok {
+			return r, ""
+		}
+		return len(order), dc
+	}
+
+	out := make(scyllaclient.NodeStatusInfoSlice, len(nodes))
+	copy(out, nodes)
+	sort.SliceStable(out, func(i, j int) bool {
+		ri, di := dcRank(out[i].Datacenter)
+		rj, dj := dcRank(out[j].Datacenter)
+		if ri != rj {
+			return ri < rj
+		}
+		return di < dj
+	})
+	return out
+}
+
+// notUN returns nodes that are not up and normal.
+func notUN(nodes scyllaclient.NodeStatusInfoSlice) scyllaclient.NodeStatusInfoSlice {
+	var out scyllaclient.NodeStatusInfoSlice
+	for _, n := range nodes {
+		if !n.IsUN() {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// RollingRestart restarts nodes of a given target one by one. A node is
+// drained and restarted by the agent, then it must respond to pings and all
+// the nodes in the cluster must be UN before the next node is restarted.
+// The restart is aborted on the first failure.
+func (s *Service) RollingRestart(ctx context.Context, clusterID, taskID, runID uuid.UUID, target Target) error {
+	s.logger.Debug(ctx, "RollingRestart",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"target", target,
+	)
+
+	run := &Run{
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		ID:        runID,
+		DC:        target.DC,
+		StartTime: timeutc.Now().UTC(),
+	}
+
+	// Get the cluster client
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return errors.Wrap(err, "get client proxy")
+	}
+
+	if len(target.nodes) == 0 {
+		return errors.New("no nodes to restart")
+	}
+
+	// Get progress of the previous run if it can be continued
+	var done map[string]*RunProgress
+	if target.Continue {
+		prev, err := s.GetLastResumableRun(ctx, clusterID, taskID)
+		if err != nil && !errors.Is(err, service.ErrNotFound) {
+			return errors.Wrap(err, "get previous run")
+		}
+		if prev != nil {
+			s.logger.Info(ctx, "Resuming previous run", "prev_run_id", prev.ID)
+			run.PrevID = prev.ID
+			if done, err = s.doneRunProgress(prev); err != nil {
+				return errors.Wrap(err, "get previous run progress")
+			}
+		}
+	}
+
+	// Register the run
+	if err := s.putRun(run); err != nil {
+		return errors.Wrap(err, "register the run")
+	}
+
+	// Register progress of all the hosts
+	progress := make([]*RunProgress, len(target.nodes))
+	for i, n := range target.nodes {
+		rp, ok := done[n.Addr]
+		if ok {
+			rp.RunID = runID
+			rp.Order = i
+		} else {
+			rp = &RunProgress{
+				ClusterID: clusterID,
+				TaskID:    taskID,
+				RunID:     runID,
+				Host:      n.Addr,
+				DC:        n.Datacenter,
+				Order:     i,
+			}
+		}
+		if err := s.putRunProgress(ctx, rp); err != nil {
+			return errors.Wrap(err, "register the run progress")
+		}
+		progress[i] = rp
+	}
+
+	s.logger.Info(ctx, "Rolling restart started",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"prev_run_id", run.PrevID,
+	)
+
+	for _, rp := range progress {
+		if rp.Done() {
+			s.logger.Info(ctx, "Skipping host restarted in previous run", "host", rp.Host)
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		s.logger.Info(ctx, "Restarting host", "host", rp.Host, "dc", rp.DC)
+
+		rp.StartedAt = pointer.TimePtr(timeutc.Now())
+		rp.CompletedAt = nil
+		rp.Error = ""
+		s.onRunProgress(ctx, rp)
+
+		err := s.restartHost(ctx, client, clusterID, rp)
+		if ctx.Err() != nil {
+			s.logger.Info(ctx, "Rolling restart interrupted", "run_id", runID, "host", rp.Host, "error", err)
+			rp.StartedAt = nil
+			s.onRunProgress(ctx, rp)
+			return ctx.Err()
+		}
+
+		rp.CompletedAt = pointer.TimePtr(timeutc.Now())
+		if err != nil {
+			rp.Error = err.Error()
+			s.onRunProgress(ctx, rp)
+			s.logger.Error(ctx, "Restart failed, aborting rolling restart", "host", rp.Host, "error", err)
+			return errors.Wrapf(err, "%s", rp.Host)
+		}
+		s.onRunProgress(ctx, rp)
+		s.logger.Info(ctx, "Done restarting host", "host", rp.Host)
+	}
+
+	s.logger.Info(ctx, "Rolling restart done",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	return nil
+}
+
+// restartHost drains and restarts a node, and waits for the node to respond
+// to pings and for all the nodes to be UN.
+func (s *Service) restartHost(ctx context.Context, client *scyllaclient.Client, clusterID uuid.UUID, rp *RunProgress) error {
+	if err := s.waitClusterUN(ctx, client); err != nil {
+		return err
+	}
+	if err := client.Drain(ctx, rp.Host); err != nil {
+		return errors.Wrap(err, "drain")
+	}
+	// Drained node does not serve clients, restart it even if the run is
+	// stopped in the meantime.
+	restartCtx := log.CopyTraceID(context.Background(), ctx)
+	if err := client.RestartScylla(restartCtx, rp.Host); err != nil {
+		return err
+	}
+	if err := s.waitFor(ctx, func() error {
+		return s.pingNode(ctx, clusterID, rp.Host, rp.DC)
+	}); err != nil {
+		return errors.Wrap(err, "wait for node to be up")
+	}
+	return s.waitClusterUN(ctx, client)
+}
+
+// waitClusterUN waits for all the nodes in the cluster to be UN.
+func (s *Service) waitClusterUN(ctx context.Context, client *scyllaclient.Client) error {
+	err := s.waitFor(ctx, func() error {
+		status, err := client.Status(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get status")
+		}
+		if nodes := notUN(status); len(nodes) > 0 {
+			return errors.Errorf("nodes are not UN: %s", strings.Join(nodes.Hosts(), ", "))
+		}
+		return nil
+	})
+	return errors.Wrap(err, "wait for all nodes to be UN")
+}
+
+// waitFor calls f every poll interval until it succeeds, the last error of f
+// is returned if it does not succeed within the wait timeout.
+func (s *Service) waitFor(ctx context.Context, f func() error) error {
+	timeout := time.NewTimer(s.config.WaitTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return errors.Wrapf(err, "timeout after %s", s.config.WaitTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) onRunProgress(ctx context.Context, rp *RunProgress) {
+	if err := s.putRunProgress(ctx, rp); err != nil {
+		s.logger.Error(ctx, "Failed to update rolling restart progress", "error", err)
+	}
+	s.publishRunProgress(ctx, rp)
+}
+
+// putRun upserts a rolling restart run.
+func (s *Service) putRun(r *Run) error {
+	return s.runs.PutRun(r)
+}
+
+// putRunProgress upserts a rolling restart run progress.
+func (s *Service) putRunProgress(ctx context.Context, p *RunProgress) error {
+	s.logger.Debug(ctx, "PutRunProgress", "run_progress", p)
+
+	return s.runs.PutRunProgress(p)
+}
+
+// publishRunProgress sends rolling restart progress event for a host.
+func (s *Service) publishRunProgress(ctx context.Context, p *RunProgress) {
+	s.events.Publish(ctx, events.Event{
+		Type:      events.RollingRestartProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "rolling_restart",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: HostProgress{
+			Host:        p.Host,
+			DC:          p.DC,
+			StartedAt:   p.StartedAt,
+			CompletedAt: p.CompletedAt,
+			Error:       p.Error,
+		},
+	})
+}
+
+// doneRunProgress returns progress of hosts successfully restarted in the run
+// indexed by host.
+func (s *Service) doneRunProgress(run *Run) (map[string]*RunProgress, error) {
+	rps, err := s.getRunProgress(run.ClusterID, run.TaskID, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*RunProgress)
+	for _, rp := range rps {
+		if rp.Done() {
+			m[rp.Host] = rp
+		}
+	}
+	return m, nil
+}
+
+func (s *Service) getRunProgress(clusterID, taskID, runID uuid.UUID) ([]*RunProgress, error) {
+	var v []*RunProgress
+	return v, s.runs.GetRunProgress(clusterID, taskID, runID, &v)
+}
+
+// GetLastResumableRun returns the the most recent started but not done run of
+// the task, if there is a recent run that is completely done ErrNotFound is
+// reported.
+func (s *Service) GetLastResumableRun(ctx context.Context, clusterID, taskID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetLastResumableRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+	)
+
+	var runs []*Run
+	if err := s.runs.GetRecentRuns(clusterID, taskID, &runs); err != nil {
+		return nil, err
+	}
+
+	i, err := nodeops.LastResumableRun(len(runs), func(i int) (nodeops.Progress, error) {
+		r := runs[i]
+		rps, err := s.getRunProgress(r.ClusterID, r.TaskID, r.ID)
+		if err != nil {
+			return nodeops.Progress{}, err
+		}
+		return aggregateProgress(r, rps).progress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return runs[i], nil
+}
+
+// GetRun returns a run based on ID. If nothing was found scylla-manager.ErrNotFound
+// is returned.
+func (s *Service) GetRun(ctx context.Context, clusterID, taskID, runID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	var r Run
+	return &r, s.runs.GetRun(clusterID, taskID, runID, &r)
+}
+
+// GetProgress aggregates progress for the run of the task and lists hosts
+// in the restart order.
+// If nothing was found scylla-manager.ErrNotFound is returned.
+func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (Progress, error) {
+	s.logger.Debug(ctx, "GetProgress",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	run, err := s.GetRun(ctx, clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	rps, err := s.getRunProgress(clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	return aggregateProgress(run, rps), nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2017 ScyllaDB
+
+package rollingrestart
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+)
+
+func TestRestartOrder(t *testing.T) {
+	nodes := scyllaclient.NodeStatusInfoSlice{
+		{Datacenter: "dc3", Addr: "h1"},
+		{Datacenter: "dc1", Addr: "h2"},
+		{Datacenter: "dc2", Addr: "h3"},
+		{Datacenter: "dc1", Addr: "h4"},
+		{Datacenter: "dc3", Addr: "h5"},
+	}
+
+	table := []struct {
+		Name   string
+		Order  []string
+		Golden []string
+	}{
+		{
+			Name:   "Default",
+			Golden: []string{"h2", "h4", "h3", "h1", "h5"},
+		},
+		{
+			Name:   "Full order",
+			Order:  []string{"dc3", "dc2", "dc1"},
+			Golden: []string{"h1", "h5", "h3", "h2", "h4"},
+		},
+		{
+			Name:   "Partial order",
+			Order:  []string{"dc2"},
+			Golden: []string{"h3", "h2", "h4", "h1", "h5"},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(restartOrder(nodes, test.Order).Hosts(), test.Golden); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
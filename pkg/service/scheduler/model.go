@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gocql/gocql"
 	"github.com/pkg/errors"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/scylla-manager/pkg/scheduler"
@@ -25,10 +26,15 @@ type TaskType string
 const (
 	UnknownTask               TaskType = "unknown"
 	BackupTask                TaskType = "backup"
+	CleanupTask               TaskType = "cleanup"
+	CompactionTask            TaskType = "compaction"
 	HealthCheckAlternatorTask TaskType = "healthcheck_alternator"
 	HealthCheckCQLTask        TaskType = "healthcheck"
 	HealthCheckRESTTask       TaskType = "healthcheck_rest"
 	RepairTask                TaskType = "repair"
+	RollingRestartTask        TaskType = "rolling_restart"
+	SSTableMaintenanceTask    TaskType = "sstable_maintenance"
+	SnapshotTask              TaskType = "snapshot"
 	ValidateBackupTask        TaskType = "validate_backup"
 
 	mockTask TaskType = "mock"
@@ -48,6 +54,10 @@ func (t *TaskType) UnmarshalText(text []byte) error {
 		*t = UnknownTask
 	case BackupTask:
 		*t = BackupTask
+	case CleanupTask:
+		*t = CleanupTask
+	case CompactionTask:
+		*t = CompactionTask
 	case HealthCheckAlternatorTask:
 		*t = HealthCheckAlternatorTask
 	case HealthCheckCQLTask:
@@ -56,6 +66,12 @@ func (t *TaskType) UnmarshalText(text []byte) error {
 		*t = HealthCheckRESTTask
 	case RepairTask:
 		*t = RepairTask
+	case RollingRestartTask:
+		*t = RollingRestartTask
+	case SSTableMaintenanceTask:
+		*t = SSTableMaintenanceTask
+	case SnapshotTask:
+		*t = SnapshotTask
 	case ValidateBackupTask:
 		*t = ValidateBackupTask
 	case mockTask:
@@ -82,17 +98,60 @@ type Schedule struct {
 	Interval             duration.Duration `json:"interval" db:"interval_seconds"`
 	NumRetries           int               `json:"num_retries"`
 	RetryInitialInterval duration.Duration `json:"retry_initial_interval"`
+	Window               []WeekdayTime     `json:"window"`
 }
 
 func (s Schedule) trigger() scheduler.Trigger {
 	return trigger.NewLegacy(s.StartDate, s.Interval.Duration())
 }
 
+func (s Schedule) window() scheduler.Window {
+	w, _ := s.newWindow() // nolint: errcheck
+	return w
+}
+
+func (s Schedule) newWindow() (scheduler.Window, error) {
+	if len(s.Window) == 0 {
+		return nil, nil
+	}
+	wdt := make([]scheduler.WeekdayTime, len(s.Window))
+	for i := range s.Window {
+		wdt[i] = s.Window[i].WeekdayTime
+	}
+	return scheduler.NewWindow(wdt...)
+}
+
 func (s Schedule) Validate() error {
-	// TO-DO add validation
+	if _, err := s.newWindow(); err != nil {
+		return errors.Wrap(err, "invalid window")
+	}
 	return nil
 }
 
+// WeekdayTime adds CQL capabilities to scheduler.WeekdayTime, it's stored
+// as text in format [weekday-]HH:MM.
+type WeekdayTime struct {
+	scheduler.WeekdayTime
+}
+
+// MarshalCQL implements gocql.Marshaler.
+func (w WeekdayTime) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	b, err := w.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return gocql.Marshal(info, string(b))
+}
+
+// UnmarshalCQL implements gocql.Unmarshaler.
+func (w *WeekdayTime) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	var s string
+	if err := gocql.Unmarshal(info, data, &s); err != nil {
+		return err
+	}
+	return w.UnmarshalText([]byte(s))
+}
+
 // Task specify task type, properties and schedule.
 type Task struct {
 	ClusterID  uuid.UUID       `json:"cluster_id"`
@@ -4,16 +4,25 @@ package scheduler
 
 import (
 	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/scylla-manager/pkg/scheduler"
 )
 
 func TestTaskType(t *testing.T) {
 	allTaskTypes := []TaskType{
 		UnknownTask,
 		BackupTask,
+		CleanupTask,
+		CompactionTask,
 		HealthCheckAlternatorTask,
 		HealthCheckCQLTask,
 		HealthCheckRESTTask,
 		RepairTask,
+		RollingRestartTask,
+		SSTableMaintenanceTask,
+		SnapshotTask,
 		ValidateBackupTask,
 	}
 
@@ -51,3 +60,73 @@ func TestStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestWeekdayTimeMarshalUnmarshalCQL(t *testing.T) {
+	g := gocql.NewNativeType(4, gocql.TypeText, "")
+
+	w0 := WeekdayTime{scheduler.WeekdayTime{Weekday: time.Monday, Time: 23*time.Hour + 15*time.Minute}}
+
+	b, err := w0.MarshalCQL(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "Mon-23:15" {
+		t.Fatal("MarshalCQL() =", string(b))
+	}
+
+	var w1 WeekdayTime
+	if err := w1.UnmarshalCQL(g, b); err != nil {
+		t.Fatal(err)
+	}
+	if w0 != w1 {
+		t.Fatal("mismatch", w0, w1)
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	wdt := func(s string) WeekdayTime {
+		var w WeekdayTime
+		if err := w.UnmarshalText([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+
+	table := []struct {
+		Name   string
+		Window []WeekdayTime
+		Error  bool
+	}{
+		{
+			Name: "no window",
+		},
+		{
+			Name:   "valid window",
+			Window: []WeekdayTime{wdt("Sat-22:00"), wdt("Sun-6:00")},
+		},
+		{
+			Name:   "odd number of points",
+			Window: []WeekdayTime{wdt("Sat-22:00")},
+			Error:  true,
+		},
+		{
+			Name:   "each day mixed with weekday",
+			Window: []WeekdayTime{wdt("22:00"), wdt("Sun-6:00")},
+			Error:  true,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			s := Schedule{Window: test.Window}
+			err := s.Validate()
+			if test.Error && err == nil {
+				t.Fatal("Validate() expected error")
+			}
+			if !test.Error && err != nil {
+				t.Fatal("Validate() error", err)
+			}
+		})
+	}
+}
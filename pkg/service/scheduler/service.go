@@ -14,6 +14,7 @@ import (
 	"github.com/scylladb/go-set/b16set"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/events"
 	"github.com/scylladb/scylla-manager/pkg/metrics"
 	"github.com/scylladb/scylla-manager/pkg/scheduler"
 	"github.com/scylladb/scylla-manager/pkg/scheduler/trigger"
@@ -34,6 +35,7 @@ type Service struct {
 	metrics metrics.SchedulerMetrics
 	drawer  store.Store
 	logger  log.Logger
+	events  events.Publisher
 
 	decorators map[TaskType]PropertiesDecorator
 	runners    map[TaskType]Runner
@@ -52,6 +54,7 @@ func NewService(session gocqlx.Session, metrics metrics.SchedulerMetrics, drawer
 		metrics: metrics,
 		drawer:  drawer,
 		logger:  logger,
+		events:  events.NopPublisher,
 
 		decorators: make(map[TaskType]PropertiesDecorator),
 		runners:    make(map[TaskType]Runner),
@@ -114,6 +117,12 @@ func (s *Service) PropertiesDecorator(tp TaskType) PropertiesDecorator {
 	return s.decorators[tp]
 }
 
+// SetEventPublisher sets publisher that would be notified on run status
+// changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
 // SetRunner assigns runner for a given task type.
 // All runners need to be registered prior to running the service.
 // The registration is separated from constructor to loosen coupling between services.
@@ -363,6 +372,7 @@ func (s *Service) run(ctx scheduler.RunContext) (runErr error) {
 	if err := s.putRun(r); err != nil {
 		return errors.Wrap(err, "put run")
 	}
+	s.publishRun(ctx, r)
 	s.metrics.BeginRun(ti.ClusterID, ti.TaskType.String(), ti.TaskID)
 
 	runCtx := log.WithTraceID(ctx)
@@ -387,6 +397,7 @@ func (s *Service) run(ctx scheduler.RunContext) (runErr error) {
 		if err := s.putRun(r); err != nil {
 			logger.Error(runCtx, "Cannot update the run", "task", ti, "run", r, "error", err)
 		}
+		s.publishRun(runCtx, r)
 		s.metrics.EndRun(ti.ClusterID, ti.TaskType.String(), ti.TaskID, r.Status.String())
 		if !ti.TaskType.isHealthCheck() {
 			if r.Status == StatusError {
@@ -429,6 +440,20 @@ func (s *Service) putRun(r *Run) error {
 		ExecRelease()
 }
 
+func (s *Service) publishRun(ctx context.Context, r *Run) {
+	if r.Type.isHealthCheck() {
+		return
+	}
+	s.events.Publish(ctx, events.Event{
+		Type:      events.RunStatus,
+		ClusterID: r.ClusterID,
+		TaskType:  r.Type.String(),
+		TaskID:    r.TaskID,
+		RunID:     r.ID,
+		Data:      *r,
+	})
+}
+
 func statusFromError(err error) Status {
 	switch {
 	case err == nil:
@@ -563,6 +588,7 @@ func (s *Service) StopTask(ctx context.Context, t *Task) error {
 	if err := s.updateRunStatus(&r); err != nil {
 		return err
 	}
+	s.publishRun(ctx, &r)
 	l.Stop(ctx, t.ID)
 
 	return nil
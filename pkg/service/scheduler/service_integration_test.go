@@ -295,8 +295,8 @@ func TestServiceScheduleIntegration(t *testing.T) {
 		}
 		for _, ts := range tasks {
 			if ts.ID == task.ID {
-				if ts.Sched != task.Sched {
-					t.Fatalf("Expected task %+v, got %+v", task.Sched, ts.Sched)
+				if diff := cmp.Diff(ts.Sched, task.Sched); diff != "" {
+					t.Fatalf("Expected task %+v, got %+v, diff %s", task.Sched, ts.Sched, diff)
 				}
 			}
 		}
@@ -0,0 +1,136 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"time"
+
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Filter specifies snapshots to list or delete.
+type Filter struct {
+	// Host limits the nodes to the given addresses, all nodes if empty.
+	Host []string
+	// DC limits the nodes to the data centers matching the glob patterns,
+	// all data centers if empty.
+	DC []string
+	// SnapshotTag limits the snapshots to the given tags, all snapshots
+	// if empty.
+	SnapshotTag []string
+	// OlderThan limits the snapshots to the ones taken before the given
+	// time, snapshots with tags that do not encode time are never matched.
+	OlderThan time.Time
+	// All must be set to delete all the snapshots if neither SnapshotTag
+	// nor OlderThan is set.
+	All bool
+}
+
+// match returns true if the snapshot matches the filter.
+func (f Filter) match(s Snapshot) bool {
+	if len(f.SnapshotTag) > 0 && !strset.New(f.SnapshotTag...).Has(s.Tag) {
+		return false
+	}
+	if !f.OlderThan.IsZero() && (s.CreatedAt == nil || !s.CreatedAt.Before(f.OlderThan)) {
+		return false
+	}
+	return true
+}
+
+// Snapshot describes a snapshot on a node.
+type Snapshot struct {
+	Tag       string     `json:"snapshot_tag"`
+	Keyspaces []string   `json:"keyspaces"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Size      int64      `json:"size"`
+	TrueSize  int64      `json:"true_size"`
+}
+
+// NodeSnapshots groups snapshots of a node, TrueSize is the disk space
+// freed on the node if all the snapshots were deleted.
+type NodeSnapshots struct {
+	Host      string     `json:"host"`
+	DC        string     `json:"dc"`
+	Snapshots []Snapshot `json:"snapshots,omitempty"`
+	TrueSize  int64      `json:"true_size"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// Unit represents keyspace and its tables.
+type Unit struct {
+	Keyspace  string   `json:"keyspace"`
+	Tables    []string `json:"tables,omitempty"`
+	AllTables bool     `json:"all_tables"`
+}
+
+// Target specifies what shall be snapshotted and where.
+type Target struct {
+	Units []Unit   `json:"units"`
+	DC    []string `json:"dc"`
+	Hosts []string `json:"hosts"`
+
+	nodes scyllaclient.NodeStatusInfoSlice
+}
+
+// taskProperties is the main data structure of the runner.Properties blob.
+type taskProperties struct {
+	Keyspace []string `json:"keyspace"`
+	DC       []string `json:"dc"`
+}
+
+// Run tracks snapshot progress, shares ID with scheduler.Run that initiated it.
+type Run struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	ID        uuid.UUID
+
+	SnapshotTag string
+	DC          []string
+	StartTime   time.Time
+}
+
+// RunProgress describes snapshot progress of a host.
+type RunProgress struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Host      string
+
+	DC          string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Size        int64
+	Error       string
+}
+
+// progress counts hosts snapshotted.
+type progress struct {
+	Total       int        `json:"total"`
+	Success     int        `json:"success"`
+	Failed      int        `json:"failed"`
+	Size        int64      `json:"size"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// Progress groups snapshot progress for all hosts.
+type Progress struct {
+	progress
+
+	SnapshotTag string         `json:"snapshot_tag"`
+	DC          []string       `json:"dcs,omitempty"`
+	Hosts       []HostProgress `json:"hosts,omitempty"`
+}
+
+// HostProgress defines snapshot progress of a host. It's also published as
+// snapshot progress event.
+type HostProgress struct {
+	Host        string     `json:"host"`
+	DC          string     `json:"dc"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Size        int64      `json:"size"`
+	Error       string     `json:"error,omitempty"`
+}
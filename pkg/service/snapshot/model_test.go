@@ -0,0 +1,68 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/util/pointer"
+)
+
+func TestFilterMatch(t *testing.T) {
+	var (
+		t0  = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		old = Snapshot{Tag: localSnapshotTagAt(t0), CreatedAt: &t0}
+		cur = Snapshot{Tag: localSnapshotTagAt(t0.Add(time.Hour)), CreatedAt: pointer.TimePtr(t0.Add(time.Hour))}
+		unk = Snapshot{Tag: "before_upgrade"}
+	)
+
+	table := []struct {
+		Name     string
+		Filter   Filter
+		Snapshot Snapshot
+		Golden   bool
+	}{
+		{
+			Name:     "Empty filter",
+			Snapshot: unk,
+			Golden:   true,
+		},
+		{
+			Name:     "Tag match",
+			Filter:   Filter{SnapshotTag: []string{"before_upgrade"}},
+			Snapshot: unk,
+			Golden:   true,
+		},
+		{
+			Name:     "Tag mismatch",
+			Filter:   Filter{SnapshotTag: []string{"before_upgrade"}},
+			Snapshot: old,
+		},
+		{
+			Name:     "Older than",
+			Filter:   Filter{OlderThan: t0.Add(time.Minute)},
+			Snapshot: old,
+			Golden:   true,
+		},
+		{
+			Name:     "Not older than",
+			Filter:   Filter{OlderThan: t0.Add(time.Minute)},
+			Snapshot: cur,
+		},
+		{
+			Name:     "Older than unknown time",
+			Filter:   Filter{OlderThan: t0.Add(time.Minute)},
+			Snapshot: unk,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := test.Filter.match(test.Snapshot); v != test.Golden {
+				t.Fatalf("match() = %v, expected %v", v, test.Golden)
+			}
+		})
+	}
+}
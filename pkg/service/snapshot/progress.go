@@ -0,0 +1,65 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"time"
+)
+
+// aggregateProgress lists run progress of hosts, run progress is expected
+// to be sorted by host.
+func aggregateProgress(run *Run, runProgress []*RunProgress) Progress {
+	p := Progress{
+		SnapshotTag: run.SnapshotTag,
+		DC:          run.DC,
+	}
+	for _, rp := range runProgress {
+		p.Hosts = append(p.Hosts, HostProgress{
+			Host:        rp.Host,
+			DC:          rp.DC,
+			StartedAt:   rp.StartedAt,
+			CompletedAt: rp.CompletedAt,
+			Size:        rp.Size,
+			Error:       rp.Error,
+		})
+		p.progress.add(rp.StartedAt, rp.CompletedAt, rp.Size, rp.Error)
+	}
+	p.progress.complete()
+
+	return p
+}
+
+func (p *progress) add(startedAt, completedAt *time.Time, size int64, err string) {
+	p.Total++
+	if completedAt != nil {
+		if err != "" {
+			p.Failed++
+		} else {
+			p.Success++
+		}
+		p.CompletedAt = maxTime(p.CompletedAt, completedAt)
+	}
+	p.Size += size
+	p.StartedAt = minTime(p.StartedAt, startedAt)
+}
+
+// complete clears CompletedAt if not all the hosts are completed.
+func (p *progress) complete() {
+	if p.Total == 0 || p.Success+p.Failed < p.Total {
+		p.CompletedAt = nil
+	}
+}
+
+func minTime(a, b *time.Time) *time.Time {
+	if a == nil || b != nil && b.Before(*a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b *time.Time) *time.Time {
+	if a == nil || b != nil && b.After(*a) {
+		return b
+	}
+	return a
+}
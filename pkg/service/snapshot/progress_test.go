@@ -0,0 +1,46 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAggregateProgress(t *testing.T) {
+	var (
+		t0 = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 = t0.Add(time.Minute)
+		t2 = t0.Add(2 * time.Minute)
+	)
+
+	run := &Run{SnapshotTag: "sm_local_20210101000000UTC", DC: []string{"dc1", "dc2"}}
+	rps := []*RunProgress{
+		{Host: "h1", DC: "dc1", StartedAt: &t0, CompletedAt: &t1, Size: 10},
+		{Host: "h2", DC: "dc1", StartedAt: &t1, CompletedAt: &t2, Error: "error"},
+		{Host: "h3", DC: "dc2", StartedAt: &t1},
+	}
+
+	golden := Progress{
+		progress: progress{
+			Total:     3,
+			Success:   1,
+			Failed:    1,
+			Size:      10,
+			StartedAt: &t0,
+		},
+		SnapshotTag: "sm_local_20210101000000UTC",
+		DC:          []string{"dc1", "dc2"},
+		Hosts: []HostProgress{
+			{Host: "h1", DC: "dc1", StartedAt: &t0, CompletedAt: &t1, Size: 10},
+			{Host: "h2", DC: "dc1", StartedAt: &t1, CompletedAt: &t2, Error: "error"},
+			{Host: "h3", DC: "dc2", StartedAt: &t1},
+		},
+	}
+
+	if diff := cmp.Diff(aggregateProgress(run, rps), golden, cmp.AllowUnexported(Progress{})); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Runner implements scheduler.Runner.
+type Runner struct {
+	service *Service
+}
+
+func (r Runner) Run(ctx context.Context, clusterID, taskID, runID uuid.UUID, properties json.RawMessage) error {
+	t, err := r.service.GetTarget(ctx, clusterID, properties)
+	if err != nil {
+		return errors.Wrap(err, "get snapshot target")
+	}
+
+	return r.service.Snapshot(ctx, clusterID, taskID, runID, t)
+}
@@ -0,0 +1,526 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/dcfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/ksfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/pkg/util/pointer"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+const systemSchema = "system_schema"
+
+// Service manages snapshots kept on nodes and takes local snapshots,
+// local snapshots are not uploaded to backup locations.
+type Service struct {
+	session gocqlx.Session
+
+	scyllaClient scyllaclient.ProviderFunc
+	events       events.Publisher
+	logger       log.Logger
+}
+
+func NewService(session gocqlx.Session, scyllaClient scyllaclient.ProviderFunc, logger log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	if scyllaClient == nil {
+		return nil, errors.New("invalid scylla provider")
+	}
+
+	return &Service{
+		session:      session,
+		scyllaClient: scyllaClient,
+		events:       events.NopPublisher,
+		logger:       logger,
+	}, nil
+}
+
+// SetEventPublisher sets publisher that would be notified on snapshot
+// progress changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
+// Runner creates a Runner that handles local snapshots.
+func (s *Service) Runner() Runner {
+	return Runner{service: s}
+}
+
+// List returns snapshots matching the filter grouped by node. Errors
+// specific to a node, i.e. node being down, are reported in the
+// NodeSnapshots.Error field.
+func (s *Service) List(ctx context.Context, clusterID uuid.UUID, filter Filter) ([]NodeSnapshots, error) {
+	s.logger.Debug(ctx, "List", "cluster_id", clusterID, "filter", filter)
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client")
+	}
+	nodes, err := s.filterNodes(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]NodeSnapshots, len(nodes))
+	_ = parallel.Run(len(nodes), parallel.NoLimit, func(i int) error { // nolint: errcheck
+		n := nodes[i]
+		out[i] = NodeSnapshots{
+			Host: n.Addr,
+			DC:   n.Datacenter,
+		}
+		if n.Status != scyllaclient.NodeStatusUp {
+			out[i].Error = "node is down"
+			return nil
+		}
+		if err := s.listNodeSnapshots(ctx, client, &out[i], filter); err != nil {
+			out[i].Error = err.Error()
+		}
+		return nil
+	})
+
+	return out, nil
+}
+
+func (s *Service) filterNodes(ctx context.Context, client *scyllaclient.Client, filter Filter) (scyllaclient.NodeStatusInfoSlice, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get status")
+	}
+
+	dcMap, err := client.Datacenters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "read datacenters")
+	}
+	dcs, err := dcfilter.Apply(dcMap, filter.DC)
+	if err != nil {
+		return nil, service.ErrValidate(err)
+	}
+	nodes := status.Datacenter(dcs)
+
+	if len(filter.Host) > 0 {
+		hosts := strset.New(filter.Host...)
+		var v scyllaclient.NodeStatusInfoSlice
+		for _, n := range nodes {
+			if hosts.Has(n.Addr) {
+				v = append(v, n)
+			}
+		}
+		if len(v) == 0 {
+			return nil, service.ErrValidate(errors.Errorf("no matching hosts found for %s", strings.Join(filter.Host, ", ")))
+		}
+		nodes = v
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Addr < nodes[j].Addr
+	})
+
+	return nodes, nil
+}
+
+func (s *Service) listNodeSnapshots(ctx context.Context, client *scyllaclient.Client, ns *NodeSnapshots, filter Filter) error {
+	snapshots, err := client.SnapshotsInfo(ctx, ns.Host)
+	if err != nil {
+		return errors.Wrap(err, "list snapshots")
+	}
+	for _, si := range snapshots {
+		v := Snapshot{
+			Tag:       si.Tag,
+			Keyspaces: si.Keyspaces,
+			Size:      si.Size,
+			TrueSize:  si.TrueSize,
+		}
+		if t, ok := snapshotTagTime(si.Tag); ok {
+			v.CreatedAt = &t
+		}
+		if filter.match(v) {
+			ns.Snapshots = append(ns.Snapshots, v)
+		}
+	}
+
+	if ns.TrueSize, err = client.SnapshotsTrueSize(ctx, ns.Host); err != nil {
+		return errors.Wrap(err, "get snapshots true size")
+	}
+	return nil
+}
+
+// Delete deletes snapshots matching the filter and returns the deleted
+// snapshots grouped by node. The filter must specify snapshot tags, age
+// or explicitly select all the snapshots. Errors specific to a node are
+// reported in the NodeSnapshots.Error field.
+func (s *Service) Delete(ctx context.Context, clusterID uuid.UUID, filter Filter) ([]NodeSnapshots, error) {
+	s.logger.Info(ctx, "Deleting snapshots", "cluster_id", clusterID, "filter", filter)
+
+	if len(filter.SnapshotTag) == 0 && filter.OlderThan.IsZero() && !filter.All {
+		return nil, service.ErrValidate(errors.New("specify snapshot tags, older than or all"))
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client")
+	}
+
+	out, err := s.List(ctx, clusterID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = parallel.Run(len(out), parallel.NoLimit, func(i int) error { // nolint: errcheck
+		ns := &out[i]
+		if ns.Error != "" {
+			return nil
+		}
+
+		var (
+			deleted []Snapshot
+			errs    error
+		)
+		for _, v := range ns.Snapshots {
+			if err := client.DeleteSnapshot(ctx, ns.Host, v.Tag); err != nil {
+				errs = multierr.Append(errs, errors.Wrapf(err, "delete snapshot %s", v.Tag))
+				continue
+			}
+			s.logger.Info(ctx, "Deleted snapshot", "host", ns.Host, "snapshot_tag", v.Tag)
+			deleted = append(deleted, v)
+		}
+		ns.Snapshots = deleted
+		if errs != nil {
+			ns.Error = errs.Error()
+		}
+
+		if ns.TrueSize, err = client.SnapshotsTrueSize(ctx, ns.Host); err != nil {
+			s.logger.Error(ctx, "Failed to get snapshots true size", "host", ns.Host, "error", err)
+		}
+		return nil
+	})
+
+	return out, nil
+}
+
+// GetTarget converts runner properties into snapshot Target.
+func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (Target, error) {
+	s.logger.Info(ctx, "Generating snapshot target", "cluster_id", clusterID)
+
+	p := taskProperties{}
+	t := Target{}
+
+	if err := json.Unmarshal(properties, &p); err != nil {
+		return t, service.ErrValidate(err)
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return t, errors.Wrapf(err, "get client")
+	}
+
+	// Filter DCs
+	dcMap, err := client.Datacenters(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "read datacenters")
+	}
+	if t.DC, err = dcfilter.Apply(dcMap, p.DC); err != nil {
+		return t, err
+	}
+
+	// Filter keyspaces
+	f, err := ksfilter.NewFilter(p.Keyspace)
+	if err != nil {
+		return t, err
+	}
+	keyspaces, err := client.Keyspaces(ctx)
+	if err != nil {
+		return t, errors.Wrapf(err, "read keyspaces")
+	}
+
+	// Always snapshot system_schema, see backup.
+	systemSchemaUnit := Unit{
+		Keyspace:  systemSchema,
+		AllTables: true,
+	}
+
+	for _, keyspace := range keyspaces {
+		tables, err := client.Tables(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get tables", keyspace)
+		}
+
+		// Get the ring description and skip local system data
+		ring, err := client.DescribeRing(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get ring description", keyspace)
+		}
+		if ring.Replication == scyllaclient.LocalStrategy &&
+			strings.HasPrefix(keyspace, "system") && keyspace != systemSchema {
+			continue
+		}
+
+		if keyspace == systemSchema {
+			systemSchemaUnit.Tables = tables
+		} else {
+			f.Add(keyspace, tables)
+		}
+	}
+
+	// Get the filtered units
+	v, err := f.Apply(false)
+	if err != nil {
+		return t, err
+	}
+	for _, u := range v {
+		t.Units = append(t.Units, Unit{
+			Keyspace:  u.Keyspace,
+			Tables:    u.Tables,
+			AllTables: u.AllTables,
+		})
+	}
+	t.Units = append(t.Units, systemSchemaUnit)
+
+	// Get nodes, snapshot requires all of them to be up
+	status, err := client.Status(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "get status")
+	}
+	t.nodes = status.Datacenter(t.DC)
+	if down := t.nodes.Down(); len(down) > 0 {
+		return t, service.ErrValidate(errors.Errorf("nodes are down: %s", strings.Join(down.Hosts(), ", ")))
+	}
+	t.Hosts = t.nodes.Hosts()
+
+	return t, nil
+}
+
+// Snapshot takes a local snapshot of the target on all the target nodes in
+// parallel. The snapshot is kept on the nodes only and is not uploaded.
+func (s *Service) Snapshot(ctx context.Context, clusterID, taskID, runID uuid.UUID, target Target) error {
+	s.logger.Debug(ctx, "Snapshot",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"target", target,
+	)
+
+	run := &Run{
+		ClusterID:   clusterID,
+		TaskID:      taskID,
+		ID:          runID,
+		SnapshotTag: NewLocalSnapshotTag(),
+		DC:          target.DC,
+		StartTime:   timeutc.Now().UTC(),
+	}
+
+	// Get the cluster client
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return errors.Wrap(err, "get client proxy")
+	}
+
+	if len(target.nodes) == 0 {
+		return errors.New("no nodes to snapshot")
+	}
+
+	// Register the run
+	if err := s.putRun(run); err != nil {
+		return errors.Wrap(err, "register the run")
+	}
+
+	// Register progress of all the hosts
+	rps := make([]*RunProgress, len(target.nodes))
+	for i, n := range target.nodes {
+		rps[i] = &RunProgress{
+			ClusterID: clusterID,
+			TaskID:    taskID,
+			RunID:     runID,
+			Host:      n.Addr,
+			DC:        n.Datacenter,
+		}
+		if err := s.putRunProgress(ctx, rps[i]); err != nil {
+			return errors.Wrap(err, "register the run progress")
+		}
+	}
+
+	s.logger.Info(ctx, "Snapshot started",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"snapshot_tag", run.SnapshotTag,
+	)
+
+	err = parallel.Run(len(rps), parallel.NoLimit, func(i int) error {
+		return errors.Wrapf(s.snapshotHost(ctx, client, run.SnapshotTag, target.Units, rps[i]), "%s", rps[i].Host)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "Snapshot done",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"snapshot_tag", run.SnapshotTag,
+	)
+
+	return nil
+}
+
+// snapshotHost takes snapshot of the units on a host and records the
+// snapshot size.
+func (s *Service) snapshotHost(ctx context.Context, client *scyllaclient.Client, tag string, units []Unit, rp *RunProgress) error {
+	rp.StartedAt = pointer.TimePtr(timeutc.Now())
+	s.onRunProgress(ctx, rp)
+
+	err := s.takeSnapshot(ctx, client, rp.Host, tag, units)
+	if err == nil {
+		rp.Size, err = snapshotSize(ctx, client, rp.Host, tag)
+	}
+
+	rp.CompletedAt = pointer.TimePtr(timeutc.Now())
+	if err != nil {
+		rp.Error = err.Error()
+		s.logger.Error(ctx, "Snapshot failed", "host", rp.Host, "error", err)
+	} else {
+		s.logger.Info(ctx, "Done taking snapshot", "host", rp.Host, "size", rp.Size)
+	}
+	s.onRunProgress(ctx, rp)
+
+	return err
+}
+
+func (s *Service) takeSnapshot(ctx context.Context, client *scyllaclient.Client, host, tag string, units []Unit) error {
+	for _, u := range units {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var tables []string
+		if !u.AllTables {
+			tables = u.Tables
+		}
+
+		s.logger.Info(ctx, "Taking snapshot", "host", host, "keyspace", u.Keyspace, "snapshot_tag", tag)
+		if err := client.TakeSnapshot(ctx, host, tag, u.Keyspace, tables...); err != nil {
+			return errors.Wrapf(err, "keyspace %s", u.Keyspace)
+		}
+	}
+	return nil
+}
+
+func snapshotSize(ctx context.Context, client *scyllaclient.Client, host, tag string) (int64, error) {
+	snapshots, err := client.SnapshotsInfo(ctx, host)
+	if err != nil {
+		return 0, errors.Wrap(err, "list snapshots")
+	}
+	for _, si := range snapshots {
+		if si.Tag == tag {
+			return si.Size, nil
+		}
+	}
+	return 0, errors.Errorf("snapshot %s not found", tag)
+}
+
+func (s *Service) onRunProgress(ctx context.Context, rp *RunProgress) {
+	if err := s.putRunProgress(ctx, rp); err != nil {
+		s.logger.Error(ctx, "Failed to update snapshot progress", "error", err)
+	}
+	s.publishRunProgress(ctx, rp)
+}
+
+// putRun upserts a snapshot run.
+func (s *Service) putRun(r *Run) error {
+	return table.SnapshotRun.InsertQuery(s.session).BindStruct(r).ExecRelease()
+}
+
+// putRunProgress upserts a snapshot run progress.
+func (s *Service) putRunProgress(ctx context.Context, p *RunProgress) error {
+	s.logger.Debug(ctx, "PutRunProgress", "run_progress", p)
+
+	return table.SnapshotRunProgress.InsertQuery(s.session).BindStruct(p).ExecRelease()
+}
+
+// publishRunProgress sends snapshot progress event for a host.
+func (s *Service) publishRunProgress(ctx context.Context, p *RunProgress) {
+	s.events.Publish(ctx, events.Event{
+		Type:      events.SnapshotProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "snapshot",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: HostProgress{
+			Host:        p.Host,
+			DC:          p.DC,
+			StartedAt:   p.StartedAt,
+			CompletedAt: p.CompletedAt,
+			Size:        p.Size,
+			Error:       p.Error,
+		},
+	})
+}
+
+func (s *Service) getRunProgress(clusterID, taskID, runID uuid.UUID) ([]*RunProgress, error) {
+	q := table.SnapshotRunProgress.SelectQuery(s.session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+		"run_id":     runID,
+	})
+	var v []*RunProgress
+	return v, q.SelectRelease(&v)
+}
+
+// GetRun returns a run based on ID. If nothing was found scylla-manager.ErrNotFound
+// is returned.
+func (s *Service) GetRun(ctx context.Context, clusterID, taskID, runID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	q := table.SnapshotRun.GetQuery(s.session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+		"id":         runID,
+	})
+
+	var r Run
+	return &r, q.GetRelease(&r)
+}
+
+// GetProgress aggregates progress for the run of the task and breaks it down
+// by host.
+// If nothing was found scylla-manager.ErrNotFound is returned.
+func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (Progress, error) {
+	s.logger.Debug(ctx, "GetProgress",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	run, err := s.GetRun(ctx, clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	rps, err := s.getRunProgress(clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	return aggregateProgress(run, rps), nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/scylladb/scylla-manager/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+)
+
+// Local snapshots use a tag format different from backup snapshot tags so
+// that they are not deleted by backups as old backup snapshots.
+var (
+	localTagDateFormat = "20060102150405"
+	localTagRegexp     = regexp.MustCompile("^sm_local_([0-9]{14})UTC$")
+	// epochMillisTagRegexp matches the default nodetool snapshot tag.
+	epochMillisTagRegexp = regexp.MustCompile("^[0-9]{13}$")
+)
+
+// NewLocalSnapshotTag creates new local snapshot tag for the current time.
+func NewLocalSnapshotTag() string {
+	return localSnapshotTagAt(timeutc.Now())
+}
+
+// localSnapshotTagAt creates new local snapshot tag for specified time.
+func localSnapshotTagAt(t time.Time) string {
+	return "sm_local_" + t.UTC().Format(localTagDateFormat) + "UTC"
+}
+
+// snapshotTagTime returns time encoded in the snapshot tag, it supports
+// backup and local snapshot tags and the default nodetool snapshot tags.
+// It returns false if time is not known.
+func snapshotTagTime(tag string) (time.Time, bool) {
+	if backupspec.IsSnapshotTag(tag) {
+		t, err := backupspec.SnapshotTagTime(tag)
+		return t, err == nil
+	}
+	if m := localTagRegexp.FindStringSubmatch(tag); m != nil {
+		t, err := timeutc.Parse(localTagDateFormat, m[1])
+		return t, err == nil
+	}
+	if epochMillisTagRegexp.MatchString(tag) {
+		ms, err := strconv.ParseInt(tag, 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(0, ms*int64(time.Millisecond)).UTC(), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2017 ScyllaDB
+
+package snapshot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshotTagTime(t *testing.T) {
+	t0 := time.Date(2021, 5, 17, 10, 30, 0, 0, time.UTC)
+
+	table := []struct {
+		Name   string
+		Tag    string
+		Golden time.Time
+		OK     bool
+	}{
+		{
+			Name:   "Backup",
+			Tag:    "sm_20210517103000UTC",
+			Golden: t0,
+			OK:     true,
+		},
+		{
+			Name:   "Local",
+			Tag:    localSnapshotTagAt(t0),
+			Golden: t0,
+			OK:     true,
+		},
+		{
+			Name:   "Nodetool",
+			Tag:    "1621247400000",
+			Golden: t0,
+			OK:     true,
+		},
+		{
+			Name: "Custom",
+			Tag:  "before_upgrade",
+		},
+		{
+			Name: "Invalid date",
+			Tag:  "sm_local_20219999999999UTC",
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			v, ok := snapshotTagTime(test.Tag)
+			if ok != test.OK {
+				t.Fatalf("snapshotTagTime() ok = %v, expected %v", ok, test.OK)
+			}
+			if !v.Equal(test.Golden) {
+				t.Fatalf("snapshotTagTime() = %s, expected %s", v, test.Golden)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2017 ScyllaDB
+
+package sstablemaintenance
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+)
+
+// Mode specifies the SSTable maintenance operation.
+type Mode string
+
+// Mode enumeration.
+const (
+	// Scrub scrubs SSTables, see ScrubMode for handling of corrupted data.
+	Scrub Mode = "scrub"
+	// Upgrade rewrites SSTables to the current SSTable format.
+	Upgrade Mode = "upgrade"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+func (m Mode) MarshalText() (text []byte, err error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Mode) UnmarshalText(text []byte) error {
+	switch Mode(text) {
+	case Scrub:
+		*m = Scrub
+	case Upgrade:
+		*m = Upgrade
+	default:
+		return errors.Errorf("unrecognized mode %s, expected scrub or upgrade", text)
+	}
+	return nil
+}
+
+// ScrubMode specifies how scrub handles corrupted data.
+type ScrubMode string
+
+// ScrubMode enumeration.
+const (
+	ScrubAbort     ScrubMode = "abort"
+	ScrubSkip      ScrubMode = "skip"
+	ScrubSegregate ScrubMode = "segregate"
+	ScrubValidate  ScrubMode = "validate"
+)
+
+func (m ScrubMode) String() string {
+	return string(m)
+}
+
+func (m ScrubMode) MarshalText() (text []byte, err error) {
+	return []byte(m.String()), nil
+}
+
+func (m *ScrubMode) UnmarshalText(text []byte) error {
+	switch ScrubMode(text) {
+	case ScrubAbort:
+		*m = ScrubAbort
+	case ScrubSkip:
+		*m = ScrubSkip
+	case ScrubSegregate:
+		*m = ScrubSegregate
+	case ScrubValidate:
+		*m = ScrubValidate
+	default:
+		return errors.Errorf("unrecognized scrub mode %s, expected abort, skip, segregate or validate", text)
+	}
+	return nil
+}
+
+func (m ScrubMode) scyllaMode() scyllaclient.ScrubMode {
+	return scyllaclient.ScrubMode(strings.ToUpper(string(m)))
+}
+
+// Unit represents keyspace and its tables.
+type Unit struct {
+	Keyspace  string   `json:"keyspace"`
+	Tables    []string `json:"tables,omitempty"`
+	AllTables bool     `json:"all_tables"`
+}
+
+// Target specifies what shall be processed and how.
+type Target struct {
+	Units              []Unit           `json:"units"`
+	DC                 []string         `json:"dc"`
+	Hosts              []string         `json:"hosts"`
+	Mode               Mode             `json:"mode"`
+	ScrubMode          ScrubMode        `json:"scrub_mode,omitempty"`
+	IncludeAllSSTables bool             `json:"include_all_sstables,omitempty"`
+	Snapshot           bool             `json:"snapshot"`
+	Parallel           []backup.DCLimit `json:"parallel"`
+	Continue           bool             `json:"continue"`
+
+	nodes scyllaclient.NodeStatusInfoSlice
+}
+
+// rewritesSSTables returns true if the operation modifies SSTables, scrub in
+// the validate mode only reads them.
+func (t Target) rewritesSSTables() bool {
+	return t.Mode != Scrub || t.ScrubMode != ScrubValidate
+}
+
+// taskProperties is the main data structure of the runner.Properties blob.
+type taskProperties struct {
+	Keyspace           []string         `json:"keyspace"`
+	DC                 []string         `json:"dc"`
+	Mode               Mode             `json:"mode"`
+	ScrubMode          ScrubMode        `json:"scrub_mode"`
+	IncludeAllSSTables bool             `json:"include_all_sstables"`
+	Snapshot           bool             `json:"snapshot"`
+	Parallel           []backup.DCLimit `json:"parallel"`
+	Continue           bool             `json:"continue"`
+}
+
+func defaultTaskProperties() taskProperties {
+	return taskProperties{
+		ScrubMode: ScrubAbort,
+		Snapshot:  true,
+		Continue:  true,
+	}
+}
+
+// Run tracks SSTable maintenance progress, shares ID with scheduler.Run that
+// initiated it.
+type Run struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	ID        uuid.UUID
+
+	PrevID      uuid.UUID
+	DC          []string
+	Mode        string
+	ScrubMode   string
+	SnapshotTag string
+	StartTime   time.Time
+}
+
+// RunProgress describes SSTable maintenance progress of a keyspace on a host.
+type RunProgress struct {
+	ClusterID uuid.UUID
+	TaskID    uuid.UUID
+	RunID     uuid.UUID
+	Host      string
+	Keyspace  string `db:"keyspace_name"`
+
+	DC          string
+	SnapshotTag string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Error       string
+}
+
+// Done returns true if processing of the keyspace on the host succeeded.
+func (p *RunProgress) Done() bool {
+	return p.CompletedAt != nil && p.Error == ""
+}
+
+// progress counts keyspaces processed on hosts.
+type progress = nodeops.Progress
+
+// Progress groups SSTable maintenance progress for all hosts.
+type Progress struct {
+	progress
+
+	Mode      string         `json:"mode"`
+	ScrubMode string         `json:"scrub_mode,omitempty"`
+	DC        []string       `json:"dcs,omitempty"`
+	Hosts     []HostProgress `json:"hosts,omitempty"`
+}
+
+// HostProgress groups SSTable maintenance progress for keyspaces belonging
+// to this host.
+type HostProgress struct {
+	progress
+
+	Host      string             `json:"host"`
+	DC        string             `json:"dc"`
+	Keyspaces []KeyspaceProgress `json:"keyspaces,omitempty"`
+}
+
+// KeyspaceProgress defines SSTable maintenance progress for the keyspace
+// on a host.
+type KeyspaceProgress struct {
+	Keyspace    string     `json:"keyspace"`
+	SnapshotTag string     `json:"snapshot_tag,omitempty"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// HostKeyspaceProgress defines progress for the keyspace on a host.
+// It's published as SSTable maintenance progress event.
+type HostKeyspaceProgress struct {
+	Host string `json:"host"`
+	DC   string `json:"dc"`
+	KeyspaceProgress
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2017 ScyllaDB
+
+package sstablemaintenance
+
+import (
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+)
+
+// aggregateProgress groups run progress by host, run progress is expected
+// to be sorted by host.
+func aggregateProgress(run *Run, runProgress []*RunProgress) Progress {
+	p := Progress{
+		Mode:      run.Mode,
+		ScrubMode: run.ScrubMode,
+		DC:        run.DC,
+	}
+
+	for _, r := range nodeops.GroupByHost(len(runProgress), func(i int) string { return runProgress[i].Host }) {
+		hp := HostProgress{
+			Host: r.Host,
+			DC:   runProgress[r.Start].DC,
+		}
+		for _, rp := range runProgress[r.Start:r.End] {
+			hp.Keyspaces = append(hp.Keyspaces, KeyspaceProgress{
+				Keyspace:    rp.Keyspace,
+				SnapshotTag: rp.SnapshotTag,
+				StartedAt:   rp.StartedAt,
+				CompletedAt: rp.CompletedAt,
+				Error:       rp.Error,
+			})
+			hp.progress.Add(rp.StartedAt, rp.CompletedAt, rp.Error)
+		}
+		hp.progress.Complete()
+		p.progress.Merge(hp.progress)
+		p.Hosts = append(p.Hosts, hp)
+	}
+	p.progress.Complete()
+
+	return p
+}
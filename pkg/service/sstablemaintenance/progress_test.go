@@ -0,0 +1,22 @@
+// Copyright (C) 2017 ScyllaDB
+
+package sstablemaintenance
+
+import (
+	"testing"
+)
+
+func TestAggregateProgressMode(t *testing.T) {
+	run := &Run{DC: []string{"dc1"}, Mode: "scrub", ScrubMode: "skip"}
+	rps := []*RunProgress{
+		{Host: "h1", Keyspace: "ks1", SnapshotTag: "sm_local_20210101000000UTC"},
+	}
+
+	p := aggregateProgress(run, rps)
+	if p.Mode != run.Mode || p.ScrubMode != run.ScrubMode {
+		t.Errorf("Mode = %s, ScrubMode = %s, expected %s, %s", p.Mode, p.ScrubMode, run.Mode, run.ScrubMode)
+	}
+	if v := p.Hosts[0].Keyspaces[0].SnapshotTag; v != rps[0].SnapshotTag {
+		t.Errorf("SnapshotTag = %s, expected %s", v, rps[0].SnapshotTag)
+	}
+}
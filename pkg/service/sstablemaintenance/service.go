@@ -0,0 +1,539 @@
+// Copyright (C) 2017 ScyllaDB
+
+package sstablemaintenance
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/pkg/events"
+	"github.com/scylladb/scylla-manager/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/pkg/service"
+	"github.com/scylladb/scylla-manager/pkg/service/internal/nodeops"
+	"github.com/scylladb/scylla-manager/pkg/service/snapshot"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/dcfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/inexlist/ksfilter"
+	"github.com/scylladb/scylla-manager/pkg/util/pointer"
+	"github.com/scylladb/scylla-manager/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+// Config specifies the SSTable maintenance service configuration. Scrub and
+// SSTables upgrade rewrite SSTables and need space for the rewritten files
+// and the snapshot, DiskSpaceFreeMinPercent is checked on every node before
+// the operation starts. It's not checked in the scrub validate mode.
+type Config = nodeops.Config
+
+// DefaultConfig returns default configuration of the SSTable maintenance service.
+func DefaultConfig() Config {
+	return nodeops.DefaultConfig()
+}
+
+// Service orchestrates SSTable scrub and upgrade, operations on a host are
+// serialized, keyspaces are processed one by one.
+type Service struct {
+	runs   nodeops.RunStore
+	config Config
+
+	scyllaClient scyllaclient.ProviderFunc
+	events       events.Publisher
+	logger       log.Logger
+}
+
+func NewService(session gocqlx.Session, config Config, scyllaClient scyllaclient.ProviderFunc, logger log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if scyllaClient == nil {
+		return nil, errors.New("invalid scylla provider")
+	}
+
+	return &Service{
+		runs: nodeops.RunStore{
+			Session:     session,
+			Run:         table.SSTableMaintenanceRun,
+			RunProgress: table.SSTableMaintenanceRunProgress,
+		},
+		config:       config,
+		scyllaClient: scyllaClient,
+		events:       events.NopPublisher,
+		logger:       logger,
+	}, nil
+}
+
+// SetEventPublisher sets publisher that would be notified on SSTable
+// maintenance progress changes. It needs to be called prior to running the service.
+func (s *Service) SetEventPublisher(p events.Publisher) {
+	s.events = p
+}
+
+// Runner creates a Runner that handles SSTable maintenance.
+func (s *Service) Runner() nodeops.Runner {
+	return nodeops.Runner{
+		Operation: "sstable maintenance",
+		GetTarget: func(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (interface{}, error) {
+			return s.GetTarget(ctx, clusterID, properties)
+		},
+		RunTarget: func(ctx context.Context, clusterID, taskID, runID uuid.UUID, target interface{}) error {
+			return s.SSTableMaintenance(ctx, clusterID, taskID, runID, target.(Target))
+		},
+	}
+}
+
+// GetTarget converts runner properties into SSTable maintenance Target.
+func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (Target, error) {
+	s.logger.Info(ctx, "Generating sstable maintenance target", "cluster_id", clusterID)
+
+	p := defaultTaskProperties()
+	t := Target{}
+
+	if err := json.Unmarshal(properties, &p); err != nil {
+		return t, service.ErrValidate(err)
+	}
+	if p.Mode == "" {
+		return t, service.ErrValidate(errors.New("missing mode, expected scrub or upgrade"))
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return t, errors.Wrapf(err, "get client")
+	}
+
+	// Get hosts in DCs
+	dcMap, err := client.Datacenters(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "read datacenters")
+	}
+
+	// Validate parallel DCs
+	for _, l := range p.Parallel {
+		if _, ok := dcMap[l.DC]; l.DC != "" && !ok {
+			return t, service.ErrValidate(errors.Errorf("invalid parallel: no such datacenter %s", l.DC))
+		}
+	}
+
+	// Copy simple properties
+	t.Mode = p.Mode
+	if t.Mode == Scrub {
+		t.ScrubMode = p.ScrubMode
+	} else {
+		t.IncludeAllSSTables = p.IncludeAllSSTables
+	}
+	t.Snapshot = p.Snapshot && t.rewritesSSTables()
+	t.Continue = p.Continue
+
+	// Filter DCs
+	if t.DC, err = dcfilter.Apply(dcMap, p.DC); err != nil {
+		return t, err
+	}
+	t.Parallel = nodeops.FilterDCLimits(p.Parallel, t.DC)
+
+	targetDCs := strset.New(t.DC...)
+
+	// Filter keyspaces
+	f, err := ksfilter.NewFilter(p.Keyspace)
+	if err != nil {
+		return t, err
+	}
+	keyspaces, err := client.Keyspaces(ctx)
+	if err != nil {
+		return t, errors.Wrapf(err, "read keyspaces")
+	}
+	for _, keyspace := range keyspaces {
+		// Get the ring description and skip local data
+		ring, err := client.DescribeRing(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get ring description", keyspace)
+		}
+		if ring.Replication == scyllaclient.LocalStrategy {
+			continue
+		}
+		// Check if keyspace has replica in any DC
+		if !targetDCs.HasAny(ring.Datacenters()...) {
+			continue
+		}
+
+		tables, err := client.Tables(ctx, keyspace)
+		if err != nil {
+			return t, errors.Wrapf(err, "keyspace %s: get tables", keyspace)
+		}
+		f.Add(keyspace, tables)
+	}
+
+	// Get the filtered units
+	v, err := f.Apply(false)
+	if err != nil {
+		return t, err
+	}
+	for _, u := range v {
+		t.Units = append(t.Units, Unit{
+			Keyspace:  u.Keyspace,
+			Tables:    u.Tables,
+			AllTables: u.AllTables,
+		})
+	}
+
+	// Get nodes, SSTable maintenance requires all of them to be up
+	status, err := client.Status(ctx)
+	if err != nil {
+		return t, errors.Wrap(err, "get status")
+	}
+	t.nodes = status.Datacenter(t.DC)
+	if down := t.nodes.Down(); len(down) > 0 {
+		return t, service.ErrValidate(errors.Errorf("nodes are down: %s", strings.Join(down.Hosts(), ", ")))
+	}
+	t.Hosts = t.nodes.Hosts()
+
+	return t, nil
+}
+
+// SSTableMaintenance executes scrub or SSTables upgrade on a given target.
+// Before a keyspace is processed on a host a snapshot of the keyspace is
+// taken unless disabled, the snapshot tag is recorded in the progress.
+func (s *Service) SSTableMaintenance(ctx context.Context, clusterID, taskID, runID uuid.UUID, target Target) error {
+	s.logger.Debug(ctx, "SSTableMaintenance",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"target", target,
+	)
+
+	run := &Run{
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		ID:        runID,
+		DC:        target.DC,
+		Mode:      target.Mode.String(),
+		ScrubMode: target.ScrubMode.String(),
+		StartTime: timeutc.Now().UTC(),
+	}
+	if target.Snapshot {
+		run.SnapshotTag = snapshot.NewLocalSnapshotTag()
+	}
+
+	// Get the cluster client
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return errors.Wrap(err, "get client proxy")
+	}
+
+	if len(target.nodes) == 0 {
+		return errors.New("no nodes to process")
+	}
+
+	// Check if there is enough space to rewrite SSTables
+	if target.rewritesSSTables() {
+		if err := nodeops.CheckAvailableDiskSpace(ctx, client, target.nodes.Hosts(), s.config.DiskSpaceFreeMinPercent, s.logger); err != nil {
+			return err
+		}
+	}
+
+	// Get progress of the previous run if it can be continued
+	var done map[string]*RunProgress
+	if target.Continue {
+		prev, err := s.GetLastResumableRun(ctx, clusterID, taskID)
+		if err != nil && !errors.Is(err, service.ErrNotFound) {
+			return errors.Wrap(err, "get previous run")
+		}
+		if prev != nil {
+			s.logger.Info(ctx, "Resuming previous run", "prev_run_id", prev.ID)
+			run.PrevID = prev.ID
+			if done, err = s.doneRunProgress(prev); err != nil {
+				return errors.Wrap(err, "get previous run progress")
+			}
+		}
+	}
+
+	// Register the run
+	if err := s.putRun(run); err != nil {
+		return errors.Wrap(err, "register the run")
+	}
+
+	// Register progress of all the host keyspaces
+	hostProgress := make(map[string][]*RunProgress, len(target.nodes))
+	for _, n := range target.nodes {
+		for _, u := range target.Units {
+			rp, ok := done[progressKey(n.Addr, u.Keyspace)]
+			if ok {
+				rp.RunID = runID
+			} else {
+				rp = &RunProgress{
+					ClusterID: clusterID,
+					TaskID:    taskID,
+					RunID:     runID,
+					Host:      n.Addr,
+					Keyspace:  u.Keyspace,
+					DC:        n.Datacenter,
+				}
+			}
+			if err := s.putRunProgress(ctx, rp); err != nil {
+				return errors.Wrap(err, "register the run progress")
+			}
+			hostProgress[n.Addr] = append(hostProgress[n.Addr], rp)
+		}
+	}
+
+	s.logger.Info(ctx, "SSTable maintenance started",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+		"prev_run_id", run.PrevID,
+		"mode", target.Mode,
+		"scrub_mode", target.ScrubMode,
+		"snapshot_tag", run.SnapshotTag,
+	)
+
+	err = nodeops.InParallelPerDC(target.nodes, target.Parallel, func(host string) error {
+		return s.processHost(ctx, client, target, run.SnapshotTag, hostProgress[host])
+	})
+	// Report window end or stop as is so that the run is continued
+	if ctx.Err() != nil {
+		s.logger.Info(ctx, "SSTable maintenance interrupted", "run_id", runID, "error", err)
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "SSTable maintenance done",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	return nil
+}
+
+func progressKey(host, keyspace string) string {
+	return host + "/" + keyspace
+}
+
+// processHost runs the operation on keyspaces of a host one by one, keyspaces
+// processed in the previous run are skipped.
+func (s *Service) processHost(ctx context.Context, client *scyllaclient.Client, target Target, snapshotTag string, progress []*RunProgress) error {
+	var errs error
+	for i, u := range target.Units {
+		rp := progress[i]
+		if rp.Done() {
+			s.logger.Info(ctx, "Skipping keyspace processed in previous run", "host", rp.Host, "keyspace", u.Keyspace)
+			continue
+		}
+		if ctx.Err() != nil {
+			return multierr.Append(errs, ctx.Err())
+		}
+
+		var tables []string
+		if !u.AllTables {
+			tables = u.Tables
+		}
+
+		rp.StartedAt = pointer.TimePtr(timeutc.Now())
+		rp.CompletedAt = nil
+		rp.SnapshotTag = ""
+		rp.Error = ""
+		s.onRunProgress(ctx, rp)
+
+		err := s.processKeyspace(ctx, client, target, snapshotTag, rp, tables)
+		if ctx.Err() != nil {
+			// Do not let the operation run in the background after the
+			// window ends or the run is stopped, the keyspace is processed
+			// again in the next run.
+			s.stopCompaction(ctx, client, rp.Host, target.Mode)
+			rp.StartedAt = nil
+			s.onRunProgress(ctx, rp)
+			return multierr.Append(errs, ctx.Err())
+		}
+
+		rp.CompletedAt = pointer.TimePtr(timeutc.Now())
+		if err != nil {
+			rp.Error = err.Error()
+			errs = multierr.Append(errs, errors.Wrapf(err, "keyspace %s", u.Keyspace))
+			s.logger.Error(ctx, "SSTable maintenance failed", "host", rp.Host, "keyspace", u.Keyspace, "error", err)
+		} else {
+			s.logger.Info(ctx, "Done processing keyspace", "host", rp.Host, "keyspace", u.Keyspace)
+		}
+		s.onRunProgress(ctx, rp)
+	}
+	return errs
+}
+
+// processKeyspace takes a snapshot of the keyspace tables if needed and runs
+// the operation.
+func (s *Service) processKeyspace(ctx context.Context, client *scyllaclient.Client, target Target, snapshotTag string, rp *RunProgress, tables []string) error {
+	if snapshotTag != "" {
+		s.logger.Info(ctx, "Taking snapshot", "host", rp.Host, "keyspace", rp.Keyspace, "snapshot_tag", snapshotTag)
+		if err := client.TakeSnapshot(ctx, rp.Host, snapshotTag, rp.Keyspace, tables...); err != nil {
+			return errors.Wrap(err, "snapshot")
+		}
+		rp.SnapshotTag = snapshotTag
+		s.onRunProgress(ctx, rp)
+	}
+
+	switch target.Mode {
+	case Scrub:
+		s.logger.Info(ctx, "Scrubbing keyspace", "host", rp.Host, "keyspace", rp.Keyspace, "tables", tables, "scrub_mode", target.ScrubMode)
+		status, err := client.KeyspaceScrub(ctx, rp.Host, rp.Keyspace, target.ScrubMode.scyllaMode(), tables...)
+		if err != nil {
+			return err
+		}
+		if status != scyllaclient.ScrubSuccessful {
+			return errors.Errorf("scrub %s", status)
+		}
+	case Upgrade:
+		s.logger.Info(ctx, "Upgrading keyspace SSTables", "host", rp.Host, "keyspace", rp.Keyspace, "tables", tables)
+		return client.KeyspaceUpgradeSSTables(ctx, rp.Host, rp.Keyspace, !target.IncludeAllSSTables, tables...)
+	default:
+		return errors.Errorf("unsupported mode %s", target.Mode)
+	}
+	return nil
+}
+
+func (s *Service) stopCompaction(ctx context.Context, client *scyllaclient.Client, host string, mode Mode) {
+	t := scyllaclient.ScrubCompaction
+	if mode == Upgrade {
+		t = scyllaclient.UpgradeCompaction
+	}
+
+	stopCtx := log.CopyTraceID(context.Background(), ctx)
+	stopCtx = scyllaclient.Interactive(stopCtx)
+	if err := client.StopCompaction(stopCtx, host, t); err != nil {
+		s.logger.Error(stopCtx, "Failed to stop compaction", "host", host, "type", t, "error", err)
+	}
+}
+
+func (s *Service) onRunProgress(ctx context.Context, rp *RunProgress) {
+	if err := s.putRunProgress(ctx, rp); err != nil {
+		s.logger.Error(ctx, "Failed to update sstable maintenance progress", "error", err)
+	}
+	s.publishRunProgress(ctx, rp)
+}
+
+// putRun upserts an SSTable maintenance run.
+func (s *Service) putRun(r *Run) error {
+	return s.runs.PutRun(r)
+}
+
+// putRunProgress upserts an SSTable maintenance run progress.
+func (s *Service) putRunProgress(ctx context.Context, p *RunProgress) error {
+	s.logger.Debug(ctx, "PutRunProgress", "run_progress", p)
+
+	return s.runs.PutRunProgress(p)
+}
+
+// publishRunProgress sends SSTable maintenance progress event for a keyspace
+// on a host.
+func (s *Service) publishRunProgress(ctx context.Context, p *RunProgress) {
+	s.events.Publish(ctx, events.Event{
+		Type:      events.SSTableMaintenanceProgress,
+		ClusterID: p.ClusterID,
+		TaskType:  "sstable_maintenance",
+		TaskID:    p.TaskID,
+		RunID:     p.RunID,
+		Data: HostKeyspaceProgress{
+			Host: p.Host,
+			DC:   p.DC,
+			KeyspaceProgress: KeyspaceProgress{
+				Keyspace:    p.Keyspace,
+				SnapshotTag: p.SnapshotTag,
+				StartedAt:   p.StartedAt,
+				CompletedAt: p.CompletedAt,
+				Error:       p.Error,
+			},
+		},
+	})
+}
+
+// doneRunProgress returns progress of keyspaces successfully processed in
+// the run indexed by host and keyspace.
+func (s *Service) doneRunProgress(run *Run) (map[string]*RunProgress, error) {
+	rps, err := s.getRunProgress(run.ClusterID, run.TaskID, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*RunProgress)
+	for _, rp := range rps {
+		if rp.Done() {
+			m[progressKey(rp.Host, rp.Keyspace)] = rp
+		}
+	}
+	return m, nil
+}
+
+func (s *Service) getRunProgress(clusterID, taskID, runID uuid.UUID) ([]*RunProgress, error) {
+	var v []*RunProgress
+	return v, s.runs.GetRunProgress(clusterID, taskID, runID, &v)
+}
+
+// GetLastResumableRun returns the the most recent started but not done run of
+// the task, if there is a recent run that is completely done ErrNotFound is
+// reported.
+func (s *Service) GetLastResumableRun(ctx context.Context, clusterID, taskID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetLastResumableRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+	)
+
+	var runs []*Run
+	if err := s.runs.GetRecentRuns(clusterID, taskID, &runs); err != nil {
+		return nil, err
+	}
+
+	i, err := nodeops.LastResumableRun(len(runs), func(i int) (nodeops.Progress, error) {
+		r := runs[i]
+		rps, err := s.getRunProgress(r.ClusterID, r.TaskID, r.ID)
+		if err != nil {
+			return nodeops.Progress{}, err
+		}
+		return aggregateProgress(r, rps).progress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return runs[i], nil
+}
+
+// GetRun returns a run based on ID. If nothing was found scylla-manager.ErrNotFound
+// is returned.
+func (s *Service) GetRun(ctx context.Context, clusterID, taskID, runID uuid.UUID) (*Run, error) {
+	s.logger.Debug(ctx, "GetRun",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	var r Run
+	return &r, s.runs.GetRun(clusterID, taskID, runID, &r)
+}
+
+// GetProgress aggregates progress for the run of the task and breaks it down
+// by host and keyspace.
+// If nothing was found scylla-manager.ErrNotFound is returned.
+func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (Progress, error) {
+	s.logger.Debug(ctx, "GetProgress",
+		"cluster_id", clusterID,
+		"task_id", taskID,
+		"run_id", runID,
+	)
+
+	run, err := s.GetRun(ctx, clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	rps, err := s.getRunProgress(clusterID, taskID, runID)
+	if err != nil {
+		return Progress{}, err
+	}
+	return aggregateProgress(run, rps), nil
+}
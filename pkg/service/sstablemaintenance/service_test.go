@@ -0,0 +1,55 @@
+// Copyright (C) 2017 ScyllaDB
+
+package sstablemaintenance
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-manager/pkg/scyllaclient"
+)
+
+func TestTargetRewritesSSTables(t *testing.T) {
+	table := []struct {
+		Name   string
+		Target Target
+		Golden bool
+	}{
+		{
+			Name:   "Upgrade",
+			Target: Target{Mode: Upgrade},
+			Golden: true,
+		},
+		{
+			Name:   "Scrub skip",
+			Target: Target{Mode: Scrub, ScrubMode: ScrubSkip},
+			Golden: true,
+		},
+		{
+			Name:   "Scrub validate",
+			Target: Target{Mode: Scrub, ScrubMode: ScrubValidate},
+			Golden: false,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := test.Target.rewritesSSTables(); v != test.Golden {
+				t.Fatalf("rewritesSSTables() = %v, expected %v", v, test.Golden)
+			}
+		})
+	}
+}
+
+func TestScrubModeUnmarshalText(t *testing.T) {
+	var m ScrubMode
+	if err := m.UnmarshalText([]byte("validate")); err != nil {
+		t.Fatal(err)
+	}
+	if m.scyllaMode() != scyllaclient.ScrubValidate {
+		t.Fatalf("scyllaMode() = %s, expected %s", m.scyllaMode(), scyllaclient.ScrubValidate)
+	}
+	if err := m.UnmarshalText([]byte("foo")); err == nil {
+		t.Fatal("UnmarshalText() expected error")
+	}
+}
@@ -25,6 +25,8 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AgentConfig(params *AgentConfigParams) (*AgentConfigOK, error)
+
 	CoreBwlimit(params *CoreBwlimitParams) (*CoreBwlimitOK, error)
 
 	CoreStatsDelete(params *CoreStatsDeleteParams) (*CoreStatsDeleteOK, error)
@@ -41,6 +43,8 @@ type ClientService interface {
 
 	NodeInfo(params *NodeInfoParams) (*NodeInfoOK, error)
 
+	NodeTime(params *NodeTimeParams) (*NodeTimeOK, error)
+
 	OperationsAbout(params *OperationsAboutParams) (*OperationsAboutOK, error)
 
 	OperationsCheckPermissions(params *OperationsCheckPermissionsParams) (*OperationsCheckPermissionsOK, error)
@@ -51,19 +55,66 @@ type ClientService interface {
 
 	OperationsList(params *OperationsListParams) (*OperationsListOK, error)
 
+	OperationsLock(params *OperationsLockParams) (*OperationsLockOK, error)
+
+	OperationsLockStatus(params *OperationsLockStatusParams) (*OperationsLockStatusOK, error)
+
 	OperationsMovefile(params *OperationsMovefileParams) (*OperationsMovefileOK, error)
 
 	OperationsPurge(params *OperationsPurgeParams) (*OperationsPurgeOK, error)
 
 	Reload(params *ReloadParams) (*ReloadOK, error)
 
+	RestartScylla(params *RestartScyllaParams) (*RestartScyllaOK, error)
+
+	RunHook(params *RunHookParams) (*RunHookOK, error)
+
 	SyncCopyDir(params *SyncCopyDirParams) (*SyncCopyDirOK, error)
 
 	SyncMoveDir(params *SyncMoveDirParams) (*SyncMoveDirOK, error)
 
+	Terminate(params *TerminateParams) (*TerminateOK, error)
+
+	Upgrade(params *UpgradeParams) (*UpgradeOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  AgentConfig agents config
+
+  Get the current agent config with secrets redacted
+*/
+func (a *Client) AgentConfig(params *AgentConfigParams) (*AgentConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAgentConfigParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "AgentConfig",
+		Method:             "GET",
+		PathPattern:        "/config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AgentConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AgentConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AgentConfigDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   CoreBwlimit sets the bandwidth limit
 
@@ -344,6 +395,41 @@ func (a *Client) NodeInfo(params *NodeInfoParams) (*NodeInfoOK, error) {
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  NodeTime gets current time of the node
+
+  Get current time of the node, used to detect clock skew
+*/
+func (a *Client) NodeTime(params *NodeTimeParams) (*NodeTimeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodeTimeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "NodeTime",
+		Method:             "GET",
+		PathPattern:        "/node_time",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &NodeTimeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodeTimeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*NodeTimeDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   OperationsAbout abouts remote
 
@@ -519,6 +605,76 @@ func (a *Client) OperationsList(params *OperationsListParams) (*OperationsListOK
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  OperationsLock locks files
+
+  Make files immutable until the retention date
+*/
+func (a *Client) OperationsLock(params *OperationsLockParams) (*OperationsLockOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOperationsLockParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "OperationsLock",
+		Method:             "POST",
+		PathPattern:        "/rclone/operations/lock",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &OperationsLockReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OperationsLockOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*OperationsLockDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  OperationsLockStatus locks status
+
+  Get files that are not immutable until the retention date
+*/
+func (a *Client) OperationsLockStatus(params *OperationsLockStatusParams) (*OperationsLockStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOperationsLockStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "OperationsLockStatus",
+		Method:             "POST",
+		PathPattern:        "/rclone/operations/lock-status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &OperationsLockStatusReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OperationsLockStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*OperationsLockStatusDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   OperationsMovefile moves a file
 
@@ -592,7 +748,7 @@ func (a *Client) OperationsPurge(params *OperationsPurgeParams) (*OperationsPurg
 /*
   Reload reloads agent config
 
-  Reload agent config
+  Reload agent config from the config files, options that require agent restart are not changed
 */
 func (a *Client) Reload(params *ReloadParams) (*ReloadOK, error) {
 	// TODO: Validate the params before sending
@@ -603,7 +759,7 @@ func (a *Client) Reload(params *ReloadParams) (*ReloadOK, error) {
 	result, err := a.transport.Submit(&runtime.ClientOperation{
 		ID:                 "Reload",
 		Method:             "POST",
-		PathPattern:        "/terminate",
+		PathPattern:        "/reload",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
@@ -624,6 +780,76 @@ func (a *Client) Reload(params *ReloadParams) (*ReloadOK, error) {
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  RestartScylla restarts scylla
+
+  Restart Scylla systemd service, the restart is queued and the call returns without waiting for Scylla to start
+*/
+func (a *Client) RestartScylla(params *RestartScyllaParams) (*RestartScyllaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestartScyllaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RestartScylla",
+		Method:             "POST",
+		PathPattern:        "/restart_scylla",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestartScyllaReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestartScyllaOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RestartScyllaDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  RunHook runs hook
+
+  Run an executable from the hooks directory configured in the agent, the call returns when the hook exits or is killed after the configured timeout
+*/
+func (a *Client) RunHook(params *RunHookParams) (*RunHookOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRunHookParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RunHook",
+		Method:             "POST",
+		PathPattern:        "/run_hook",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RunHookReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RunHookOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RunHookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   SyncCopyDir copies dir contents to directory
 
@@ -694,6 +920,76 @@ func (a *Client) SyncMoveDir(params *SyncMoveDirParams) (*SyncMoveDirOK, error)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  Terminate terminates agent
+
+  Terminate agent, if the agent binary was upgraded the new binary is executed
+*/
+func (a *Client) Terminate(params *TerminateParams) (*TerminateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTerminateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Terminate",
+		Method:             "POST",
+		PathPattern:        "/terminate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TerminateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TerminateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*TerminateDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  Upgrade upgrades agent
+
+  Download agent binary from the upgrade location configured in the agent, verify it and replace the agent binary, the agent keeps running the old version until it is restarted with terminate
+*/
+func (a *Client) Upgrade(params *UpgradeParams) (*UpgradeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpgradeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Upgrade",
+		Method:             "POST",
+		PathPattern:        "/upgrade",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpgradeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpgradeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpgradeDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
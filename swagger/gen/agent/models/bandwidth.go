@@ -17,7 +17,10 @@ import (
 // swagger:model Bandwidth
 type Bandwidth struct {
 
-	// String representation of the bandwidth rate limit (eg. 100k, 1M, ...).
+	// ID of the running copy or move dir job started with bwlimit to set the bandwidth limit of, if not set the global limit is set
+	Jobid int64 `json:"jobid,omitempty"`
+
+	// String representation of the bandwidth rate limit (eg. 100k, 1M, ...). Separate upload and download limits can be set with upload:download pair (eg. 10M:100M), it's not supported for a job.
 	Rate string `json:"rate,omitempty"`
 }
 
@@ -15,17 +15,26 @@ import (
 // swagger:model MoveOrCopyFileOptions
 type MoveOrCopyFileOptions struct {
 
+	// Bandwidth limit of the job (eg. 100k, 1M, ...), applies only to copy and move dir operations, the limit can be changed only if it's set, use 0 for no limit
+	Bwlimit string `json:"bwlimit,omitempty"`
+
 	// A remote name string eg. drive: for the destination
 	DstFs string `json:"dstFs,omitempty"`
 
 	// A path within that remote eg. file.txt for the destination
 	DstRemote string `json:"dstRemote,omitempty"`
 
+	// Metadata and tags set on the uploaded objects, applies only to copy and move dir operations
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// A remote name string eg. drive: for the source
 	SrcFs string `json:"srcFs,omitempty"`
 
 	// A path within that remote eg. file.txt for the source
 	SrcRemote string `json:"srcRemote,omitempty"`
+
+	// Storage class or access tier of the uploaded objects (eg. STANDARD_IA, Cool, ...), applies only to copy and move dir operations
+	StorageClass string `json:"storage_class,omitempty"`
 }
 
 // Validate validates this move or copy file options
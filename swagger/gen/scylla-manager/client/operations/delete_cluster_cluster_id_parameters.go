@@ -61,6 +61,8 @@ for the delete cluster cluster ID operation typically these are written to a htt
 */
 type DeleteClusterClusterIDParams struct {
 
+	/*AgentTLS*/
+	AgentTLS *bool
 	/*ClusterID*/
 	ClusterID string
 	/*CqlCreds*/
@@ -106,6 +108,17 @@ func (o *DeleteClusterClusterIDParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAgentTLS adds the agentTLS to the delete cluster cluster ID params
+func (o *DeleteClusterClusterIDParams) WithAgentTLS(agentTLS *bool) *DeleteClusterClusterIDParams {
+	o.SetAgentTLS(agentTLS)
+	return o
+}
+
+// SetAgentTLS adds the agentTls to the delete cluster cluster ID params
+func (o *DeleteClusterClusterIDParams) SetAgentTLS(agentTLS *bool) {
+	o.AgentTLS = agentTLS
+}
+
 // WithClusterID adds the clusterID to the delete cluster cluster ID params
 func (o *DeleteClusterClusterIDParams) WithClusterID(clusterID string) *DeleteClusterClusterIDParams {
 	o.SetClusterID(clusterID)
@@ -147,6 +160,22 @@ func (o *DeleteClusterClusterIDParams) WriteToRequest(r runtime.ClientRequest, r
 	}
 	var res []error
 
+	if o.AgentTLS != nil {
+
+		// query param agent_tls
+		var qrAgentTLS bool
+		if o.AgentTLS != nil {
+			qrAgentTLS = *o.AgentTLS
+		}
+		qAgentTLS := swag.FormatBool(qrAgentTLS)
+		if qAgentTLS != "" {
+			if err := r.SetQueryParam("agent_tls", qAgentTLS); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
 		return err
@@ -19,7 +19,7 @@ import (
 // NewGetClustersParams creates a new GetClustersParams object
 // with the default values initialized.
 func NewGetClustersParams() *GetClustersParams {
-
+	var ()
 	return &GetClustersParams{
 
 		timeout: cr.DefaultTimeout,
@@ -29,7 +29,7 @@ func NewGetClustersParams() *GetClustersParams {
 // NewGetClustersParamsWithTimeout creates a new GetClustersParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewGetClustersParamsWithTimeout(timeout time.Duration) *GetClustersParams {
-
+	var ()
 	return &GetClustersParams{
 
 		timeout: timeout,
@@ -39,7 +39,7 @@ func NewGetClustersParamsWithTimeout(timeout time.Duration) *GetClustersParams {
 // NewGetClustersParamsWithContext creates a new GetClustersParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewGetClustersParamsWithContext(ctx context.Context) *GetClustersParams {
-
+	var ()
 	return &GetClustersParams{
 
 		Context: ctx,
@@ -49,7 +49,7 @@ func NewGetClustersParamsWithContext(ctx context.Context) *GetClustersParams {
 // NewGetClustersParamsWithHTTPClient creates a new GetClustersParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewGetClustersParamsWithHTTPClient(client *http.Client) *GetClustersParams {
-
+	var ()
 	return &GetClustersParams{
 		HTTPClient: client,
 	}
@@ -59,6 +59,13 @@ func NewGetClustersParamsWithHTTPClient(client *http.Client) *GetClustersParams
 for the get clusters operation typically these are written to a http.Request
 */
 type GetClustersParams struct {
+
+	/*Selector
+	  Label selector, comma separated list of key=value, key!=value, key or !key requirements
+
+	*/
+	Selector *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -97,6 +104,17 @@ func (o *GetClustersParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithSelector adds the selector to the get clusters params
+func (o *GetClustersParams) WithSelector(selector *string) *GetClustersParams {
+	o.SetSelector(selector)
+	return o
+}
+
+// SetSelector adds the selector to the get clusters params
+func (o *GetClustersParams) SetSelector(selector *string) {
+	o.Selector = selector
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -105,6 +123,22 @@ func (o *GetClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Selector != nil {
+
+		// query param selector
+		var qrSelector string
+		if o.Selector != nil {
+			qrSelector = *o.Selector
+		}
+		qSelector := qrSelector
+		if qSelector != "" {
+			if err := r.SetQueryParam("selector", qSelector); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
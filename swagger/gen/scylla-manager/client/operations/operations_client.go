@@ -27,24 +27,46 @@ type Client struct {
 type ClientService interface {
 	DeleteClusterClusterID(params *DeleteClusterClusterIDParams) (*DeleteClusterClusterIDOK, error)
 
+	DeleteClusterClusterIDAuthToken(params *DeleteClusterClusterIDAuthTokenParams) (*DeleteClusterClusterIDAuthTokenOK, error)
+
 	DeleteClusterClusterIDBackups(params *DeleteClusterClusterIDBackupsParams) (*DeleteClusterClusterIDBackupsOK, error)
 
+	DeleteClusterClusterIDSnapshots(params *DeleteClusterClusterIDSnapshotsParams) (*DeleteClusterClusterIDSnapshotsOK, error)
+
 	DeleteClusterClusterIDTaskTaskTypeTaskID(params *DeleteClusterClusterIDTaskTaskTypeTaskIDParams) (*DeleteClusterClusterIDTaskTaskTypeTaskIDOK, error)
 
 	GetClusterClusterID(params *GetClusterClusterIDParams) (*GetClusterClusterIDOK, error)
 
 	GetClusterClusterIDBackups(params *GetClusterClusterIDBackupsParams) (*GetClusterClusterIDBackupsOK, error)
 
+	GetClusterClusterIDBackupsDiff(params *GetClusterClusterIDBackupsDiffParams) (*GetClusterClusterIDBackupsDiffOK, error)
+
 	GetClusterClusterIDBackupsFiles(params *GetClusterClusterIDBackupsFilesParams) (*GetClusterClusterIDBackupsFilesOK, error)
 
+	GetClusterClusterIDSnapshots(params *GetClusterClusterIDSnapshotsParams) (*GetClusterClusterIDSnapshotsOK, error)
+
 	GetClusterClusterIDStatus(params *GetClusterClusterIDStatusParams) (*GetClusterClusterIDStatusOK, error)
 
+	GetClusterClusterIDStatusAgentConfig(params *GetClusterClusterIDStatusAgentConfigParams) (*GetClusterClusterIDStatusAgentConfigOK, error)
+
+	GetClusterClusterIDStatusHistory(params *GetClusterClusterIDStatusHistoryParams) (*GetClusterClusterIDStatusHistoryOK, error)
+
 	GetClusterClusterIDSuspended(params *GetClusterClusterIDSuspendedParams) (*GetClusterClusterIDSuspendedOK, error)
 
 	GetClusterClusterIDTaskBackupTaskIDRunID(params *GetClusterClusterIDTaskBackupTaskIDRunIDParams) (*GetClusterClusterIDTaskBackupTaskIDRunIDOK, error)
 
+	GetClusterClusterIDTaskCleanupTaskIDRunID(params *GetClusterClusterIDTaskCleanupTaskIDRunIDParams) (*GetClusterClusterIDTaskCleanupTaskIDRunIDOK, error)
+
+	GetClusterClusterIDTaskCompactionTaskIDRunID(params *GetClusterClusterIDTaskCompactionTaskIDRunIDParams) (*GetClusterClusterIDTaskCompactionTaskIDRunIDOK, error)
+
 	GetClusterClusterIDTaskRepairTaskIDRunID(params *GetClusterClusterIDTaskRepairTaskIDRunIDParams) (*GetClusterClusterIDTaskRepairTaskIDRunIDOK, error)
 
+	GetClusterClusterIDTaskRollingRestartTaskIDRunID(params *GetClusterClusterIDTaskRollingRestartTaskIDRunIDParams) (*GetClusterClusterIDTaskRollingRestartTaskIDRunIDOK, error)
+
+	GetClusterClusterIDTaskSnapshotTaskIDRunID(params *GetClusterClusterIDTaskSnapshotTaskIDRunIDParams) (*GetClusterClusterIDTaskSnapshotTaskIDRunIDOK, error)
+
+	GetClusterClusterIDTaskSstableMaintenanceTaskIDRunID(params *GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDParams) (*GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDOK, error)
+
 	GetClusterClusterIDTaskTaskTypeTaskID(params *GetClusterClusterIDTaskTaskTypeTaskIDParams) (*GetClusterClusterIDTaskTaskTypeTaskIDOK, error)
 
 	GetClusterClusterIDTaskTaskTypeTaskIDHistory(params *GetClusterClusterIDTaskTaskTypeTaskIDHistoryParams) (*GetClusterClusterIDTaskTaskTypeTaskIDHistoryOK, error)
@@ -53,10 +75,22 @@ type ClientService interface {
 
 	GetClusterClusterIDTasks(params *GetClusterClusterIDTasksParams) (*GetClusterClusterIDTasksOK, error)
 
+	GetClusterClusterIDTasksBackupEstimate(params *GetClusterClusterIDTasksBackupEstimateParams) (*GetClusterClusterIDTasksBackupEstimateOK, error)
+
 	GetClusterClusterIDTasksBackupTarget(params *GetClusterClusterIDTasksBackupTargetParams) (*GetClusterClusterIDTasksBackupTargetOK, error)
 
+	GetClusterClusterIDTasksCleanupTarget(params *GetClusterClusterIDTasksCleanupTargetParams) (*GetClusterClusterIDTasksCleanupTargetOK, error)
+
+	GetClusterClusterIDTasksCompactionTarget(params *GetClusterClusterIDTasksCompactionTargetParams) (*GetClusterClusterIDTasksCompactionTargetOK, error)
+
 	GetClusterClusterIDTasksRepairTarget(params *GetClusterClusterIDTasksRepairTargetParams) (*GetClusterClusterIDTasksRepairTargetOK, error)
 
+	GetClusterClusterIDTasksRollingRestartTarget(params *GetClusterClusterIDTasksRollingRestartTargetParams) (*GetClusterClusterIDTasksRollingRestartTargetOK, error)
+
+	GetClusterClusterIDTasksSnapshotTarget(params *GetClusterClusterIDTasksSnapshotTargetParams) (*GetClusterClusterIDTasksSnapshotTargetOK, error)
+
+	GetClusterClusterIDTasksSstableMaintenanceTarget(params *GetClusterClusterIDTasksSstableMaintenanceTargetParams) (*GetClusterClusterIDTasksSstableMaintenanceTargetOK, error)
+
 	GetClusters(params *GetClustersParams) (*GetClustersOK, error)
 
 	GetVersion(params *GetVersionParams) (*GetVersionOK, error)
@@ -67,6 +101,10 @@ type ClientService interface {
 
 	PutClusterClusterID(params *PutClusterClusterIDParams) (*PutClusterClusterIDOK, error)
 
+	PutClusterClusterIDAuthToken(params *PutClusterClusterIDAuthTokenParams) (*PutClusterClusterIDAuthTokenOK, error)
+
+	PutClusterClusterIDBackupsRateLimit(params *PutClusterClusterIDBackupsRateLimitParams) (*PutClusterClusterIDBackupsRateLimitOK, error)
+
 	PutClusterClusterIDRepairsIntensity(params *PutClusterClusterIDRepairsIntensityParams) (*PutClusterClusterIDRepairsIntensityOK, error)
 
 	PutClusterClusterIDRepairsParallel(params *PutClusterClusterIDRepairsParallelParams) (*PutClusterClusterIDRepairsParallelOK, error)
@@ -115,6 +153,39 @@ func (a *Client) DeleteClusterClusterID(params *DeleteClusterClusterIDParams) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  DeleteClusterClusterIDAuthToken delete cluster cluster ID auth token API
+*/
+func (a *Client) DeleteClusterClusterIDAuthToken(params *DeleteClusterClusterIDAuthTokenParams) (*DeleteClusterClusterIDAuthTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteClusterClusterIDAuthTokenParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterClusterIDAuthToken",
+		Method:             "DELETE",
+		PathPattern:        "/cluster/{cluster_id}/auth_token",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteClusterClusterIDAuthTokenReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteClusterClusterIDAuthTokenOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteClusterClusterIDAuthTokenDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   DeleteClusterClusterIDBackups delete cluster cluster ID backups API
 */
@@ -148,6 +219,39 @@ func (a *Client) DeleteClusterClusterIDBackups(params *DeleteClusterClusterIDBac
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  DeleteClusterClusterIDSnapshots delete cluster cluster ID snapshots API
+*/
+func (a *Client) DeleteClusterClusterIDSnapshots(params *DeleteClusterClusterIDSnapshotsParams) (*DeleteClusterClusterIDSnapshotsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteClusterClusterIDSnapshotsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterClusterIDSnapshots",
+		Method:             "DELETE",
+		PathPattern:        "/cluster/{cluster_id}/snapshots",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteClusterClusterIDSnapshotsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteClusterClusterIDSnapshotsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteClusterClusterIDSnapshotsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   DeleteClusterClusterIDTaskTaskTypeTaskID delete cluster cluster ID task task type task ID API
 */
@@ -247,6 +351,39 @@ func (a *Client) GetClusterClusterIDBackups(params *GetClusterClusterIDBackupsPa
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDBackupsDiff get cluster cluster ID backups diff API
+*/
+func (a *Client) GetClusterClusterIDBackupsDiff(params *GetClusterClusterIDBackupsDiffParams) (*GetClusterClusterIDBackupsDiffOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDBackupsDiffParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDBackupsDiff",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/backups/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDBackupsDiffReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDBackupsDiffOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDBackupsDiffDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDBackupsFiles get cluster cluster ID backups files API
 */
@@ -280,6 +417,39 @@ func (a *Client) GetClusterClusterIDBackupsFiles(params *GetClusterClusterIDBack
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDSnapshots get cluster cluster ID snapshots API
+*/
+func (a *Client) GetClusterClusterIDSnapshots(params *GetClusterClusterIDSnapshotsParams) (*GetClusterClusterIDSnapshotsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDSnapshotsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDSnapshots",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/snapshots",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDSnapshotsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDSnapshotsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDSnapshotsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDStatus get cluster cluster ID status API
 */
@@ -313,6 +483,72 @@ func (a *Client) GetClusterClusterIDStatus(params *GetClusterClusterIDStatusPara
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDStatusAgentConfig get cluster cluster ID status agent config API
+*/
+func (a *Client) GetClusterClusterIDStatusAgentConfig(params *GetClusterClusterIDStatusAgentConfigParams) (*GetClusterClusterIDStatusAgentConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDStatusAgentConfigParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDStatusAgentConfig",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/status/agent_config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDStatusAgentConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDStatusAgentConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDStatusAgentConfigDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDStatusHistory get cluster cluster ID status history API
+*/
+func (a *Client) GetClusterClusterIDStatusHistory(params *GetClusterClusterIDStatusHistoryParams) (*GetClusterClusterIDStatusHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDStatusHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDStatusHistory",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/status/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDStatusHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDStatusHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDStatusHistoryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDSuspended get cluster cluster ID suspended API
 */
@@ -379,6 +615,72 @@ func (a *Client) GetClusterClusterIDTaskBackupTaskIDRunID(params *GetClusterClus
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDTaskCleanupTaskIDRunID get cluster cluster ID task cleanup task ID run ID API
+*/
+func (a *Client) GetClusterClusterIDTaskCleanupTaskIDRunID(params *GetClusterClusterIDTaskCleanupTaskIDRunIDParams) (*GetClusterClusterIDTaskCleanupTaskIDRunIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTaskCleanupTaskIDRunIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTaskCleanupTaskIDRunID",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/task/cleanup/{task_id}/{run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTaskCleanupTaskIDRunIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTaskCleanupTaskIDRunIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTaskCleanupTaskIDRunIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTaskCompactionTaskIDRunID get cluster cluster ID task compaction task ID run ID API
+*/
+func (a *Client) GetClusterClusterIDTaskCompactionTaskIDRunID(params *GetClusterClusterIDTaskCompactionTaskIDRunIDParams) (*GetClusterClusterIDTaskCompactionTaskIDRunIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTaskCompactionTaskIDRunIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTaskCompactionTaskIDRunID",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/task/compaction/{task_id}/{run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTaskCompactionTaskIDRunIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTaskCompactionTaskIDRunIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTaskCompactionTaskIDRunIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDTaskRepairTaskIDRunID get cluster cluster ID task repair task ID run ID API
 */
@@ -412,6 +714,105 @@ func (a *Client) GetClusterClusterIDTaskRepairTaskIDRunID(params *GetClusterClus
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDTaskRollingRestartTaskIDRunID get cluster cluster ID task rolling restart task ID run ID API
+*/
+func (a *Client) GetClusterClusterIDTaskRollingRestartTaskIDRunID(params *GetClusterClusterIDTaskRollingRestartTaskIDRunIDParams) (*GetClusterClusterIDTaskRollingRestartTaskIDRunIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTaskRollingRestartTaskIDRunIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTaskRollingRestartTaskIDRunID",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/task/rolling_restart/{task_id}/{run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTaskRollingRestartTaskIDRunIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTaskRollingRestartTaskIDRunIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTaskRollingRestartTaskIDRunIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTaskSnapshotTaskIDRunID get cluster cluster ID task snapshot task ID run ID API
+*/
+func (a *Client) GetClusterClusterIDTaskSnapshotTaskIDRunID(params *GetClusterClusterIDTaskSnapshotTaskIDRunIDParams) (*GetClusterClusterIDTaskSnapshotTaskIDRunIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTaskSnapshotTaskIDRunIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTaskSnapshotTaskIDRunID",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/task/snapshot/{task_id}/{run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTaskSnapshotTaskIDRunIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTaskSnapshotTaskIDRunIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTaskSnapshotTaskIDRunIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTaskSstableMaintenanceTaskIDRunID get cluster cluster ID task sstable maintenance task ID run ID API
+*/
+func (a *Client) GetClusterClusterIDTaskSstableMaintenanceTaskIDRunID(params *GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDParams) (*GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTaskSstableMaintenanceTaskIDRunID",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/task/sstable_maintenance/{task_id}/{run_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTaskSstableMaintenanceTaskIDRunIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDTaskTaskTypeTaskID get cluster cluster ID task task type task ID API
 */
@@ -544,6 +945,39 @@ func (a *Client) GetClusterClusterIDTasks(params *GetClusterClusterIDTasksParams
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDTasksBackupEstimate get cluster cluster ID tasks backup estimate API
+*/
+func (a *Client) GetClusterClusterIDTasksBackupEstimate(params *GetClusterClusterIDTasksBackupEstimateParams) (*GetClusterClusterIDTasksBackupEstimateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksBackupEstimateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksBackupEstimate",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/backup/estimate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksBackupEstimateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksBackupEstimateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksBackupEstimateDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDTasksBackupTarget get cluster cluster ID tasks backup target API
 */
@@ -577,6 +1011,72 @@ func (a *Client) GetClusterClusterIDTasksBackupTarget(params *GetClusterClusterI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDTasksCleanupTarget get cluster cluster ID tasks cleanup target API
+*/
+func (a *Client) GetClusterClusterIDTasksCleanupTarget(params *GetClusterClusterIDTasksCleanupTargetParams) (*GetClusterClusterIDTasksCleanupTargetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksCleanupTargetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksCleanupTarget",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/cleanup/target",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksCleanupTargetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksCleanupTargetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksCleanupTargetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTasksCompactionTarget get cluster cluster ID tasks compaction target API
+*/
+func (a *Client) GetClusterClusterIDTasksCompactionTarget(params *GetClusterClusterIDTasksCompactionTargetParams) (*GetClusterClusterIDTasksCompactionTargetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksCompactionTargetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksCompactionTarget",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/compaction/target",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksCompactionTargetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksCompactionTargetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksCompactionTargetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterClusterIDTasksRepairTarget get cluster cluster ID tasks repair target API
 */
@@ -610,6 +1110,105 @@ func (a *Client) GetClusterClusterIDTasksRepairTarget(params *GetClusterClusterI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterClusterIDTasksRollingRestartTarget get cluster cluster ID tasks rolling restart target API
+*/
+func (a *Client) GetClusterClusterIDTasksRollingRestartTarget(params *GetClusterClusterIDTasksRollingRestartTargetParams) (*GetClusterClusterIDTasksRollingRestartTargetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksRollingRestartTargetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksRollingRestartTarget",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/rolling_restart/target",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksRollingRestartTargetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksRollingRestartTargetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksRollingRestartTargetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTasksSnapshotTarget get cluster cluster ID tasks snapshot target API
+*/
+func (a *Client) GetClusterClusterIDTasksSnapshotTarget(params *GetClusterClusterIDTasksSnapshotTargetParams) (*GetClusterClusterIDTasksSnapshotTargetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksSnapshotTargetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksSnapshotTarget",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/snapshot/target",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksSnapshotTargetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksSnapshotTargetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksSnapshotTargetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetClusterClusterIDTasksSstableMaintenanceTarget get cluster cluster ID tasks sstable maintenance target API
+*/
+func (a *Client) GetClusterClusterIDTasksSstableMaintenanceTarget(params *GetClusterClusterIDTasksSstableMaintenanceTargetParams) (*GetClusterClusterIDTasksSstableMaintenanceTargetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksSstableMaintenanceTargetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksSstableMaintenanceTarget",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/sstable_maintenance/target",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksSstableMaintenanceTargetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksSstableMaintenanceTargetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksSstableMaintenanceTargetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusters get clusters API
 */
@@ -775,6 +1374,72 @@ func (a *Client) PutClusterClusterID(params *PutClusterClusterIDParams) (*PutClu
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  PutClusterClusterIDAuthToken put cluster cluster ID auth token API
+*/
+func (a *Client) PutClusterClusterIDAuthToken(params *PutClusterClusterIDAuthTokenParams) (*PutClusterClusterIDAuthTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutClusterClusterIDAuthTokenParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PutClusterClusterIDAuthToken",
+		Method:             "PUT",
+		PathPattern:        "/cluster/{cluster_id}/auth_token",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutClusterClusterIDAuthTokenReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutClusterClusterIDAuthTokenOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutClusterClusterIDAuthTokenDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutClusterClusterIDBackupsRateLimit put cluster cluster ID backups rate limit API
+*/
+func (a *Client) PutClusterClusterIDBackupsRateLimit(params *PutClusterClusterIDBackupsRateLimitParams) (*PutClusterClusterIDBackupsRateLimitOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutClusterClusterIDBackupsRateLimitParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PutClusterClusterIDBackupsRateLimit",
+		Method:             "PUT",
+		PathPattern:        "/cluster/{cluster_id}/backups/rate-limit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutClusterClusterIDBackupsRateLimitReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutClusterClusterIDBackupsRateLimitOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutClusterClusterIDBackupsRateLimitDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   PutClusterClusterIDRepairsIntensity put cluster cluster ID repairs intensity API
 */
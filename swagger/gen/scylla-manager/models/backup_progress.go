@@ -29,9 +29,18 @@ type BackupProgress struct {
 	// failed
 	Failed int64 `json:"failed,omitempty"`
 
+	// hooks
+	Hooks []*BackupHookProgress `json:"hooks"`
+
 	// hosts
 	Hosts []*HostProgress `json:"hosts"`
 
+	// rate limit
+	RateLimit []string `json:"rate_limit"`
+
+	// rate limit schedule
+	RateLimitSchedule []string `json:"rate_limit_schedule"`
+
 	// size
 	Size int64 `json:"size,omitempty"`
 
@@ -60,6 +69,10 @@ func (m *BackupProgress) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHooks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHosts(formats); err != nil {
 		res = append(res, err)
 	}
@@ -87,6 +100,31 @@ func (m *BackupProgress) validateCompletedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *BackupProgress) validateHooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hooks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hooks); i++ {
+		if swag.IsZero(m.Hooks[i]) { // not required
+			continue
+		}
+
+		if m.Hooks[i] != nil {
+			if err := m.Hooks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hooks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *BackupProgress) validateHosts(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Hosts) { // not required
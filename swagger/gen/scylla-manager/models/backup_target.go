@@ -24,24 +24,48 @@ type BackupTarget struct {
 	// dc
 	Dc []string `json:"dc"`
 
+	// hooks
+	Hooks *BackupHooks `json:"hooks,omitempty"`
+
 	// host
 	Host string `json:"host,omitempty"`
 
 	// location
 	Location []string `json:"location"`
 
+	// lock mode
+	LockMode string `json:"lock_mode,omitempty"`
+
+	// lock period
+	LockPeriod string `json:"lock_period,omitempty"`
+
+	// object tags
+	ObjectTags bool `json:"object_tags,omitempty"`
+
 	// rate limit
 	RateLimit []string `json:"rate_limit"`
 
+	// rate limit schedule
+	RateLimitSchedule []string `json:"rate_limit_schedule"`
+
 	// retention
 	Retention int64 `json:"retention,omitempty"`
 
+	// single replica
+	SingleReplica bool `json:"single_replica,omitempty"`
+
 	// size
 	Size int64 `json:"size,omitempty"`
 
 	// snapshot parallel
 	SnapshotParallel []string `json:"snapshot_parallel"`
 
+	// stagger dc
+	StaggerDc bool `json:"stagger_dc,omitempty"`
+
+	// storage class
+	StorageClass []string `json:"storage_class"`
+
 	// units
 	Units []*BackupUnit `json:"units"`
 
@@ -56,6 +80,10 @@ type BackupTarget struct {
 func (m *BackupTarget) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateHooks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUnits(formats); err != nil {
 		res = append(res, err)
 	}
@@ -66,6 +94,24 @@ func (m *BackupTarget) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *BackupTarget) validateHooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hooks) { // not required
+		return nil
+	}
+
+	if m.Hooks != nil {
+		if err := m.Hooks.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hooks")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *BackupTarget) validateUnits(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Units) { // not required
@@ -15,6 +15,18 @@ import (
 // swagger:model Cluster
 type Cluster struct {
 
+	// agent tls ca file
+	// Format: byte
+	AgentTLSCaFile strfmt.Base64 `json:"agent_tls_ca_file,omitempty"`
+
+	// agent tls cert file
+	// Format: byte
+	AgentTLSCertFile strfmt.Base64 `json:"agent_tls_cert_file,omitempty"`
+
+	// agent tls key file
+	// Format: byte
+	AgentTLSKeyFile strfmt.Base64 `json:"agent_tls_key_file,omitempty"`
+
 	// auth token
 	AuthToken string `json:"auth_token,omitempty"`
 
@@ -24,6 +36,9 @@ type Cluster struct {
 	// id
 	ID string `json:"id,omitempty"`
 
+	// labels
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ClusterStatus cluster status
@@ -52,6 +53,12 @@ type ClusterStatusItems0 struct {
 	// agent version
 	AgentVersion string `json:"agent_version,omitempty"`
 
+	// agent version cause
+	AgentVersionCause string `json:"agent_version_cause,omitempty"`
+
+	// agent version status
+	AgentVersionStatus string `json:"agent_version_status,omitempty"`
+
 	// alternator cause
 	AlternatorCause string `json:"alternator_cause,omitempty"`
 
@@ -64,6 +71,19 @@ type ClusterStatusItems0 struct {
 	// cpu count
 	CPUCount int64 `json:"cpu_count,omitempty"`
 
+	// cert expiry
+	// Format: date-time
+	CertExpiry strfmt.DateTime `json:"cert_expiry,omitempty"`
+
+	// cert status
+	CertStatus string `json:"cert_status,omitempty"`
+
+	// clock offset ms
+	ClockOffsetMs float32 `json:"clock_offset_ms,omitempty"`
+
+	// clock status
+	ClockStatus string `json:"clock_status,omitempty"`
+
 	// cql cause
 	CqlCause string `json:"cql_cause,omitempty"`
 
@@ -76,6 +96,18 @@ type ClusterStatusItems0 struct {
 	// dc
 	Dc string `json:"dc,omitempty"`
 
+	// disk free percent
+	DiskFreePercent float32 `json:"disk_free_percent,omitempty"`
+
+	// disk status
+	DiskStatus string `json:"disk_status,omitempty"`
+
+	// gossip cause
+	GossipCause string `json:"gossip_cause,omitempty"`
+
+	// gossip status
+	GossipStatus string `json:"gossip_status,omitempty"`
+
 	// host
 	Host string `json:"host,omitempty"`
 
@@ -91,6 +123,12 @@ type ClusterStatusItems0 struct {
 	// rest status
 	RestStatus string `json:"rest_status,omitempty"`
 
+	// schema status
+	SchemaStatus string `json:"schema_status,omitempty"`
+
+	// schema version
+	SchemaVersion string `json:"schema_version,omitempty"`
+
 	// scylla version
 	ScyllaVersion string `json:"scylla_version,omitempty"`
 
@@ -109,6 +147,28 @@ type ClusterStatusItems0 struct {
 
 // Validate validates this cluster status items0
 func (m *ClusterStatusItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCertExpiry(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterStatusItems0) validateCertExpiry(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CertExpiry) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cert_expiry", "body", "date-time", m.CertExpiry.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
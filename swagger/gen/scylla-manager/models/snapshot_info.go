@@ -18,6 +18,9 @@ type SnapshotInfo struct {
 	// nodes
 	Nodes int64 `json:"nodes,omitempty"`
 
+	// partial
+	Partial bool `json:"partial,omitempty"`
+
 	// size
 	Size int64 `json:"size,omitempty"`
 
@@ -54,6 +54,12 @@ type ValidateBackupProgress struct {
 	// started at
 	// Format: date-time
 	StartedAt *strfmt.DateTime `json:"started_at,omitempty"`
+
+	// unlocked files
+	UnlockedFiles int64 `json:"unlocked_files,omitempty"`
+
+	// unlocked snapshots
+	UnlockedSnapshots []string `json:"unlocked_snapshots"`
 }
 
 // Validate validates this validate backup progress